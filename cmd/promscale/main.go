@@ -13,6 +13,17 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			runImport(os.Args[2:])
+		case "export":
+			runExport(os.Args[2:])
+		case "migrate":
+			runMigrate(os.Args[2:])
+		}
+	}
+
 	cfg := &runner.Config{}
 	cfg, err := runner.ParseFlags(cfg)
 	if err != nil {
@@ -32,3 +43,67 @@ func main() {
 	}
 	os.Exit(0)
 }
+
+// runImport runs the import subcommand with args, the arguments following it.
+func runImport(args []string) {
+	cfg, err := runner.ParseImportFlags(&runner.ImportConfig{}, args)
+	if err != nil {
+		fmt.Println("Version: ", version.Version, "Commit Hash: ", version.CommitHash)
+		fmt.Println("Fatal error: cannot parse flags ", err)
+		os.Exit(1)
+	}
+	err = log.Init(cfg.LogCfg)
+	if err != nil {
+		fmt.Println("Version: ", version.Version, "Commit Hash: ", version.CommitHash)
+		fmt.Println("Fatal error: cannot start logger", err)
+		os.Exit(1)
+	}
+	err = runner.RunImport(cfg)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// runExport runs the export subcommand with args, the arguments following it.
+func runExport(args []string) {
+	cfg, err := runner.ParseExportFlags(&runner.ExportConfig{}, args)
+	if err != nil {
+		fmt.Println("Version: ", version.Version, "Commit Hash: ", version.CommitHash)
+		fmt.Println("Fatal error: cannot parse flags ", err)
+		os.Exit(1)
+	}
+	err = log.Init(cfg.LogCfg)
+	if err != nil {
+		fmt.Println("Version: ", version.Version, "Commit Hash: ", version.CommitHash)
+		fmt.Println("Fatal error: cannot start logger", err)
+		os.Exit(1)
+	}
+	err = runner.RunExport(cfg)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// runMigrate runs the migrate subcommand with args, the arguments following
+// it.
+func runMigrate(args []string) {
+	cfg, err := runner.ParseMigrateFlags(&runner.MigrateConfig{}, args)
+	if err != nil {
+		fmt.Println("Version: ", version.Version, "Commit Hash: ", version.CommitHash)
+		fmt.Println("Fatal error: cannot parse flags ", err)
+		os.Exit(1)
+	}
+	err = log.Init(cfg.LogCfg)
+	if err != nil {
+		fmt.Println("Version: ", version.Version, "Commit Hash: ", version.CommitHash)
+		fmt.Println("Fatal error: cannot start logger", err)
+		os.Exit(1)
+	}
+	err = runner.RunMigrate(cfg)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
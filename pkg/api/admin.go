@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+var errAdminDisabled = errors.New("admin APIs disabled")
+
+// SeriesDeleter deletes the samples of the series matching label matchers.
+type SeriesDeleter interface {
+	DeleteSeries(tenant string, mint, maxt int64, dropEmptySeries bool, matcherSets ...[]*labels.Matcher) (pgmodel.DeleteResult, error)
+}
+
+// DeleteSeries serves the delete_series endpoint of the Prometheus admin API.
+// The samples are deleted right away, so there are no tombstones to clean.
+// The drop_empty_series parameter, not part of the Prometheus API, deletes
+// the matching series left without samples along with their labels.
+func DeleteSeries(conf *Config, deleter SeriesDeleter) http.Handler {
+	return corsWrapper(conf, deleteSeriesHandler(conf, deleter))
+}
+
+func deleteSeriesHandler(conf *Config, deleter SeriesDeleter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !conf.AdminAPIEnabled {
+			respondError(w, http.StatusServiceUnavailable, errAdminDisabled, "unavailable")
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			respondError(w, http.StatusBadRequest, errors.Wrap(err, "error parsing form values"), "bad_data")
+			return
+		}
+
+		if len(r.Form["match[]"]) == 0 {
+			respondError(w, http.StatusBadRequest, errors.New("no match[] parameter provided"), "bad_data")
+			return
+		}
+
+		start, err := parseTimeParam(r, "start", minTime)
+		if err != nil {
+			log.Info("msg", "Delete bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		end, err := parseTimeParam(r, "end", maxTime)
+		if err != nil {
+			log.Info("msg", "Delete bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		if end.Before(start) {
+			err := errors.New("end timestamp must not be before start time")
+			log.Info("msg", "Delete bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+
+		dropEmptySeries := false
+		if v := r.FormValue("drop_empty_series"); v != "" {
+			if dropEmptySeries, err = strconv.ParseBool(v); err != nil {
+				respondError(w, http.StatusBadRequest, errors.Wrap(err, "invalid drop_empty_series parameter"), "bad_data")
+				return
+			}
+		}
+
+		var matcherSets [][]*labels.Matcher
+		for _, s := range r.Form["match[]"] {
+			matchers, err := parser.ParseMetricSelector(s)
+			if err != nil {
+				respondError(w, http.StatusBadRequest, err, "bad_data")
+				return
+			}
+			matcherSets = append(matcherSets, matchers)
+		}
+
+		tenant := tenancy.FromContext(r.Context())
+		_, err = deleter.DeleteSeries(tenant, timestamp.FromTime(start), timestamp.FromTime(end), dropEmptySeries, matcherSets...)
+		if err != nil {
+			log.Error("msg", "Error deleting series", "err", err)
+			respondError(w, http.StatusInternalServerError, err, "internal")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// CleanTombstones serves the clean_tombstones endpoint of the Prometheus
+// admin API. Deletions don't leave tombstones behind, so it does nothing.
+func CleanTombstones(conf *Config) http.Handler {
+	return corsWrapper(conf, func(w http.ResponseWriter, r *http.Request) {
+		if !conf.AdminAPIEnabled {
+			respondError(w, http.StatusServiceUnavailable, errAdminDisabled, "unavailable")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
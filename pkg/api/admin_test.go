@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+type mockSeriesDeleter struct {
+	err             error
+	tenant          string
+	mint, maxt      int64
+	dropEmptySeries bool
+	matcherSets     [][]*labels.Matcher
+}
+
+func (m *mockSeriesDeleter) DeleteSeries(tenant string, mint, maxt int64, dropEmptySeries bool, matcherSets ...[]*labels.Matcher) (pgmodel.DeleteResult, error) {
+	m.tenant = tenant
+	m.mint, m.maxt = mint, maxt
+	m.dropEmptySeries = dropEmptySeries
+	m.matcherSets = matcherSets
+	return pgmodel.DeleteResult{}, m.err
+}
+
+func TestDeleteSeries(t *testing.T) {
+	testCases := []struct {
+		name            string
+		disabled        bool
+		form            url.Values
+		tenant          string
+		err             error
+		expectCode      int
+		expectError     string
+		mint, maxt      int64
+		dropEmptySeries bool
+		matcherSets     [][]*labels.Matcher
+	}{
+		{
+			name:        "admin API disabled",
+			disabled:    true,
+			form:        url.Values{"match[]": {"foo"}},
+			expectCode:  http.StatusServiceUnavailable,
+			expectError: "unavailable",
+		}, {
+			name:        "no match",
+			form:        url.Values{},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "invalid match",
+			form:        url.Values{"match[]": {"foo{"}},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "end before start",
+			form:        url.Values{"match[]": {"foo"}, "start": {"2"}, "end": {"1"}},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "invalid drop_empty_series",
+			form:        url.Values{"match[]": {"foo"}, "drop_empty_series": {"maybe"}},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "deletion error",
+			form:        url.Values{"match[]": {"foo"}},
+			err:         fmt.Errorf("some error"),
+			expectCode:  http.StatusInternalServerError,
+			expectError: "internal",
+		}, {
+			name: "delete",
+			form: url.Values{
+				"match[]":           {`foo{job="a"}`, "bar"},
+				"start":             {"1"},
+				"end":               {"2"},
+				"drop_empty_series": {"true"},
+			},
+			tenant:          "tenant-a",
+			expectCode:      http.StatusNoContent,
+			mint:            1000,
+			maxt:            2000,
+			dropEmptySeries: true,
+			matcherSets: [][]*labels.Matcher{
+				{
+					labels.MustNewMatcher(labels.MatchEqual, "job", "a"),
+					labels.MustNewMatcher(labels.MatchEqual, labels.MetricName, "foo"),
+				},
+				{labels.MustNewMatcher(labels.MatchEqual, labels.MetricName, "bar")},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.tenant != "" {
+				ctx = tenancy.WithTenant(ctx, tc.tenant)
+			}
+			req, err := http.NewRequestWithContext(ctx, "POST", "http://localhost:9090/api/v1/admin/tsdb/delete_series", strings.NewReader(tc.form.Encode()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			deleter := &mockSeriesDeleter{err: tc.err}
+			deleteSeriesHandler(&Config{AdminAPIEnabled: !tc.disabled}, deleter).ServeHTTP(w, req)
+
+			if w.Code != tc.expectCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, tc.expectCode)
+			}
+			if tc.expectError != "" {
+				var er errResponse
+				if err := json.NewDecoder(w.Body).Decode(&er); err != nil {
+					t.Fatal(err)
+				}
+				if er.ErrorType != tc.expectError {
+					t.Errorf("Unexpected error type: got %s wanted %s", er.ErrorType, tc.expectError)
+				}
+				return
+			}
+			if deleter.tenant != tc.tenant || deleter.mint != tc.mint || deleter.maxt != tc.maxt || deleter.dropEmptySeries != tc.dropEmptySeries {
+				t.Errorf("Unexpected deletion: %+v", deleter)
+			}
+			if !reflect.DeepEqual(deleter.matcherSets, tc.matcherSets) {
+				t.Errorf("Unexpected matchers: got %v wanted %v", deleter.matcherSets, tc.matcherSets)
+			}
+		})
+	}
+}
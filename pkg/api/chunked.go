@@ -0,0 +1,71 @@
+package api
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+)
+
+// The table gets initialized with sync.Once but may still cause a race
+// with any other use of the crc32 package anywhere. Thus we initialize it
+// before.
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// chunkedWriter is an io.Writer wrapper framing every write the way
+// Prometheus expects for STREAMED_XOR_CHUNKS remote-read responses. Each
+// frame consists of:
+//
+// 1. uvarint for the size of the data frame.
+// 2. big-endian uint32 for the Castagnoli polynomial CRC-32 checksum of the data frame.
+// 3. the bytes of the given data.
+//
+// Every frame is flushed to the client as soon as it is written.
+// We cannot reuse the Prometheus implementation, storage/remote registers its
+// own copy of the prompb types.
+type chunkedWriter struct {
+	writer  io.Writer
+	flusher http.Flusher
+	// started is set once a frame was written, after which the response
+	// can't be replaced by an error
+	started bool
+
+	crc32 hash.Hash32
+}
+
+func newChunkedWriter(w io.Writer, f http.Flusher) *chunkedWriter {
+	return &chunkedWriter{writer: w, flusher: f, crc32: crc32.New(castagnoliTable)}
+}
+
+// Write writes b as a single frame and flushes it. The returned number of
+// bytes does not include the delimiter and checksum bytes.
+func (w *chunkedWriter) Write(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	w.started = true
+	var buf [binary.MaxVarintLen64]byte
+	v := binary.PutUvarint(buf[:], uint64(len(b)))
+	if _, err := w.writer.Write(buf[:v]); err != nil {
+		return 0, err
+	}
+
+	w.crc32.Reset()
+	if _, err := w.crc32.Write(b); err != nil {
+		return 0, err
+	}
+
+	if err := binary.Write(w.writer, binary.BigEndian, w.crc32.Sum32()); err != nil {
+		return 0, err
+	}
+
+	n, err := w.writer.Write(b)
+	if err != nil {
+		return n, err
+	}
+
+	w.flusher.Flush()
+	return n, nil
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/prometheus/prometheus/util/httputil"
+	"github.com/timescale/promscale/pkg/pgmodel"
 	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/query"
 )
 
 var (
@@ -28,6 +30,22 @@ var (
 
 type Config struct {
 	AllowedOrigin *regexp.Regexp
+	// MultiTenancy requires every request to name its tenant in the
+	// TenantHeader header, and scopes all writes and reads to it.
+	MultiTenancy bool
+	TenantHeader string
+	// QueryEngine bounds the resources used by PromQL queries.
+	QueryEngine query.EngineConfig
+	// ResultsCache caches the results of range queries. Range queries are
+	// executed in full every time when it is nil.
+	ResultsCache *query.ResultsCache
+	// AdminAPIEnabled serves the endpoints of the Prometheus admin API
+	// deleting data. They respond with 503 otherwise.
+	AdminAPIEnabled bool
+	// ExplainAnalyzeEnabled lets the explain endpoint run the statements of
+	// queries with EXPLAIN ANALYZE. Requests asking to are rejected with 503
+	// otherwise.
+	ExplainAnalyzeEnabled bool
 }
 
 func corsWrapper(conf *Config, f http.HandlerFunc) http.HandlerFunc {
@@ -93,6 +111,31 @@ func respondError(w http.ResponseWriter, status int, err error, errType string)
 	})
 }
 
+// respondExecError responds with the Prometheus API error matching the
+// error of a query execution.
+func respondExecError(w http.ResponseWriter, err error) {
+	var (
+		canceled       promql.ErrQueryCanceled
+		timeout        promql.ErrQueryTimeout
+		tooManyQueries promql.ErrTooManyQueries
+		storageErr     promql.ErrStorage
+	)
+	switch {
+	case errors.As(err, &canceled):
+		respondError(w, http.StatusServiceUnavailable, err, "canceled")
+	case errors.As(err, &timeout), pgmodel.IsStatementTimeout(err):
+		respondError(w, http.StatusServiceUnavailable, err, "timeout")
+	case errors.As(err, &tooManyQueries):
+		respondError(w, http.StatusServiceUnavailable, err, "unavailable")
+	case errors.As(err, &storageErr):
+		respondError(w, http.StatusInternalServerError, err, "internal")
+	default:
+		// going over the samples or read limits is an execution error, as
+		// in Prometheus
+		respondError(w, http.StatusUnprocessableEntity, err, "execution")
+	}
+}
+
 type errResponse struct {
 	Status    string `json:"status"`
 	ErrorType string `json:"errorType"`
@@ -2,13 +2,20 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"regexp"
 	"testing"
 
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/pkg/errors"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/promql"
 )
 
 func TestCORSWrapper(t *testing.T) {
@@ -109,3 +116,65 @@ func doCORSWrapperRequest(t *testing.T, queryHandler http.Handler, url, origin s
 	queryHandler.ServeHTTP(w, req)
 	return w
 }
+
+func TestRespondExecError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		expectCode int
+		expectType string
+	}{
+		{
+			name:       "canceled",
+			err:        promql.ErrQueryCanceled("query queue"),
+			expectCode: http.StatusServiceUnavailable,
+			expectType: "canceled",
+		}, {
+			name:       "timeout",
+			err:        promql.ErrQueryTimeout("query execution"),
+			expectCode: http.StatusServiceUnavailable,
+			expectType: "timeout",
+		}, {
+			name:       "statement timeout",
+			err:        errors.Wrap(&pgconn.PgError{Code: pgerrcode.QueryCanceled}, "expanding series"),
+			expectCode: http.StatusServiceUnavailable,
+			expectType: "timeout",
+		}, {
+			name:       "too many queries",
+			err:        promql.ErrTooManyQueries("query queue"),
+			expectCode: http.StatusServiceUnavailable,
+			expectType: "unavailable",
+		}, {
+			name:       "storage error",
+			err:        promql.ErrStorage{Err: fmt.Errorf("connection refused")},
+			expectCode: http.StatusInternalServerError,
+			expectType: "internal",
+		}, {
+			name:       "too many samples",
+			err:        promql.ErrTooManySamples("query execution"),
+			expectCode: http.StatusUnprocessableEntity,
+			expectType: "execution",
+		}, {
+			name:       "query limit",
+			err:        errors.Wrap(pgmodel.ErrQueryLimit("too many series"), "expanding series"),
+			expectCode: http.StatusUnprocessableEntity,
+			expectType: "execution",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			respondExecError(w, tc.err)
+			if w.Code != tc.expectCode {
+				t.Errorf("unexpected status code: got %d, wanted %d", w.Code, tc.expectCode)
+			}
+			var resp errResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			if resp.ErrorType != tc.expectType || resp.Error != tc.err.Error() {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/query"
+)
+
+var errAnalyzeDisabled = errors.New("EXPLAIN ANALYZE disabled")
+
+type explainData struct {
+	Selectors []pgmodel.SelectorPlan `json:"selectors"`
+}
+
+func Explain(conf *Config, queryEngine *promql.Engine, queryable *query.Queryable) http.Handler {
+	hf := corsWrapper(conf, explainHandler(conf, queryEngine, queryable))
+	return gziphandler.GzipHandler(hf)
+}
+
+// explainHandler returns the SQL statements run for every selector of a
+// query, without evaluating it. The query is a range query if a start is
+// given, an instant query otherwise.
+func explainHandler(conf *Config, queryEngine *promql.Engine, queryable *query.Queryable) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			start, end time.Time
+			step       time.Duration
+			err        error
+		)
+		if r.FormValue("start") != "" {
+			start, end, step, err = parseRangeParams(r)
+		} else {
+			start, err = parseTimeParam(r, "time", time.Now())
+			end = start
+		}
+		if err != nil {
+			log.Info("msg", "Explain bad request: "+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+
+		analyze := false
+		if a := r.FormValue("analyze"); a != "" {
+			if analyze, err = strconv.ParseBool(a); err != nil {
+				respondError(w, http.StatusBadRequest, errors.Wrap(err, "param analyze"), "bad_data")
+				return
+			}
+		}
+		if analyze && !conf.ExplainAnalyzeEnabled {
+			respondError(w, http.StatusServiceUnavailable, errAnalyzeDisabled, "unavailable")
+			return
+		}
+
+		qs := r.FormValue("query")
+		if _, err = parser.ParseExpr(qs); err != nil {
+			log.Info("msg", "Explain parse error: "+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+
+		plans, err := query.Explain(r.Context(), queryEngine, queryable, qs, start, end, step, analyze)
+		if err != nil {
+			log.Error("msg", err, "endpoint", "explain")
+			respondExecError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data:   &explainData{Selectors: plans},
+		})
+	}
+}
+
+// parseRangeParams parses the start, end and step of a range query.
+func parseRangeParams(r *http.Request) (start, end time.Time, step time.Duration, err error) {
+	if start, err = parseTime(r.FormValue("start")); err != nil {
+		return
+	}
+	if end, err = parseTime(r.FormValue("end")); err != nil {
+		return
+	}
+	if end.Before(start) {
+		err = errors.New("end timestamp must not be before start time")
+		return
+	}
+	if step, err = parseDuration(r.FormValue("step")); err != nil {
+		err = errors.Wrap(err, "param step")
+		return
+	}
+	if step <= 0 {
+		err = errors.New("zero or negative query resolution step widths are not accepted. Try a positive integer")
+	}
+	return
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/query"
+)
+
+// explainingQuerier explains every select with a plan holding its hints.
+type explainingQuerier struct {
+	mockQuerier
+	analyzed bool
+}
+
+func (m *explainingQuerier) WithContext(context.Context) pgmodel.Querier {
+	return m
+}
+
+func (m *explainingQuerier) ExplainSelect(_ context.Context, mint, maxt int64, hints *storage.SelectHints, path []parser.Node, analyze bool, ms ...*labels.Matcher) (pgmodel.SelectorPlan, parser.Node, error) {
+	m.analyzed = analyze
+	return pgmodel.SelectorPlan{
+		Selector:   ms[0].Value,
+		Statements: []pgmodel.ExplainedStatement{{SQL: hints.Func, Args: []interface{}{float64(hints.Range)}}},
+	}, nil, nil
+}
+
+func TestExplain(t *testing.T) {
+	_ = log.Init(log.Config{
+		Level: "debug",
+	})
+	testCases := []struct {
+		name          string
+		params        url.Values
+		expectCode    int
+		expectPlans   []pgmodel.SelectorPlan
+		expectAnalyze bool
+		analyzeOff    bool
+	}{
+		{
+			name:       "Unparsable query",
+			params:     url.Values{"query": {"rate(foo"}},
+			expectCode: http.StatusBadRequest,
+		}, {
+			name:       "Missing step",
+			params:     url.Values{"query": {"foo"}, "start": {"1"}, "end": {"2"}},
+			expectCode: http.StatusBadRequest,
+		}, {
+			name:       "Unparsable analyze",
+			params:     url.Values{"query": {"foo"}, "analyze": {"maybe"}},
+			expectCode: http.StatusBadRequest,
+		}, {
+			name:       "Analyze disabled",
+			params:     url.Values{"query": {"foo"}, "analyze": {"true"}},
+			analyzeOff: true,
+			expectCode: http.StatusServiceUnavailable,
+		}, {
+			name:       "Instant query",
+			params:     url.Values{"query": {"rate(foo[5m]) + bar"}, "time": {"1000"}},
+			expectCode: http.StatusOK,
+			expectPlans: []pgmodel.SelectorPlan{
+				{Selector: "foo", Statements: []pgmodel.ExplainedStatement{{SQL: "rate", Args: []interface{}{float64(300000)}}}},
+				{Selector: "bar", Statements: []pgmodel.ExplainedStatement{{SQL: "", Args: []interface{}{float64(0)}}}},
+			},
+		}, {
+			name:          "Range query with analyze",
+			params:        url.Values{"query": {"sum(foo)"}, "start": {"1000"}, "end": {"2000"}, "step": {"10"}, "analyze": {"true"}},
+			expectCode:    http.StatusOK,
+			expectAnalyze: true,
+			expectPlans: []pgmodel.SelectorPlan{
+				{Selector: "foo", Statements: []pgmodel.ExplainedStatement{{SQL: "sum", Args: []interface{}{float64(0)}}}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			querier := &explainingQuerier{}
+			engine := promql.NewEngine(
+				promql.EngineOpts{
+					Logger:     log.GetLogger(),
+					Reg:        prometheus.NewRegistry(),
+					MaxSamples: math.MaxInt32,
+				},
+			)
+			handler := explainHandler(&Config{ExplainAnalyzeEnabled: !tc.analyzeOff}, engine, query.NewQueryable(querier))
+			req := httptest.NewRequest("POST", "/api/v1/explain", strings.NewReader(tc.params.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tc.expectCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d: %s", w.Code, tc.expectCode, w.Body.String())
+			}
+			if tc.expectCode != http.StatusOK {
+				return
+			}
+			var resp struct {
+				Data explainData `json:"data"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(resp.Data.Selectors, tc.expectPlans) {
+				t.Errorf("unexpected plans:\ngot\n\t%+v\nwanted\n\t%+v", resp.Data.Selectors, tc.expectPlans)
+			}
+			if querier.analyzed != tc.expectAnalyze {
+				t.Errorf("unexpected analyze: %v", querier.analyzed)
+			}
+		})
+	}
+}
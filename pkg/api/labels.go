@@ -1,7 +1,6 @@
 package api
 
 import (
-	"context"
 	"encoding/json"
 	"math"
 	"net/http"
@@ -31,7 +30,7 @@ func Labels(conf *Config, queryable *query.Queryable) http.Handler {
 
 func labelsHandler(queryable *query.Queryable) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		querier, err := queryable.Querier(context.Background(), math.MinInt64, math.MaxInt64)
+		querier, err := queryable.Querier(r.Context(), math.MinInt64, math.MaxInt64)
 		if err != nil {
 			respondError(w, http.StatusInternalServerError, err, "internal")
 			return
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/timescale/promscale/pkg/pgmodel"
+)
+
+func Metadata(conf *Config, reader pgmodel.Reader) http.Handler {
+	hf := corsWrapper(conf, metadataHandler(reader))
+	return gziphandler.GzipHandler(hf)
+}
+
+func metadataHandler(reader pgmodel.Reader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := -1
+		if s := r.FormValue("limit"); s != "" {
+			var err error
+			if limit, err = strconv.Atoi(s); err != nil {
+				respondError(w, http.StatusBadRequest, fmt.Errorf("limit must be a number"), "bad_data")
+				return
+			}
+		}
+
+		metadata, err := tenantReader(r, reader).Metadata(r.FormValue("metric"), limit)
+		if err != nil {
+			respondError(w, http.StatusInternalServerError, err, "internal")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data:   metadata,
+		})
+	}
+}
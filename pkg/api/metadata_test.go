@@ -0,0 +1,102 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel"
+)
+
+type mockMetadataReader struct {
+	pgmodel.Reader
+	metadata map[string][]pgmodel.MetricMetadata
+	err      error
+	metric   string
+	limit    int
+}
+
+func (m *mockMetadataReader) Metadata(metric string, limit int) (map[string][]pgmodel.MetricMetadata, error) {
+	m.metric = metric
+	m.limit = limit
+	return m.metadata, m.err
+}
+
+func TestMetadata(t *testing.T) {
+	metadata := map[string][]pgmodel.MetricMetadata{
+		"http_requests_total": {{Type: "counter", Help: "Total HTTP requests", Unit: ""}},
+	}
+	testCases := []struct {
+		name        string
+		query       string
+		reader      *mockMetadataReader
+		expectCode  int
+		expectError string
+		metric      string
+		limit       int
+	}{
+		{
+			name:       "All metadata",
+			reader:     &mockMetadataReader{metadata: metadata},
+			expectCode: http.StatusOK,
+			limit:      -1,
+		}, {
+			name:       "Single metric with limit",
+			query:      "?metric=http_requests_total&limit=1",
+			reader:     &mockMetadataReader{metadata: metadata},
+			expectCode: http.StatusOK,
+			metric:     "http_requests_total",
+			limit:      1,
+		}, {
+			name:        "Invalid limit",
+			query:       "?limit=foo",
+			reader:      &mockMetadataReader{},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "Error on get metadata",
+			reader:      &mockMetadataReader{err: fmt.Errorf("error on metadata")},
+			expectCode:  http.StatusInternalServerError,
+			expectError: "internal",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), "GET", "http://localhost:9090/api/v1/metadata"+tc.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w := httptest.NewRecorder()
+			metadataHandler(tc.reader).ServeHTTP(w, req)
+
+			if w.Code != tc.expectCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, tc.expectCode)
+			}
+			if tc.expectError != "" {
+				var er errResponse
+				_ = json.NewDecoder(bytes.NewReader(w.Body.Bytes())).Decode(&er)
+				if tc.expectError != er.ErrorType {
+					t.Errorf("expected error of type %s, got %s", tc.expectError, er.ErrorType)
+				}
+				return
+			}
+			if tc.reader.metric != tc.metric || tc.reader.limit != tc.limit {
+				t.Errorf("unexpected parameters: got metric %q limit %d, wanted metric %q limit %d", tc.reader.metric, tc.reader.limit, tc.metric, tc.limit)
+			}
+
+			var res struct {
+				Status string                              `json:"status"`
+				Data   map[string][]pgmodel.MetricMetadata `json:"data"`
+			}
+			_ = json.NewDecoder(bytes.NewReader(w.Body.Bytes())).Decode(&res)
+			if !reflect.DeepEqual(res.Data, metadata) {
+				t.Errorf("expected: %v, got: %v", metadata, res.Data)
+			}
+		})
+	}
+}
@@ -27,6 +27,11 @@ type Metrics struct {
 	InvalidReadReqs     prometheus.Counter
 	InvalidWriteReqs    prometheus.Counter
 	HTTPRequestDuration *prometheus.HistogramVec
+	// Per-tenant ingestion counters, only updated when multi-tenancy is
+	// enabled.
+	TenantReceivedSamples *prometheus.CounterVec
+	TenantFailedSamples   *prometheus.CounterVec
+	TenantSentSamples     *prometheus.CounterVec
 }
 
 func InitMetrics() *Metrics {
@@ -117,6 +122,30 @@ func InitMetrics() *Metrics {
 			},
 			[]string{"path"},
 		),
+		TenantReceivedSamples: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: util.PromNamespace,
+				Name:      "tenant_received_samples_total",
+				Help:      "Total number of received samples per tenant.",
+			},
+			[]string{"tenant"},
+		),
+		TenantFailedSamples: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: util.PromNamespace,
+				Name:      "tenant_failed_samples_total",
+				Help:      "Total number of processed samples per tenant which failed on send to remote storage.",
+			},
+			[]string{"tenant"},
+		),
+		TenantSentSamples: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: util.PromNamespace,
+				Name:      "tenant_sent_samples_total",
+				Help:      "Total number of processed samples per tenant sent to remote storage.",
+			},
+			[]string{"tenant"},
+		),
 	}
 	prometheus.MustRegister(
 		metrics.LeaderGauge,
@@ -130,6 +159,9 @@ func InitMetrics() *Metrics {
 		metrics.SentBatchDuration,
 		metrics.QueryBatchDuration,
 		metrics.HTTPRequestDuration,
+		metrics.TenantReceivedSamples,
+		metrics.TenantFailedSamples,
+		metrics.TenantSentSamples,
 	)
 	metrics.WriteThroughput.Start()
 
@@ -12,11 +12,13 @@ import (
 )
 
 func Query(conf *Config, queryEngine *promql.Engine, queryable *query.Queryable) http.Handler {
-	hf := corsWrapper(conf, queryHandler(queryEngine, queryable))
+	hf := corsWrapper(conf, queryHandler(queryEngine, queryable, conf.QueryEngine.SlowQueryThreshold > 0))
 	return gziphandler.GzipHandler(hf)
 }
 
-func queryHandler(queryEngine *promql.Engine, queryable *query.Queryable) http.HandlerFunc {
+// queryHandler executes instant queries, recording the SQL statements they run
+// for the slow query log if recordStats is set.
+func queryHandler(queryEngine *promql.Engine, queryable *query.Queryable, recordStats bool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var ts time.Time
 		var err error
@@ -28,6 +30,9 @@ func queryHandler(queryEngine *promql.Engine, queryable *query.Queryable) http.H
 		}
 
 		ctx := r.Context()
+		if recordStats {
+			ctx = query.WithQueryStats(ctx)
+		}
 		if to := r.FormValue("timeout"); to != "" {
 			var cancel context.CancelFunc
 			timeout, err := parseDuration(to)
@@ -51,18 +56,7 @@ func queryHandler(queryEngine *promql.Engine, queryable *query.Queryable) http.H
 		res := qry.Exec(ctx)
 		if res.Err != nil {
 			log.Error("msg", res.Err, "endpoint", "query")
-			switch res.Err.(type) {
-			case promql.ErrQueryCanceled:
-				respondError(w, http.StatusServiceUnavailable, res.Err, "canceled")
-				return
-			case promql.ErrQueryTimeout:
-				respondError(w, http.StatusServiceUnavailable, res.Err, "timeout")
-				return
-			case promql.ErrStorage:
-				respondError(w, http.StatusInternalServerError, res.Err, "internal")
-				return
-			}
-			respondError(w, http.StatusUnprocessableEntity, res.Err, "execution")
+			respondExecError(w, res.Err)
 			return
 		}
 
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+)
+
+// exemplarSeries and exemplar follow the format of the Prometheus HTTP API.
+type exemplarSeries struct {
+	SeriesLabels labels.Labels `json:"seriesLabels"`
+	Exemplars    []exemplar    `json:"exemplars"`
+}
+
+type exemplar struct {
+	Labels    labels.Labels `json:"labels"`
+	Value     string        `json:"value"`
+	Timestamp float64       `json:"timestamp"`
+}
+
+func QueryExemplars(conf *Config, reader pgmodel.Reader) http.Handler {
+	hf := corsWrapper(conf, queryExemplarsHandler(reader))
+	return gziphandler.GzipHandler(hf)
+}
+
+func queryExemplarsHandler(reader pgmodel.Reader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start, err := parseTimeParam(r, "start", minTime)
+		if err != nil {
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		end, err := parseTimeParam(r, "end", maxTime)
+		if err != nil {
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		if end.Before(start) {
+			err := errors.New("end timestamp must not be before start time")
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+
+		expr, err := parser.ParseExpr(r.FormValue("query"))
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+
+		results, err := tenantReader(r, reader).Exemplars(timestamp.FromTime(start), timestamp.FromTime(end), extractSelectors(expr)...)
+		if err != nil {
+			respondError(w, http.StatusUnprocessableEntity, err, "execution")
+			return
+		}
+
+		data := make([]exemplarSeries, 0, len(results))
+		for _, res := range results {
+			series := exemplarSeries{
+				SeriesLabels: res.SeriesLabels,
+				Exemplars:    make([]exemplar, 0, len(res.Exemplars)),
+			}
+			for _, e := range res.Exemplars {
+				series.Exemplars = append(series.Exemplars, exemplar{
+					Labels:    e.Labels,
+					Value:     strconv.FormatFloat(e.Value, 'f', -1, 64),
+					Timestamp: float64(e.Timestamp) / 1000,
+				})
+			}
+			data = append(data, series)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data:   data,
+		})
+	}
+}
+
+// extractSelectors returns the label matchers of every vector selector of
+// the expression.
+func extractSelectors(expr parser.Expr) [][]*labels.Matcher {
+	var selectors [][]*labels.Matcher
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if vs, ok := node.(*parser.VectorSelector); ok {
+			selectors = append(selectors, vs.LabelMatchers)
+		}
+		return nil
+	})
+	return selectors
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel"
+)
+
+type mockExemplarReader struct {
+	pgmodel.Reader
+	results     []pgmodel.ExemplarQueryResult
+	err         error
+	start       int64
+	end         int64
+	matcherSets [][]*labels.Matcher
+}
+
+func (m *mockExemplarReader) Exemplars(start, end int64, matcherSets ...[]*labels.Matcher) ([]pgmodel.ExemplarQueryResult, error) {
+	m.start = start
+	m.end = end
+	m.matcherSets = matcherSets
+	return m.results, m.err
+}
+
+func (m *mockExemplarReader) ForTenant(string) pgmodel.Reader {
+	return m
+}
+
+func TestQueryExemplars(t *testing.T) {
+	results := []pgmodel.ExemplarQueryResult{{
+		SeriesLabels: labels.FromStrings("__name__", "latency_bucket", "le", "0.5"),
+		Exemplars: []pgmodel.Exemplar{{
+			Labels:    labels.FromStrings("trace_id", "abc"),
+			Value:     0.25,
+			Timestamp: 1600096945479,
+		}},
+	}}
+	expected := []exemplarSeries{{
+		SeriesLabels: labels.FromStrings("__name__", "latency_bucket", "le", "0.5"),
+		Exemplars: []exemplar{{
+			Labels:    labels.FromStrings("trace_id", "abc"),
+			Value:     "0.25",
+			Timestamp: 1600096945.479,
+		}},
+	}}
+	testCases := []struct {
+		name           string
+		query          string
+		reader         *mockExemplarReader
+		expectCode     int
+		expectError    string
+		expectStart    int64
+		expectEnd      int64
+		expectMatchers []string
+	}{
+		{
+			name:           "Single selector",
+			query:          "?query=latency_bucket&start=1&end=2",
+			reader:         &mockExemplarReader{results: results},
+			expectCode:     http.StatusOK,
+			expectStart:    1000,
+			expectEnd:      2000,
+			expectMatchers: []string{`[__name__="latency_bucket"]`},
+		}, {
+			name:        "Every selector of the query",
+			query:       `?query=sum(rate(a{job="x"}[5m]))/sum(rate(b[5m]))&start=1&end=2`,
+			reader:      &mockExemplarReader{results: results},
+			expectCode:  http.StatusOK,
+			expectStart: 1000,
+			expectEnd:   2000,
+			expectMatchers: []string{
+				`[job="x" __name__="a"]`,
+				`[__name__="b"]`,
+			},
+		}, {
+			name:        "Invalid query",
+			query:       "?query=sum(",
+			reader:      &mockExemplarReader{},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "Invalid time range",
+			query:       "?query=a&start=2&end=1",
+			reader:      &mockExemplarReader{},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "Error on get exemplars",
+			query:       "?query=a",
+			reader:      &mockExemplarReader{err: fmt.Errorf("error on exemplars")},
+			expectCode:  http.StatusUnprocessableEntity,
+			expectError: "execution",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), "GET", "http://localhost:9090/api/v1/query_exemplars"+tc.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w := httptest.NewRecorder()
+			queryExemplarsHandler(tc.reader).ServeHTTP(w, req)
+
+			if w.Code != tc.expectCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, tc.expectCode)
+			}
+			if tc.expectError != "" {
+				var er errResponse
+				_ = json.NewDecoder(bytes.NewReader(w.Body.Bytes())).Decode(&er)
+				if tc.expectError != er.ErrorType {
+					t.Errorf("expected error of type %s, got %s", tc.expectError, er.ErrorType)
+				}
+				return
+			}
+			if tc.reader.start != tc.expectStart || tc.reader.end != tc.expectEnd {
+				t.Errorf("unexpected time range: got %d-%d, wanted %d-%d", tc.reader.start, tc.reader.end, tc.expectStart, tc.expectEnd)
+			}
+			matchers := make([]string, 0, len(tc.reader.matcherSets))
+			for _, ms := range tc.reader.matcherSets {
+				matchers = append(matchers, fmt.Sprint(ms))
+			}
+			if !reflect.DeepEqual(matchers, tc.expectMatchers) {
+				t.Errorf("unexpected matchers: got %v, wanted %v", matchers, tc.expectMatchers)
+			}
+
+			var res struct {
+				Status string           `json:"status"`
+				Data   []exemplarSeries `json:"data"`
+			}
+			_ = json.NewDecoder(bytes.NewReader(w.Body.Bytes())).Decode(&res)
+			if !reflect.DeepEqual(res.Data, expected) {
+				t.Errorf("expected: %v, got: %v", expected, res.Data)
+			}
+		})
+	}
+}
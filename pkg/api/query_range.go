@@ -12,11 +12,14 @@ import (
 )
 
 func QueryRange(conf *Config, queryEngine *promql.Engine, queriable *query.Queryable) http.Handler {
-	hf := corsWrapper(conf, queryRange(queryEngine, queriable))
+	hf := corsWrapper(conf, queryRange(queryEngine, queriable, conf.ResultsCache, conf.QueryEngine.SlowQueryThreshold > 0))
 	return gziphandler.GzipHandler(hf)
 }
 
-func queryRange(queryEngine *promql.Engine, queriable *query.Queryable) http.HandlerFunc {
+// queryRange executes range queries, through the results cache if it isn't nil,
+// recording the SQL statements they run for the slow query log if recordStats
+// is set.
+func queryRange(queryEngine *promql.Engine, queriable *query.Queryable, cache *query.ResultsCache, recordStats bool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		start, err := parseTime(r.FormValue("start"))
 		if err != nil {
@@ -61,6 +64,9 @@ func queryRange(queryEngine *promql.Engine, queriable *query.Queryable) http.Han
 		}
 
 		ctx := r.Context()
+		if recordStats {
+			ctx = query.WithQueryStats(ctx)
+		}
 		if to := r.FormValue("timeout"); to != "" {
 			var cancel context.CancelFunc
 			timeout, err := parseDuration(to)
@@ -74,34 +80,31 @@ func queryRange(queryEngine *promql.Engine, queriable *query.Queryable) http.Han
 			defer cancel()
 		}
 
-		qry, err := queryEngine.NewRangeQuery(
-			queriable,
-			r.FormValue("query"),
-			start,
-			end,
-			step,
-		)
+		var res *promql.Result
+		if cache != nil {
+			res, err = cache.Exec(ctx, queryEngine, queriable, r.FormValue("query"), start, end, step)
+		} else {
+			var qry promql.Query
+			qry, err = queryEngine.NewRangeQuery(
+				queriable,
+				r.FormValue("query"),
+				start,
+				end,
+				step,
+			)
+			if err == nil {
+				res = qry.Exec(ctx)
+			}
+		}
 		if err != nil {
 			log.Info("msg", "Query parse error: "+err.Error())
 			respondError(w, http.StatusBadRequest, err, "bad_data")
 			return
 		}
 
-		res := qry.Exec(ctx)
 		if res.Err != nil {
 			log.Error("msg", res.Err, "endpoint", "query_range")
-			switch res.Err.(type) {
-			case promql.ErrQueryCanceled:
-				respondError(w, http.StatusServiceUnavailable, res.Err, "canceled")
-				return
-			case promql.ErrQueryTimeout:
-				respondError(w, http.StatusServiceUnavailable, res.Err, "timeout")
-				return
-			case promql.ErrStorage:
-				respondError(w, http.StatusInternalServerError, res.Err, "internal")
-				return
-			}
-			respondError(w, http.StatusUnprocessableEntity, res.Err, "execution")
+			respondExecError(w, res.Err)
 			return
 		}
 
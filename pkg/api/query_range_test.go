@@ -156,7 +156,7 @@ func TestRangedQuery(t *testing.T) {
 					Timeout:    timeout,
 				},
 			)
-			handler := queryRange(engine, query.NewQueryable(tc.querier))
+			handler := queryRange(engine, query.NewQueryable(tc.querier), nil, false)
 			queryUrl := constructRangedQuery(tc.metric, tc.start, tc.end, tc.step, tc.timeout)
 			w := doRangedQuery(t, handler, queryUrl, tc.canceled)
 
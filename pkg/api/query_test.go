@@ -64,6 +64,10 @@ func (m mockQuerier) Query(*prompb.Query) ([]*prompb.TimeSeries, error) {
 	panic("implement me")
 }
 
+func (m mockQuerier) StreamQuery(*prompb.Query, func(*prompb.TimeSeries) error) error {
+	panic("implement me")
+}
+
 func (m mockQuerier) Select(int64, int64, bool, *storage.SelectHints, []parser.Node, ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
 	time.Sleep(m.timeToSleepOnSelect)
 	return &mockSeriesSet{err: m.selectErr}, nil
@@ -77,6 +81,26 @@ func (m mockQuerier) LabelsCacheCapacity() int {
 	return 0
 }
 
+func (m mockQuerier) Metadata(string, int) (map[string][]pgmodel.MetricMetadata, error) {
+	panic("implement me")
+}
+
+func (m mockQuerier) Exemplars(int64, int64, ...[]*labels.Matcher) ([]pgmodel.ExemplarQueryResult, error) {
+	panic("implement me")
+}
+
+func (m mockQuerier) ForTenant(string) pgmodel.Querier {
+	return m
+}
+
+func (m mockQuerier) WithStats(*pgmodel.QueryStats) pgmodel.Querier {
+	return m
+}
+
+func (m mockQuerier) WithContext(context.Context) pgmodel.Querier {
+	return m
+}
+
 func TestParseDuration(t *testing.T) {
 	testCase := []struct {
 		in          string
@@ -199,7 +223,7 @@ func TestQuery(t *testing.T) {
 					Timeout:    timeout,
 				},
 			)
-			handler := queryHandler(engine, query.NewQueryable(tc.querier))
+			handler := queryHandler(engine, query.NewQueryable(tc.querier), false)
 			queryURL := constructQuery(tc.metric, tc.time, tc.timeout)
 			w := doQuery(t, handler, queryURL, tc.canceled)
 
@@ -46,35 +46,94 @@ func Read(reader pgmodel.Reader, metrics *Metrics) http.Handler {
 		metrics.ReceivedQueries.Add(queryCount)
 		begin := time.Now()
 
-		var resp *prompb.ReadResponse
-		resp, err = reader.Read(&req)
+		responseType, err := negotiateResponseType(req.AcceptedResponseTypes)
 		if err != nil {
-			log.Warn("msg", "Error executing query", "query", req, "storage", "PostgreSQL", "err", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			log.Error("msg", "Response type negotiation error", "err", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			metrics.FailedQueries.Add(queryCount)
 			return
 		}
 
+		reader := tenantReader(r, reader)
+		if responseType == prompb.ReadRequest_STREAMED_XOR_CHUNKS {
+			streamRead(w, &req, reader, metrics, queryCount)
+		} else {
+			sampledRead(w, &req, reader, metrics, queryCount)
+		}
+
 		duration := time.Since(begin).Seconds()
 		metrics.QueryBatchDuration.Observe(duration)
+	})
+}
 
-		data, err := proto.Marshal(resp)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			metrics.FailedQueries.Add(queryCount)
+func sampledRead(w http.ResponseWriter, req *prompb.ReadRequest, reader pgmodel.Reader, metrics *Metrics, queryCount float64) {
+	resp, err := reader.Read(req)
+	if err != nil {
+		log.Warn("msg", "Error executing query", "query", req, "storage", "PostgreSQL", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		metrics.FailedQueries.Add(queryCount)
+		return
+	}
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		metrics.FailedQueries.Add(queryCount)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Encoding", "snappy")
+
+	compressed := snappy.Encode(nil, data)
+	if _, err := w.Write(compressed); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		metrics.FailedQueries.Add(queryCount)
+		return
+	}
+}
+
+func streamRead(w http.ResponseWriter, req *prompb.ReadRequest, reader pgmodel.Reader, metrics *Metrics, queryCount float64) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "internal http.ResponseWriter does not implement http.Flusher interface", http.StatusInternalServerError)
+		metrics.FailedQueries.Add(queryCount)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse")
+
+	// Once the first frame is written the status code is already sent, and
+	// writing an error would corrupt the stream, so an error past that point
+	// ends the stream where it stopped and is only logged.
+	cw := newChunkedWriter(w, f)
+	if err := reader.StreamRead(req, cw); err != nil {
+		metrics.FailedQueries.Add(queryCount)
+		if cw.started {
+			log.Error("msg", "Error executing streamed query, ending the stream", "query", req, "storage", "PostgreSQL", "err", err)
 			return
 		}
+		log.Warn("msg", "Error executing streamed query", "query", req, "storage", "PostgreSQL", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
 
-		w.Header().Set("Content-Type", "application/x-protobuf")
-		w.Header().Set("Content-Encoding", "snappy")
+// negotiateResponseType returns the first accepted response type we support.
+// An empty list means the client only understands SAMPLES, this keeps us
+// compatible with older Prometheus versions.
+func negotiateResponseType(accepted []prompb.ReadRequest_ResponseType) (prompb.ReadRequest_ResponseType, error) {
+	if len(accepted) == 0 {
+		return prompb.ReadRequest_SAMPLES, nil
+	}
 
-		compressed = snappy.Encode(nil, data)
-		if _, err := w.Write(compressed); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			metrics.FailedQueries.Add(queryCount)
-			return
+	for _, resType := range accepted {
+		switch resType {
+		case prompb.ReadRequest_SAMPLES, prompb.ReadRequest_STREAMED_XOR_CHUNKS:
+			return resType, nil
 		}
-	})
+	}
+	return 0, fmt.Errorf("server does not support any of the requested response types: %v", accepted)
 }
 
 func validateReadHeaders(w http.ResponseWriter, r *http.Request) bool {
@@ -1,7 +1,10 @@
 package api
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +12,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel"
 	"github.com/timescale/promscale/pkg/prompb"
 )
 
@@ -114,7 +119,24 @@ func readRequestToString(r *prompb.ReadRequest) string {
 type mockReader struct {
 	request  *prompb.ReadRequest
 	response *prompb.ReadResponse
+	frames   [][]byte
 	err      error
+	// streamErr is returned by StreamRead once the frames are written
+	streamErr error
+	tenant    string
+}
+
+func (m *mockReader) Exemplars(int64, int64, ...[]*labels.Matcher) ([]pgmodel.ExemplarQueryResult, error) {
+	panic("implement me")
+}
+
+func (m *mockReader) Metadata(string, int) (map[string][]pgmodel.MetricMetadata, error) {
+	panic("implement me")
+}
+
+func (m *mockReader) ForTenant(tenant string) pgmodel.Reader {
+	m.tenant = tenant
+	return m
 }
 
 func (m *mockReader) Read(r *prompb.ReadRequest) (*prompb.ReadResponse, error) {
@@ -122,6 +144,19 @@ func (m *mockReader) Read(r *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 	return m.response, m.err
 }
 
+func (m *mockReader) StreamRead(r *prompb.ReadRequest, w io.Writer) error {
+	m.request = r
+	if m.err != nil {
+		return m.err
+	}
+	for i := range m.frames {
+		if _, err := w.Write(m.frames[i]); err != nil {
+			return err
+		}
+	}
+	return m.streamErr
+}
+
 func GenerateReadHandleTester(t *testing.T, handleFunc http.Handler, badHeader bool) HandleTester {
 	return func(method string, body io.Reader) *httptest.ResponseRecorder {
 		req, err := http.NewRequest(method, "", body)
@@ -139,3 +174,116 @@ func GenerateReadHandleTester(t *testing.T, handleFunc http.Handler, badHeader b
 		return w
 	}
 }
+
+func TestStreamedRead(t *testing.T) {
+	frame, err := proto.Marshal(&prompb.ChunkedReadResponse{
+		ChunkedSeries: []*prompb.ChunkedSeries{
+			{
+				Labels: []prompb.Label{{Name: "__name__", Value: "foo"}},
+				Chunks: []prompb.Chunk{{MinTimeMs: 1, MaxTimeMs: 2, Type: prompb.Chunk_XOR, Data: []byte{0, 1}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name          string
+		responseCode  int
+		acceptedTypes []prompb.ReadRequest_ResponseType
+		readerErr     error
+		streamErr     error
+		expFrames     int
+	}{
+		{
+			name:          "unsupported response type",
+			responseCode:  http.StatusBadRequest,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{prompb.ReadRequest_ResponseType(42)},
+		},
+		{
+			name:          "reader error",
+			responseCode:  http.StatusInternalServerError,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{prompb.ReadRequest_STREAMED_XOR_CHUNKS},
+			readerErr:     fmt.Errorf("some error"),
+		},
+		{
+			name:          "error after the first frames",
+			responseCode:  http.StatusOK,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{prompb.ReadRequest_STREAMED_XOR_CHUNKS},
+			streamErr:     fmt.Errorf("some error"),
+			expFrames:     2,
+		},
+		{
+			name:          "happy path",
+			responseCode:  http.StatusOK,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{prompb.ReadRequest_STREAMED_XOR_CHUNKS, prompb.ReadRequest_SAMPLES},
+			expFrames:     2,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mockReader := &mockReader{
+				frames:    [][]byte{frame, frame},
+				err:       c.readerErr,
+				streamErr: c.streamErr,
+			}
+			metrics := &Metrics{
+				QueryBatchDuration: &mockMetric{},
+				FailedQueries:      &mockMetric{},
+				ReceivedQueries:    &mockMetric{},
+				InvalidReadReqs:    &mockMetric{},
+			}
+			handler := Read(mockReader, metrics)
+
+			test := GenerateReadHandleTester(t, handler, false)
+			w := test("POST", getReader(readRequestToString(&prompb.ReadRequest{
+				Queries:               []*prompb.Query{{}},
+				AcceptedResponseTypes: c.acceptedTypes,
+			})))
+
+			if w.Code != c.responseCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.responseCode)
+			}
+			if c.responseCode != http.StatusOK {
+				return
+			}
+
+			if ct := w.Header().Get("Content-Type"); ct != "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse" {
+				t.Errorf("unexpected content type %s", ct)
+			}
+
+			numFrames := 0
+			for w.Body.Len() > 0 {
+				var resp prompb.ChunkedReadResponse
+				if err := readFrame(w.Body, &resp); err != nil {
+					t.Fatal(err)
+				}
+				if len(resp.ChunkedSeries) != 1 || resp.ChunkedSeries[0].Labels[0].Value != "foo" {
+					t.Errorf("unexpected frame %v", resp)
+				}
+				numFrames++
+			}
+			if numFrames != c.expFrames {
+				t.Errorf("expected %d frames, got %d", c.expFrames, numFrames)
+			}
+		})
+	}
+}
+
+func readFrame(r *bytes.Buffer, pb proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	var checksum uint32
+	if err = binary.Read(r, binary.BigEndian, &checksum); err != nil {
+		return err
+	}
+	data := r.Next(int(size))
+	if crc32.Checksum(data, castagnoliTable) != checksum {
+		return fmt.Errorf("chunked: corrupted frame, checksum mismatch")
+	}
+	return proto.Unmarshal(data, pb)
+}
@@ -12,36 +12,63 @@ import (
 	"github.com/timescale/promscale/pkg/util"
 )
 
-func GenerateRouter(apiConf *Config, metrics *Metrics, client *pgclient.Client, elector *util.Elector) http.Handler {
+func GenerateRouter(apiConf *Config, metrics *Metrics, client *pgclient.Client, elector *util.Elector, rulesReader RulesReader) http.Handler {
 	router := route.New()
-	writeHandler := timeHandler(metrics.HTTPRequestDuration, "write", Write(client, elector, metrics))
+	// every endpoint but the health check is scoped to the tenant of the
+	// request when multi-tenancy is enabled
+	writeHandler := timeHandler(metrics.HTTPRequestDuration, "write", withTenant(apiConf, Write(client, elector, metrics)))
 	router.Post("/write", writeHandler)
 
-	readHandler := timeHandler(metrics.HTTPRequestDuration, "read", Read(client, metrics))
+	readHandler := timeHandler(metrics.HTTPRequestDuration, "read", withTenant(apiConf, Read(client, metrics)))
 	router.Get("/read", readHandler)
 	router.Post("/read", readHandler)
 
 	queryable := client.GetQueryable()
-	queryEngine := query.NewEngine(log.GetLogger(), time.Minute)
-	queryHandler := timeHandler(metrics.HTTPRequestDuration, "query", Query(apiConf, queryEngine, queryable))
+	queryEngine := query.NewEngine(log.GetLogger(), apiConf.QueryEngine)
+	queryHandler := timeHandler(metrics.HTTPRequestDuration, "query", withTenant(apiConf, Query(apiConf, queryEngine, queryable)))
 	router.Get("/api/v1/query", queryHandler)
 	router.Post("/api/v1/query", queryHandler)
 
-	queryRangeHandler := timeHandler(metrics.HTTPRequestDuration, "query_range", QueryRange(apiConf, queryEngine, queryable))
+	queryRangeHandler := timeHandler(metrics.HTTPRequestDuration, "query_range", withTenant(apiConf, QueryRange(apiConf, queryEngine, queryable)))
 	router.Get("/api/v1/query_range", queryRangeHandler)
 	router.Post("/api/v1/query_range", queryRangeHandler)
 
-	seriesHandler := timeHandler(metrics.HTTPRequestDuration, "series", Series(apiConf, queryable))
+	explainHandler := timeHandler(metrics.HTTPRequestDuration, "explain", withTenant(apiConf, Explain(apiConf, queryEngine, queryable)))
+	router.Get("/api/v1/explain", explainHandler)
+	router.Post("/api/v1/explain", explainHandler)
+
+	seriesHandler := timeHandler(metrics.HTTPRequestDuration, "series", withTenant(apiConf, Series(apiConf, queryable)))
 	router.Get("/api/v1/series", seriesHandler)
 	router.Post("/api/v1/series", seriesHandler)
 
-	labelsHandler := timeHandler(metrics.HTTPRequestDuration, "labels", Labels(apiConf, queryable))
+	labelsHandler := timeHandler(metrics.HTTPRequestDuration, "labels", withTenant(apiConf, Labels(apiConf, queryable)))
 	router.Get("/api/v1/labels", labelsHandler)
 	router.Post("/api/v1/labels", labelsHandler)
 
-	labelValuesHandler := timeHandler(metrics.HTTPRequestDuration, "label/:name/values", LabelValues(apiConf, queryable))
+	labelValuesHandler := timeHandler(metrics.HTTPRequestDuration, "label/:name/values", withTenant(apiConf, LabelValues(apiConf, queryable)))
 	router.Get("/api/v1/label/:name/values", labelValuesHandler)
 
+	metadataHandler := timeHandler(metrics.HTTPRequestDuration, "metadata", withTenant(apiConf, Metadata(apiConf, client)))
+	router.Get("/api/v1/metadata", metadataHandler)
+
+	queryExemplarsHandler := timeHandler(metrics.HTTPRequestDuration, "query_exemplars", withTenant(apiConf, QueryExemplars(apiConf, client)))
+	router.Get("/api/v1/query_exemplars", queryExemplarsHandler)
+	router.Post("/api/v1/query_exemplars", queryExemplarsHandler)
+
+	rulesHandler := timeHandler(metrics.HTTPRequestDuration, "rules", withTenant(apiConf, Rules(apiConf, rulesReader)))
+	router.Get("/api/v1/rules", rulesHandler)
+
+	alertsHandler := timeHandler(metrics.HTTPRequestDuration, "alerts", withTenant(apiConf, Alerts(apiConf, rulesReader)))
+	router.Get("/api/v1/alerts", alertsHandler)
+
+	deleteSeriesHandler := timeHandler(metrics.HTTPRequestDuration, "admin/tsdb/delete_series", withTenant(apiConf, DeleteSeries(apiConf, client)))
+	router.Post("/api/v1/admin/tsdb/delete_series", deleteSeriesHandler)
+	router.Put("/api/v1/admin/tsdb/delete_series", deleteSeriesHandler)
+
+	cleanTombstonesHandler := timeHandler(metrics.HTTPRequestDuration, "admin/tsdb/clean_tombstones", withTenant(apiConf, CleanTombstones(apiConf)))
+	router.Post("/api/v1/admin/tsdb/clean_tombstones", cleanTombstonesHandler)
+	router.Put("/api/v1/admin/tsdb/clean_tombstones", cleanTombstonesHandler)
+
 	router.Get("/healthz", Health(client))
 
 	return router
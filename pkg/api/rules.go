@@ -0,0 +1,210 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/rules"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+// RulesReader exposes the rules evaluated by the connector.
+type RulesReader interface {
+	RuleGroups() []*rules.Group
+	AlertingRules() []*rules.AlertingRule
+}
+
+// noRules is the RulesReader of a connector that doesn't evaluate rules.
+type noRules struct{}
+
+func (noRules) RuleGroups() []*rules.Group           { return nil }
+func (noRules) AlertingRules() []*rules.AlertingRule { return nil }
+
+type alert struct {
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	State       string        `json:"state"`
+	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
+	Value       string        `json:"value"`
+}
+
+type alertsData struct {
+	Alerts []*alert `json:"alerts"`
+}
+
+type ruleGroup struct {
+	Name           string        `json:"name"`
+	File           string        `json:"file"`
+	Rules          []interface{} `json:"rules"`
+	Interval       float64       `json:"interval"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+}
+
+type alertingRule struct {
+	State          string        `json:"state"`
+	Name           string        `json:"name"`
+	Query          string        `json:"query"`
+	Duration       float64       `json:"duration"`
+	Labels         labels.Labels `json:"labels"`
+	Annotations    labels.Labels `json:"annotations"`
+	Alerts         []*alert      `json:"alerts"`
+	Health         string        `json:"health"`
+	LastError      string        `json:"lastError,omitempty"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+	Type           string        `json:"type"`
+}
+
+type recordingRule struct {
+	Name           string        `json:"name"`
+	Query          string        `json:"query"`
+	Labels         labels.Labels `json:"labels,omitempty"`
+	Health         string        `json:"health"`
+	LastError      string        `json:"lastError,omitempty"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+	Type           string        `json:"type"`
+}
+
+type ruleDiscovery struct {
+	RuleGroups []*ruleGroup `json:"groups"`
+}
+
+func Rules(conf *Config, reader RulesReader) http.Handler {
+	if reader == nil {
+		reader = noRules{}
+	}
+	hf := corsWrapper(conf, rulesHandler(reader))
+	return gziphandler.GzipHandler(hf)
+}
+
+func rulesHandler(reader RulesReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		typ := r.FormValue("type")
+		if typ != "" && typ != "alert" && typ != "record" {
+			respondError(w, http.StatusBadRequest, fmt.Errorf("invalid type %q, must be alert or record", typ), "bad_data")
+			return
+		}
+		tenant := tenancy.FromContext(r.Context())
+
+		res := &ruleDiscovery{RuleGroups: []*ruleGroup{}}
+		for _, g := range reader.RuleGroups() {
+			// the groups of other tenants are hidden
+			if tenant != "" && g.Tenant() != "" && g.Tenant() != tenant {
+				continue
+			}
+			apiGroup := &ruleGroup{
+				Name:           g.Name(),
+				File:           g.File(),
+				Rules:          []interface{}{},
+				Interval:       g.Interval().Seconds(),
+				EvaluationTime: g.EvaluationDuration().Seconds(),
+				LastEvaluation: g.LastEvaluation(),
+			}
+			for _, rule := range g.Rules() {
+				lastError := ""
+				if err := rule.LastError(); err != nil {
+					lastError = err.Error()
+				}
+				switch rule := rule.(type) {
+				case *rules.AlertingRule:
+					if typ == "record" {
+						continue
+					}
+					apiGroup.Rules = append(apiGroup.Rules, alertingRule{
+						State:          rule.State().String(),
+						Name:           rule.Name(),
+						Query:          rule.Query(),
+						Duration:       rule.HoldDuration().Seconds(),
+						Labels:         rule.Labels(),
+						Annotations:    rule.Annotations(),
+						Alerts:         tenantAlerts(rule.ActiveAlerts(), tenant),
+						Health:         string(rule.Health()),
+						LastError:      lastError,
+						EvaluationTime: rule.EvaluationDuration().Seconds(),
+						LastEvaluation: rule.LastEvaluation(),
+						Type:           "alerting",
+					})
+				case *rules.RecordingRule:
+					if typ == "alert" {
+						continue
+					}
+					apiGroup.Rules = append(apiGroup.Rules, recordingRule{
+						Name:           rule.Name(),
+						Query:          rule.Query(),
+						Labels:         rule.Labels(),
+						Health:         string(rule.Health()),
+						LastError:      lastError,
+						EvaluationTime: rule.EvaluationDuration().Seconds(),
+						LastEvaluation: rule.LastEvaluation(),
+						Type:           "recording",
+					})
+				}
+			}
+			res.RuleGroups = append(res.RuleGroups, apiGroup)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data:   res,
+		})
+	}
+}
+
+func Alerts(conf *Config, reader RulesReader) http.Handler {
+	if reader == nil {
+		reader = noRules{}
+	}
+	hf := corsWrapper(conf, alertsHandler(reader))
+	return gziphandler.GzipHandler(hf)
+}
+
+func alertsHandler(reader RulesReader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tenant := tenancy.FromContext(r.Context())
+		res := &alertsData{Alerts: []*alert{}}
+		for _, rule := range reader.AlertingRules() {
+			res.Alerts = append(res.Alerts, tenantAlerts(rule.ActiveAlerts(), tenant)...)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data:   res,
+		})
+	}
+}
+
+// tenantAlerts converts the alerts raised for the series of tenant, hiding the
+// tenant label. All alerts are returned if tenant is empty.
+func tenantAlerts(alerts []*rules.Alert, tenant string) []*alert {
+	res := make([]*alert, 0, len(alerts))
+	for _, a := range alerts {
+		lset := a.Labels
+		if tenant != "" {
+			if lset.Get(pgmodel.TenantLabelName) != tenant {
+				continue
+			}
+			lset = labels.NewBuilder(lset).Del(pgmodel.TenantLabelName).Labels()
+		}
+		activeAt := a.ActiveAt
+		res = append(res, &alert{
+			Labels:      lset,
+			Annotations: a.Annotations,
+			State:       a.State.String(),
+			ActiveAt:    &activeAt,
+			Value:       strconv.FormatFloat(a.Value, 'e', -1, 64),
+		})
+	}
+	return res
+}
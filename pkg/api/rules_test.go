@@ -0,0 +1,162 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/rules"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+const testRuleFile = `
+groups:
+  - name: example
+    rules:
+      - record: job:up:sum
+        expr: sum by (job) (up)
+      - alert: InstanceDown
+        expr: up == 0
+        for: 5m
+        labels:
+          severity: page
+  - name: other
+    tenant: team-b
+    rules:
+      - record: up:sum
+        expr: sum(up)
+`
+
+func TestRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "rules.yml")
+	if err = ioutil.WriteFile(path, []byte(testRuleFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	manager, err := rules.NewManager([]string{path}, rules.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name  string
+		typ   string
+		code  int
+		types []string
+	}{
+		{name: "all rules", code: http.StatusOK, types: []string{"recording", "alerting"}},
+		{name: "alerting rules", typ: "alert", code: http.StatusOK, types: []string{"alerting"}},
+		{name: "recording rules", typ: "record", code: http.StatusOK, types: []string{"recording"}},
+		{name: "invalid type", typ: "foo", code: http.StatusBadRequest},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			// the groups of other tenants are hidden
+			r := httptest.NewRequest("GET", "/api/v1/rules?type="+c.typ, nil)
+			r = r.WithContext(tenancy.WithTenant(r.Context(), "team-a"))
+			Rules(&Config{}, manager).ServeHTTP(w, r)
+			if w.Code != c.code {
+				t.Fatalf("unexpected status code: got %d wanted %d", w.Code, c.code)
+			}
+			if c.code != http.StatusOK {
+				return
+			}
+
+			var res struct {
+				Data struct {
+					Groups []struct {
+						Name     string
+						Interval float64
+						Rules    []struct {
+							Name   string
+							Type   string
+							Health string
+						}
+					}
+				}
+			}
+			if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Data.Groups) != 1 || res.Data.Groups[0].Name != "example" || res.Data.Groups[0].Interval != 60 {
+				t.Fatalf("unexpected groups: %+v", res.Data.Groups)
+			}
+			types := []string{}
+			for _, r := range res.Data.Groups[0].Rules {
+				types = append(types, r.Type)
+				if r.Health != string(rules.HealthUnknown) {
+					t.Errorf("unexpected health of rule %s: %s", r.Name, r.Health)
+				}
+			}
+			if !reflect.DeepEqual(types, c.types) {
+				t.Errorf("unexpected rules: got %v wanted %v", types, c.types)
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	Rules(&Config{}, manager).ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/rules", nil))
+	var res struct {
+		Data struct {
+			Groups []struct{ Name string }
+		}
+	}
+	if err = json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Data.Groups) != 2 {
+		t.Errorf("all the groups should be listed without a tenant: %+v", res.Data.Groups)
+	}
+
+	// connectors without rules return empty lists
+	w = httptest.NewRecorder()
+	Alerts(&Config{}, nil).ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/alerts", nil))
+	if w.Code != http.StatusOK || w.Body.String() != `{"status":"success","data":{"alerts":[]}}`+"\n" {
+		t.Errorf("unexpected response: %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestTenantAlerts(t *testing.T) {
+	activeAt := time.Unix(10, 0)
+	alerts := []*rules.Alert{
+		{
+			State:    rules.StateFiring,
+			Labels:   labels.FromStrings("alertname", "a", pgmodel.TenantLabelName, "team-a"),
+			Value:    1,
+			ActiveAt: activeAt,
+		},
+		{
+			State:    rules.StatePending,
+			Labels:   labels.FromStrings("alertname", "b", pgmodel.TenantLabelName, "team-b"),
+			ActiveAt: activeAt,
+		},
+	}
+
+	if res := tenantAlerts(alerts, ""); len(res) != 2 {
+		t.Errorf("all alerts should be returned without multi-tenancy, got %d", len(res))
+	}
+
+	res := tenantAlerts(alerts, "team-a")
+	expected := []*alert{{
+		Labels:   labels.FromStrings("alertname", "a"),
+		State:    "firing",
+		ActiveAt: &activeAt,
+		Value:    "1e+00",
+	}}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("unexpected alerts:\ngot\n%+v\nwanted\n%+v", res[0], expected[0])
+	}
+}
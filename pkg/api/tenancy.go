@@ -0,0 +1,45 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+// withTenant rejects requests that don't name a valid tenant when
+// multi-tenancy is enabled, and passes the tenant on in the request context.
+func withTenant(conf *Config, handler http.Handler) http.Handler {
+	if !conf.MultiTenancy {
+		return handler
+	}
+	header := conf.TenantHeader
+	if header == "" {
+		header = tenancy.DefaultHeader
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant := r.Header.Get(header)
+		if tenant == "" {
+			err := fmt.Errorf("missing tenant header %s", header)
+			log.Info("msg", "Request rejected: "+err.Error())
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err := tenancy.Validate(tenant); err != nil {
+			log.Info("msg", "Request rejected: "+err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(tenancy.WithTenant(r.Context(), tenant)))
+	})
+}
+
+// tenantReader scopes reader to the tenant of the request, if any.
+func tenantReader(r *http.Request, reader pgmodel.Reader) pgmodel.Reader {
+	if tenant := tenancy.FromContext(r.Context()); tenant != "" {
+		return reader.ForTenant(tenant)
+	}
+	return reader
+}
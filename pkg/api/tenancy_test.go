@@ -0,0 +1,99 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+func TestWithTenant(t *testing.T) {
+	testCases := []struct {
+		name         string
+		conf         *Config
+		header       string
+		tenant       string
+		expectCode   int
+		expectTenant string
+	}{
+		{
+			name:       "Multi-tenancy disabled",
+			conf:       &Config{},
+			header:     tenancy.DefaultHeader,
+			tenant:     "team-a",
+			expectCode: http.StatusOK,
+		}, {
+			name:         "Default header",
+			conf:         &Config{MultiTenancy: true},
+			header:       tenancy.DefaultHeader,
+			tenant:       "team-a",
+			expectCode:   http.StatusOK,
+			expectTenant: "team-a",
+		}, {
+			name:         "Custom header",
+			conf:         &Config{MultiTenancy: true, TenantHeader: "X-Tenant"},
+			header:       "X-Tenant",
+			tenant:       "team-a",
+			expectCode:   http.StatusOK,
+			expectTenant: "team-a",
+		}, {
+			name:       "Missing tenant",
+			conf:       &Config{MultiTenancy: true},
+			expectCode: http.StatusUnauthorized,
+		}, {
+			name:       "Invalid tenant",
+			conf:       &Config{MultiTenancy: true},
+			header:     tenancy.DefaultHeader,
+			tenant:     "team\ta",
+			expectCode: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var tenant string
+			handler := withTenant(tc.conf, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tenant = tenancy.FromContext(r.Context())
+			}))
+			req := httptest.NewRequest("GET", "/api/v1/labels", nil)
+			if tc.header != "" {
+				req.Header.Set(tc.header, tc.tenant)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tc.expectCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, tc.expectCode)
+			}
+			if tenant != tc.expectTenant {
+				t.Errorf("unexpected tenant: got %q wanted %q", tenant, tc.expectTenant)
+			}
+		})
+	}
+}
+
+func TestReadForTenant(t *testing.T) {
+	reader := &mockReader{response: &prompb.ReadResponse{}}
+	handler := withTenant(&Config{MultiTenancy: true}, Read(reader, &Metrics{
+		QueryBatchDuration: &mockMetric{},
+		FailedQueries:      &mockMetric{},
+		ReceivedQueries:    &mockMetric{},
+		InvalidReadReqs:    &mockMetric{},
+	}))
+
+	req := httptest.NewRequest("POST", "/read", getReader(readRequestToString(&prompb.ReadRequest{})))
+	req.Header.Add("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Read-Version", "0.1.0")
+	req.Header.Set(tenancy.DefaultHeader, "team-a")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, http.StatusOK)
+	}
+	if reader.tenant != "team-a" {
+		t.Errorf("read not scoped to the tenant: got %q", reader.tenant)
+	}
+}
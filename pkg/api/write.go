@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -14,9 +15,15 @@ import (
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/tenancy"
 	"github.com/timescale/promscale/pkg/util"
 )
 
+// seconds we ask clients to wait before retrying a write we rejected because
+// the ingest pipeline was saturated
+const writeRetryAfter = "1"
+
 func Write(writer pgmodel.DBInserter, elector *util.Elector, metrics *Metrics) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
@@ -74,19 +81,48 @@ func Write(writer pgmodel.DBInserter, elector *util.Elector, metrics *Metrics) h
 		}
 
 		metrics.ReceivedSamples.Add(float64(receivedBatchCount))
+		tenant := tenancy.FromContext(r.Context())
+		if tenant != "" {
+			setTenantLabel(ts, tenant)
+			metrics.TenantReceivedSamples.WithLabelValues(tenant).Add(float64(receivedBatchCount))
+		}
 		begin := time.Now()
 
-		numSamples, err := writer.Ingest(req.GetTimeseries(), req)
+		numSamples, err := writer.IngestForTenant(tenant, req.GetTimeseries(), req)
+		if errors.Is(err, pgmodel.ErrIngestSaturated) || errors.Is(err, pgmodel.ErrIngestRateLimited) {
+			// let Prometheus buffer the data and retry it later
+			log.Debug("msg", "Rejecting samples", "reason", err, "num_samples", receivedBatchCount)
+			w.Header().Set("Retry-After", writeRetryAfter)
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		var limitErr *pgmodel.LimitError
+		if errors.As(err, &limitErr) {
+			// the rest of the samples were ingested, retrying won't help
+			log.Debug("msg", "Samples rejected for exceeding the limits", "err", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			metrics.FailedSamples.Add(float64(limitErr.Samples))
+			if tenant != "" {
+				metrics.TenantFailedSamples.WithLabelValues(tenant).Add(float64(limitErr.Samples))
+			}
+			err = nil
+		}
 		if err != nil {
 			log.Warn("msg", "Error sending samples to remote storage", "err", err, "num_samples", numSamples)
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			metrics.FailedSamples.Add(float64(receivedBatchCount))
+			if tenant != "" {
+				metrics.TenantFailedSamples.WithLabelValues(tenant).Add(float64(receivedBatchCount))
+			}
 			return
 		}
 
 		duration := time.Since(begin).Seconds()
 
 		metrics.SentSamples.Add(float64(numSamples))
+		if tenant != "" {
+			metrics.TenantSentSamples.WithLabelValues(tenant).Add(float64(numSamples))
+		}
 		metrics.SentBatchDuration.Observe(duration)
 
 		metrics.WriteThroughput.SetCurrent(getCounterValue(metrics.SentSamples))
@@ -100,6 +136,20 @@ func Write(writer pgmodel.DBInserter, elector *util.Elector, metrics *Metrics) h
 	})
 }
 
+// setTenantLabel marks every series as belonging to tenant, overriding any
+// tenant label the client sent so that it can't write into another tenant.
+func setTenantLabel(ts []prompb.TimeSeries, tenant string) {
+	for i := range ts {
+		lbls := ts[i].Labels[:0]
+		for _, l := range ts[i].Labels {
+			if l.Name != pgmodel.TenantLabelName {
+				lbls = append(lbls, l)
+			}
+		}
+		ts[i].Labels = append(lbls, prompb.Label{Name: pgmodel.TenantLabelName, Value: tenant})
+	}
+}
+
 func isWriter(elector *util.Elector) (bool, error) {
 	if elector != nil {
 		shouldWrite, err := elector.IsLeader()
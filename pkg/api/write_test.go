@@ -1,10 +1,12 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 	"testing/iotest"
@@ -14,12 +16,15 @@ import (
 	"github.com/golang/snappy"
 
 	"github.com/prometheus/client_golang/prometheus"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	dto "github.com/prometheus/client_model/go"
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/prometheus/prometheus/util/testutil"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
 
 	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/tenancy"
 	"github.com/timescale/promscale/pkg/util"
 )
 
@@ -68,6 +73,34 @@ func TestWrite(t *testing.T) {
 				&prompb.WriteRequest{},
 			),
 		},
+		{
+			name:         "ingest saturated",
+			isLeader:     true,
+			responseCode: http.StatusTooManyRequests,
+			inserterErr:  pgmodel.ErrIngestSaturated,
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{},
+			),
+		},
+		{
+			name:         "ingest rate limited",
+			isLeader:     true,
+			responseCode: http.StatusTooManyRequests,
+			inserterErr:  fmt.Errorf("rejected: %w", pgmodel.ErrIngestRateLimited),
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{},
+			),
+		},
+		{
+			name:             "samples over the limits",
+			isLeader:         true,
+			responseCode:     http.StatusBadRequest,
+			inserterResponse: 2,
+			inserterErr:      &pgmodel.LimitError{Reason: "too_many_labels", Samples: 1, Msg: "too many labels"},
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{},
+			),
+		},
 		{
 			name:         "elector error",
 			electionErr:  fmt.Errorf("some error"),
@@ -129,6 +162,15 @@ func TestWrite(t *testing.T) {
 				t.Errorf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.responseCode)
 			}
 
+			if c.responseCode == http.StatusTooManyRequests && w.Header().Get("Retry-After") == "" {
+				t.Errorf("missing Retry-After header on a rejected write")
+			}
+
+			var limitErr *pgmodel.LimitError
+			if errors.As(c.inserterErr, &limitErr) && failedSamplesGauge.value != float64(limitErr.Samples) {
+				t.Errorf("num failed samples not set correctly: got %f, expected %d", failedSamplesGauge.value, limitErr.Samples)
+			}
+
 			if c.electionErr != nil && leaderGauge.value != 0 {
 				t.Errorf("leader gauge metric not set correctly: got %f when election returns an error", leaderGauge.value)
 			}
@@ -151,6 +193,75 @@ func TestWrite(t *testing.T) {
 	}
 }
 
+func TestWriteForTenant(t *testing.T) {
+	mock := &mockInserter{result: 2}
+	metrics := &Metrics{
+		LeaderGauge:       &mockMetric{},
+		ReceivedSamples:   &mockMetric{},
+		FailedSamples:     &mockMetric{},
+		SentSamples:       &mockMetric{},
+		SentBatchDuration: &mockMetric{},
+		InvalidWriteReqs:  &mockMetric{},
+		WriteThroughput:   util.NewThroughputCalc(time.Second),
+		TenantReceivedSamples: prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: "tenant_received_samples_total"}, []string{"tenant"}),
+		TenantFailedSamples: prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: "tenant_failed_samples_total"}, []string{"tenant"}),
+		TenantSentSamples: prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: "tenant_sent_samples_total"}, []string{"tenant"}),
+	}
+	handler := withTenant(&Config{MultiTenancy: true}, Write(mock, nil, metrics))
+
+	body := writeRequestToString(&prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  []prompb.Label{{Name: pgmodel.MetricNameLabelName, Value: "foo"}},
+				Samples: []prompb.Sample{{Timestamp: 1, Value: 1}},
+			},
+			{
+				// clients can't write into another tenant
+				Labels: []prompb.Label{
+					{Name: pgmodel.MetricNameLabelName, Value: "foo"},
+					{Name: pgmodel.TenantLabelName, Value: "team-b"},
+				},
+				Samples: []prompb.Sample{{Timestamp: 1, Value: 1}},
+			},
+		},
+	})
+	req := httptest.NewRequest("POST", "/write", getReader(body))
+	req.Header.Add("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	req.Header.Set(tenancy.DefaultHeader, "team-a")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, http.StatusOK)
+	}
+	expected := []prompb.Label{
+		{Name: pgmodel.MetricNameLabelName, Value: "foo"},
+		{Name: pgmodel.TenantLabelName, Value: "team-a"},
+	}
+	if len(mock.ts) != 2 {
+		t.Fatalf("unexpected number of series ingested: %d", len(mock.ts))
+	}
+	if mock.tenant != "team-a" {
+		t.Errorf("unexpected tenant of the metadata: %q", mock.tenant)
+	}
+	for _, ts := range mock.ts {
+		if !reflect.DeepEqual(ts.Labels, expected) {
+			t.Errorf("unexpected labels: got %v wanted %v", ts.Labels, expected)
+		}
+	}
+	if v := promtestutil.ToFloat64(metrics.TenantReceivedSamples.WithLabelValues("team-a")); v != 2 {
+		t.Errorf("unexpected number of received samples for the tenant: %f", v)
+	}
+	if v := promtestutil.ToFloat64(metrics.TenantSentSamples.WithLabelValues("team-a")); v != 2 {
+		t.Errorf("unexpected number of sent samples for the tenant: %f", v)
+	}
+}
+
 func writeRequestToString(r *prompb.WriteRequest) string {
 	data, _ := proto.Marshal(r)
 	return string(snappy.Encode(nil, data))
@@ -198,6 +309,7 @@ func (m *mockElection) Resign() error {
 
 type mockInserter struct {
 	ts     []prompb.TimeSeries
+	tenant string
 	result uint64
 	err    error
 }
@@ -207,6 +319,11 @@ func (m *mockInserter) Ingest(series []prompb.TimeSeries, request *prompb.WriteR
 	return m.result, m.err
 }
 
+func (m *mockInserter) IngestForTenant(tenant string, series []prompb.TimeSeries, request *prompb.WriteRequest) (uint64, error) {
+	m.tenant = tenant
+	return m.Ingest(series, request)
+}
+
 func getReader(s string) io.Reader {
 	var r io.Reader = strings.NewReader(s)
 	if s == "" {
@@ -9,6 +9,11 @@ import (
 // CLOCK based approximate LRU storing designed for concurrent usage.
 // Gets only require a read lock, while Inserts take at least one write lock.
 type Cache struct {
+	// number of elements evicted over the lifetime of the cache. Must be the
+	// first word in the struct to ensure proper alignment in 32-bit systems.
+	// Reference: https://golang.org/pkg/sync/atomic/#pkg-note-BUG
+	evictions uint64
+
 	// guards elements and all fields except for `used` in Element, must have at
 	// least a read-lock to access, and a write-lock to insert/update/delete.
 	elementsLock sync.RWMutex
@@ -92,6 +97,7 @@ func (self *Cache) insert(key interface{}, value interface{}) (canonicalKey inte
 		if insertLocation == nil {
 			return key, value, false
 		}
+		atomic.AddUint64(&self.evictions, 1)
 		self.elementsLock.Lock()
 		defer self.elementsLock.Unlock()
 		delete(self.elements, insertLocation.key)
@@ -267,6 +273,18 @@ func (self *Cache) ExpandTo(newMax int) {
 	self.storage = newStorage
 }
 
+// Reset removes every element from the cache, keeping its capacity.
+func (self *Cache) Reset() {
+	self.insertLock.Lock()
+	defer self.insertLock.Unlock()
+	self.elementsLock.Lock()
+	defer self.elementsLock.Unlock()
+
+	self.elements = make(map[interface{}]*element, cap(self.storage))
+	self.storage = make([]element, 0, cap(self.storage))
+	self.next = 0
+}
+
 func (self *Cache) Len() int {
 	self.elementsLock.RLock()
 	defer self.elementsLock.RUnlock()
@@ -279,6 +297,11 @@ func (self *Cache) Cap() int {
 	return cap(self.storage)
 }
 
+// Evictions returns the number of elements evicted to make room for new ones
+func (self *Cache) Evictions() uint64 {
+	return atomic.LoadUint64(&self.evictions)
+}
+
 func (self *Cache) debugString() string {
 	self.elementsLock.RLock()
 	defer self.elementsLock.RUnlock()
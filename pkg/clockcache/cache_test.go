@@ -56,9 +56,17 @@ func TestEviction(t *testing.T) {
 		}
 	}
 
+	if cache.Evictions() != 0 {
+		t.Errorf("unexpected evictions before the cache is full: %d", cache.Evictions())
+	}
+
 	cache.Insert("100", 100)
 	cache.Get("100")
 
+	if cache.Evictions() != 1 {
+		t.Errorf("expected 1 eviction, got %d", cache.Evictions())
+	}
+
 	for i := 0; i < 10; i++ {
 		key := fmt.Sprintf("%d", i)
 		val, found := cache.Get(key)
@@ -206,3 +214,21 @@ func TestElementCacheAligned(t *testing.T) {
 		t.Errorf("unexpected element size: %d", elementSize)
 	}
 }
+
+func TestReset(t *testing.T) {
+	cache := WithMax(2)
+	cache.Insert("a", 1)
+	cache.Insert("b", 2)
+	cache.Reset()
+
+	if cache.Len() != 0 || cache.Cap() != 2 {
+		t.Errorf("unexpected cache after reset: %d elements, capacity %d", cache.Len(), cache.Cap())
+	}
+	if _, found := cache.Get("a"); found {
+		t.Errorf("found a reset element")
+	}
+	cache.Insert("c", 3)
+	if v, found := cache.Get("c"); !found || v != 3 {
+		t.Errorf("unexpected element inserted after reset: %v", v)
+	}
+}
@@ -0,0 +1,202 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package exporter dumps the series matching PromQL selectors over a time
+// range, in a format other systems or another promscale can read back.
+//
+// The series selected by every selector are streamed from the database and
+// written as soon as they are read, so that exports of any size only hold a
+// series at a time in memory, along with the labels of the series already
+// written.
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+// Format is the format of an export.
+type Format string
+
+const (
+	// OpenMetrics is the OpenMetrics text exposition format, with every
+	// metric typed as unknown and every sample timestamped.
+	OpenMetrics Format = "openmetrics"
+	// JSON is newline-delimited JSON, a series per line, encoded like the
+	// series of a range query result of the Prometheus HTTP API.
+	JSON Format = "json"
+	// RemoteWrite is a sequence of frames, each a snappy-compressed remote
+	// write request, the body of a remote write, prefixed by its length as
+	// a uvarint.
+	RemoteWrite Format = "remote-write"
+
+	// DefaultBatchSamples is the default number of samples of a remote write
+	// request.
+	DefaultBatchSamples = 10000
+)
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case OpenMetrics, JSON, RemoteWrite:
+		return f, nil
+	}
+	return "", fmt.Errorf("invalid export format %q, must be one of %s, %s or %s", s, OpenMetrics, JSON, RemoteWrite)
+}
+
+// Querier calls fn with every series matching a remote read query as soon as
+// it is read, like the pgmodel.Querier.
+type Querier interface {
+	StreamQuery(query *prompb.Query, fn func(*prompb.TimeSeries) error) error
+}
+
+// Config configures an export.
+type Config struct {
+	Format Format
+	// Start and End bound the exported samples, in milliseconds, inclusive.
+	Start int64
+	End   int64
+	// BatchSamples is the number of samples after which a remote write
+	// request is written, DefaultBatchSamples if 0.
+	BatchSamples int
+}
+
+// Result counts what was exported.
+type Result struct {
+	Series  int
+	Samples int64
+}
+
+// Exporter exports series.
+type Exporter struct {
+	cfg     Config
+	querier Querier
+}
+
+// New returns an exporter reading the series from querier.
+func New(cfg Config, querier Querier) *Exporter {
+	if cfg.BatchSamples <= 0 {
+		cfg.BatchSamples = DefaultBatchSamples
+	}
+	return &Exporter{cfg: cfg, querier: querier}
+}
+
+// Export writes the series matching any of the selectors to w, in the order
+// of the selectors. The series a selector matches are written in the order of
+// their metric names, the series of a metric one after the other. A series is
+// written once even if several selectors match it, so the series of a metric
+// matched by several selectors may be split into several metric families,
+// which OpenMetrics parsers reject.
+func (e *Exporter) Export(w io.Writer, selectors ...string) (Result, error) {
+	var result Result
+	queries := make([]*prompb.Query, 0, len(selectors))
+	for _, s := range selectors {
+		ms, err := parser.ParseMetricSelector(s)
+		if err != nil {
+			return result, fmt.Errorf("invalid selector %q: %w", s, err)
+		}
+		queries = append(queries, &prompb.Query{
+			StartTimestampMs: e.cfg.Start,
+			EndTimestampMs:   e.cfg.End,
+			Matchers:         toPromMatchers(ms),
+		})
+	}
+
+	sw, err := e.newSeriesWriter(w)
+	if err != nil {
+		return result, err
+	}
+
+	seen := make(map[string]struct{})
+	for i, q := range queries {
+		err = e.querier.StreamQuery(q, func(ts *prompb.TimeSeries) error {
+			if len(ts.Samples) == 0 {
+				return nil
+			}
+			s := newExportedSeries(ts)
+			key := s.labels.String()
+			if _, ok := seen[key]; ok {
+				return nil
+			}
+			seen[key] = struct{}{}
+			if err := sw.write(s); err != nil {
+				return writeError{err}
+			}
+			result.Series++
+			result.Samples += int64(len(ts.Samples))
+			return nil
+		})
+		var writeErr writeError
+		if errors.As(err, &writeErr) {
+			return result, writeErr.err
+		}
+		if err != nil {
+			return result, fmt.Errorf("querying %q: %w", selectors[i], err)
+		}
+	}
+	return result, sw.close()
+}
+
+// writeError wraps the errors writing the series, told apart from the errors
+// querying them.
+type writeError struct {
+	err error
+}
+
+func (e writeError) Error() string {
+	return e.err.Error()
+}
+
+// exportedSeries is a series to export with its sorted labels.
+type exportedSeries struct {
+	name   string
+	labels labels.Labels
+	ts     *prompb.TimeSeries
+}
+
+func newExportedSeries(ts *prompb.TimeSeries) exportedSeries {
+	ls := make(labels.Labels, 0, len(ts.Labels))
+	for _, l := range ts.Labels {
+		ls = append(ls, labels.Label{Name: l.Name, Value: l.Value})
+	}
+	sort.Sort(ls)
+	return exportedSeries{name: ls.Get(labels.MetricName), labels: ls, ts: ts}
+}
+
+func (e *Exporter) newSeriesWriter(w io.Writer) (seriesWriter, error) {
+	switch e.cfg.Format {
+	case OpenMetrics, "":
+		return newOpenMetricsWriter(w), nil
+	case JSON:
+		return newJSONWriter(w), nil
+	case RemoteWrite:
+		return newRemoteWriteWriter(w, e.cfg.BatchSamples), nil
+	}
+	return nil, fmt.Errorf("invalid export format %q", e.cfg.Format)
+}
+
+func toPromMatchers(ms []*labels.Matcher) []*prompb.LabelMatcher {
+	res := make([]*prompb.LabelMatcher, 0, len(ms))
+	for _, m := range ms {
+		var t prompb.LabelMatcher_Type
+		switch m.Type {
+		case labels.MatchEqual:
+			t = prompb.LabelMatcher_EQ
+		case labels.MatchNotEqual:
+			t = prompb.LabelMatcher_NEQ
+		case labels.MatchRegexp:
+			t = prompb.LabelMatcher_RE
+		case labels.MatchNotRegexp:
+			t = prompb.LabelMatcher_NRE
+		}
+		res = append(res, &prompb.LabelMatcher{Type: t, Name: m.Name, Value: m.Value})
+	}
+	return res
+}
@@ -0,0 +1,202 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package exporter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/value"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+type mockQuerier struct {
+	// series returned for every metric name matched with an equality matcher
+	series  map[string][]*prompb.TimeSeries
+	queries []*prompb.Query
+}
+
+func (m *mockQuerier) StreamQuery(q *prompb.Query, fn func(*prompb.TimeSeries) error) error {
+	m.queries = append(m.queries, q)
+	for _, matcher := range q.Matchers {
+		if matcher.Name == labels.MetricName && matcher.Type == prompb.LabelMatcher_EQ {
+			for _, ts := range m.series[matcher.Value] {
+				if err := fn(ts); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("no metric name")
+}
+
+func newMockQuerier() *mockQuerier {
+	return &mockQuerier{series: map[string][]*prompb.TimeSeries{
+		"foo": {
+			{
+				Labels:  []prompb.Label{{Name: "job", Value: "b"}, {Name: "__name__", Value: "foo"}},
+				Samples: []prompb.Sample{{Timestamp: 1000, Value: 1}, {Timestamp: 2500, Value: math.Float64frombits(value.StaleNaN)}},
+			},
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "foo"}, {Name: "job", Value: "a\"\n\\"}},
+				Samples: []prompb.Sample{{Timestamp: 1000, Value: math.Inf(1)}, {Timestamp: 2000, Value: 1.5e-7}},
+			},
+			{
+				Labels: []prompb.Label{{Name: "__name__", Value: "foo"}, {Name: "job", Value: "empty"}},
+			},
+		},
+		"bar": {
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "bar"}},
+				Samples: []prompb.Sample{{Timestamp: 3000, Value: -2}},
+			},
+		},
+	}}
+}
+
+func TestExportOpenMetrics(t *testing.T) {
+	querier := newMockQuerier()
+	var out bytes.Buffer
+	result, err := New(Config{Format: OpenMetrics, Start: 1000, End: 3000}, querier).Export(&out, `foo{job=~".+"}`, "bar", "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Series != 3 || result.Samples != 5 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	// the series are written in the order they are read, once
+	expected := `# TYPE foo unknown
+foo{job="b"} 1 1
+foo{job="a\"\n\\"} +Inf 1
+foo{job="a\"\n\\"} 1.5e-07 2
+# TYPE bar unknown
+bar -2 3
+# EOF
+`
+	if out.String() != expected {
+		t.Errorf("unexpected output:\ngot\n%s\nwanted\n%s", out.String(), expected)
+	}
+
+	expectedQuery := &prompb.Query{
+		StartTimestampMs: 1000,
+		EndTimestampMs:   3000,
+		Matchers: []*prompb.LabelMatcher{
+			{Type: prompb.LabelMatcher_RE, Name: "job", Value: ".+"},
+			{Type: prompb.LabelMatcher_EQ, Name: "__name__", Value: "foo"},
+		},
+	}
+	if len(querier.queries) != 3 || !reflect.DeepEqual(querier.queries[0], expectedQuery) {
+		t.Errorf("unexpected queries: %v", querier.queries)
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	var out bytes.Buffer
+	result, err := New(Config{Format: JSON}, newMockQuerier()).Export(&out, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Series != 2 || result.Samples != 4 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	var got []jsonSeries
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		var s jsonSeries
+		if err = json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, s)
+	}
+	expected := []jsonSeries{
+		{
+			Metric: map[string]string{"__name__": "foo", "job": "b"},
+			Values: [][2]interface{}{{1.0, "1"}},
+		},
+		{
+			Metric: map[string]string{"__name__": "foo", "job": "a\"\n\\"},
+			Values: [][2]interface{}{{1.0, "+Inf"}, {2.0, "0.00000015"}},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected series:\ngot\n%v\nwanted\n%v", got, expected)
+	}
+}
+
+func TestExportRemoteWrite(t *testing.T) {
+	var out bytes.Buffer
+	result, err := New(Config{Format: RemoteWrite, BatchSamples: 2}, newMockQuerier()).Export(&out, "foo", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Series != 3 || result.Samples != 5 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	var requests []prompb.WriteRequest
+	r := bufio.NewReader(&out)
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		compressed := make([]byte, size)
+		if _, err = io.ReadFull(r, compressed); err != nil {
+			t.Fatal(err)
+		}
+		data, err := snappy.Decode(nil, compressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var req prompb.WriteRequest
+		if err = req.Unmarshal(data); err != nil {
+			t.Fatal(err)
+		}
+		requests = append(requests, req)
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("unexpected number of requests: %d", len(requests))
+	}
+	// the series of job b keeps its staleness marker
+	first := requests[0].Timeseries
+	if len(first) != 1 || len(first[0].Samples) != 2 || !value.IsStaleNaN(first[0].Samples[1].Value) {
+		t.Errorf("unexpected first request: %v", requests[0])
+	}
+	last := requests[2].Timeseries
+	if len(last) != 1 || last[0].Labels[0].Value != "bar" || len(last[0].Samples) != 1 {
+		t.Errorf("unexpected last request: %v", requests[2])
+	}
+}
+
+func TestExportInvalid(t *testing.T) {
+	querier := newMockQuerier()
+	if _, err := New(Config{}, querier).Export(&bytes.Buffer{}, "foo{"); err == nil {
+		t.Error("expected an error for an invalid selector")
+	}
+	if _, err := New(Config{Format: "xml"}, querier).Export(&bytes.Buffer{}, "foo"); err == nil {
+		t.Error("expected an error for an invalid format")
+	}
+	if len(querier.queries) != 0 {
+		t.Errorf("unexpected queries: %v", querier.queries)
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("expected an error for an invalid format")
+	}
+}
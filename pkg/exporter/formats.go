@@ -0,0 +1,216 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package exporter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/value"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+// seriesWriter writes series in a format. The series of a metric are written
+// one after the other.
+type seriesWriter interface {
+	write(s exportedSeries) error
+	// close writes what is left buffered.
+	close() error
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+type openMetricsWriter struct {
+	w      *bufio.Writer
+	metric string
+	// started is set once the first metric family is written
+	started bool
+}
+
+func newOpenMetricsWriter(w io.Writer) *openMetricsWriter {
+	return &openMetricsWriter{w: bufio.NewWriter(w)}
+}
+
+func (o *openMetricsWriter) write(s exportedSeries) error {
+	if !o.started || s.name != o.metric {
+		o.started = true
+		o.metric = s.name
+		// the type of the metric isn't stored, the samples of histograms
+		// and summaries are exported as series of their own
+		o.w.WriteString("# TYPE ")
+		o.w.WriteString(s.name)
+		o.w.WriteString(" unknown\n")
+	}
+
+	var series strings.Builder
+	series.WriteString(s.name)
+	first := true
+	for _, l := range s.labels {
+		if l.Name == labels.MetricName {
+			continue
+		}
+		if first {
+			series.WriteByte('{')
+			first = false
+		} else {
+			series.WriteByte(',')
+		}
+		series.WriteString(l.Name)
+		series.WriteString(`="`)
+		series.WriteString(labelValueEscaper.Replace(l.Value))
+		series.WriteByte('"')
+	}
+	if !first {
+		series.WriteByte('}')
+	}
+	prefix := series.String()
+
+	for _, sample := range s.ts.Samples {
+		// staleness markers can't be told apart from NaN in text formats
+		if value.IsStaleNaN(sample.Value) {
+			continue
+		}
+		o.w.WriteString(prefix)
+		o.w.WriteByte(' ')
+		o.w.WriteString(formatOpenMetricsValue(sample.Value))
+		o.w.WriteByte(' ')
+		o.w.WriteString(formatTimestamp(sample.Timestamp))
+		o.w.WriteByte('\n')
+	}
+	// the first write error is kept by the buffered writer, which doesn't
+	// write anything after it
+	_, err := o.w.Write(nil)
+	return err
+}
+
+func (o *openMetricsWriter) close() error {
+	o.w.WriteString("# EOF\n")
+	return o.w.Flush()
+}
+
+func formatOpenMetricsValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// formatTimestamp formats a timestamp in milliseconds as seconds.
+func formatTimestamp(t int64) string {
+	return strconv.FormatFloat(float64(t)/1000, 'f', -1, 64)
+}
+
+// jsonSeries is a series of the JSON format, encoded like the series of a
+// range query result: the values are [<seconds>, "<value>"] pairs.
+type jsonSeries struct {
+	Metric map[string]string `json:"metric"`
+	Values [][2]interface{}  `json:"values"`
+}
+
+type jsonWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONWriter(w io.Writer) *jsonWriter {
+	buf := bufio.NewWriter(w)
+	return &jsonWriter{w: buf, enc: json.NewEncoder(buf)}
+}
+
+func (j *jsonWriter) write(s exportedSeries) error {
+	js := jsonSeries{
+		Metric: s.labels.Map(),
+		Values: make([][2]interface{}, 0, len(s.ts.Samples)),
+	}
+	for _, sample := range s.ts.Samples {
+		if value.IsStaleNaN(sample.Value) {
+			continue
+		}
+		js.Values = append(js.Values, [2]interface{}{
+			json.Number(formatTimestamp(sample.Timestamp)),
+			strconv.FormatFloat(sample.Value, 'f', -1, 64),
+		})
+	}
+	// Encode ends every series with a newline
+	return j.enc.Encode(js)
+}
+
+func (j *jsonWriter) close() error {
+	return j.w.Flush()
+}
+
+// remoteWriteWriter writes the series in remote write requests of up to
+// batchSamples samples, the samples of a series being split across requests
+// if needed.
+type remoteWriteWriter struct {
+	w            io.Writer
+	batchSamples int
+	req          prompb.WriteRequest
+	samples      int
+}
+
+func newRemoteWriteWriter(w io.Writer, batchSamples int) *remoteWriteWriter {
+	return &remoteWriteWriter{w: w, batchSamples: batchSamples}
+}
+
+func (r *remoteWriteWriter) write(s exportedSeries) error {
+	ls := make([]prompb.Label, 0, len(s.labels))
+	for _, l := range s.labels {
+		ls = append(ls, prompb.Label{Name: l.Name, Value: l.Value})
+	}
+	samples := s.ts.Samples
+	for len(samples) > 0 {
+		n := r.batchSamples - r.samples
+		if n > len(samples) {
+			n = len(samples)
+		}
+		r.req.Timeseries = append(r.req.Timeseries, prompb.TimeSeries{Labels: ls, Samples: samples[:n]})
+		r.samples += n
+		samples = samples[n:]
+		if r.samples >= r.batchSamples {
+			if err := r.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *remoteWriteWriter) flush() error {
+	if len(r.req.Timeseries) == 0 {
+		return nil
+	}
+	data, err := r.req.Marshal()
+	if err != nil {
+		return err
+	}
+	compressed := snappy.Encode(nil, data)
+	var size [binary.MaxVarintLen64]byte
+	if _, err = r.w.Write(size[:binary.PutUvarint(size[:], uint64(len(compressed)))]); err != nil {
+		return err
+	}
+	if _, err = r.w.Write(compressed); err != nil {
+		return err
+	}
+	r.req = prompb.WriteRequest{}
+	r.samples = 0
+	return nil
+}
+
+func (r *remoteWriteWriter) close() error {
+	return r.flush()
+}
@@ -0,0 +1,381 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package importer backfills the samples of Prometheus TSDB blocks, such as
+// the ones in the data directory of a Prometheus server, without going
+// through remote write.
+//
+// The series of every block are read in the order of their labels and sent
+// to the ingestor in batches, several at once. The progress of the import is
+// recorded after every batch, so that an interrupted import resumes from the
+// first series not fully imported. The samples a resumed import sends again
+// are ignored if they are already stored, even by the metrics rejecting
+// duplicates.
+//
+// Batches rejected because the ingest pipeline is saturated or rate limited
+// are retried with an exponential backoff.
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const (
+	// DefaultParallelism is the default number of batches ingested at once.
+	DefaultParallelism = 4
+	// DefaultBatchSamples is the default number of samples of a batch.
+	DefaultBatchSamples = 10000
+
+	metaFilename = "meta.json"
+)
+
+// minRetryBackoff and maxRetryBackoff bound the wait before retrying a batch
+// rejected because the ingest pipeline is saturated or rate limited.
+var (
+	minRetryBackoff = time.Second
+	maxRetryBackoff = 30 * time.Second
+)
+
+// errStopped is returned when reading the blocks is stopped by an ingest
+// failure.
+var errStopped = errors.New("import stopped")
+
+// Ingestor inserts the imported samples, like pgmodel.DBIngestor.
+type Ingestor interface {
+	Ingest(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error)
+	// IngestResent inserts samples that a previous attempt may have
+	// inserted, ignoring the ones already stored.
+	IngestResent(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error)
+}
+
+// ChunkDecompressor decompresses the chunks of a metric holding samples
+// between mint and maxt, inclusive, like pgmodel.ChunkDecompressor.
+type ChunkDecompressor interface {
+	DecompressChunks(metric string, mint, maxt int64) error
+}
+
+// Config configures an import.
+type Config struct {
+	// Parallelism is the number of batches ingested at once,
+	// DefaultParallelism if 0.
+	Parallelism int
+	// BatchSamples is the number of samples after which a batch is ingested,
+	// DefaultBatchSamples if 0.
+	BatchSamples int
+	// ProgressFile is the file recording the progress of the import, which
+	// is resumed from it. The progress isn't kept if empty.
+	ProgressFile string
+}
+
+// Result counts what was imported.
+type Result struct {
+	// Blocks is the number of blocks imported, excluding the ones already
+	// imported by a previous run.
+	Blocks  int
+	Samples int64
+}
+
+// Importer imports TSDB blocks.
+type Importer struct {
+	cfg          Config
+	ingestor     Ingestor
+	decompressor ChunkDecompressor
+}
+
+// New returns an importer sending the samples to ingestor. If decompressor
+// is not nil, the chunks of the metrics of every block are decompressed over
+// the time range of the block before it is imported.
+func New(cfg Config, ingestor Ingestor, decompressor ChunkDecompressor) *Importer {
+	if cfg.Parallelism <= 0 {
+		cfg.Parallelism = DefaultParallelism
+	}
+	if cfg.BatchSamples <= 0 {
+		cfg.BatchSamples = DefaultBatchSamples
+	}
+	return &Importer{cfg: cfg, ingestor: ingestor, decompressor: decompressor}
+}
+
+// batch is a set of series sent to the ingestor at once. The samples of a
+// series may be split across consecutive batches.
+type batch struct {
+	block string
+	// seq is the sequence number of the batch in its block
+	seq     int
+	series  []prompb.TimeSeries
+	samples int
+	// seriesDone is the number of series of the block whose samples are all
+	// in this batch or the previous ones
+	seriesDone int
+	// last is set on the last batch of the block
+	last bool
+	// resent is set if an interrupted import may have inserted some of the
+	// samples of the batch
+	resent bool
+	err    error
+}
+
+// Import imports the blocks found in dirs, which are either block
+// directories or directories holding blocks, like a Prometheus data
+// directory. The blocks are imported in the order of their ULIDs.
+func (imp *Importer) Import(dirs ...string) (Result, error) {
+	var result Result
+	var blocks []string
+	for _, dir := range dirs {
+		found, err := findBlocks(dir)
+		if err != nil {
+			return result, err
+		}
+		blocks = append(blocks, found...)
+	}
+	if len(blocks) == 0 {
+		return result, fmt.Errorf("no blocks found in %v", dirs)
+	}
+
+	progress, err := loadProgress(imp.cfg.ProgressFile)
+	if err != nil {
+		return result, err
+	}
+	// the progress file is written before any batch is sent so that the
+	// batches an interrupted import may have inserted are known to be
+	// resent, even if it stopped before recording anything
+	if err = progress.save(); err != nil {
+		return result, err
+	}
+
+	batches := make(chan *batch)
+	done := make(chan *batch)
+	quit := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(imp.cfg.Parallelism)
+	for i := 0; i < imp.cfg.Parallelism; i++ {
+		go func() {
+			defer wg.Done()
+			for b := range batches {
+				b.err = imp.ingest(b, quit)
+				done <- b
+			}
+		}()
+	}
+
+	var readErr error
+	go func() {
+		readErr = imp.readBlocks(blocks, progress, batches, quit)
+		close(batches)
+		wg.Wait()
+		close(done)
+	}()
+
+	for b := range done {
+		if b.err != nil {
+			if err == nil {
+				err = fmt.Errorf("importing block %s: %w", b.block, b.err)
+				close(quit)
+			}
+			continue
+		}
+		result.Samples += int64(b.samples)
+		blockDone, saveErr := progress.complete(b)
+		if saveErr != nil && err == nil {
+			err = saveErr
+			close(quit)
+		}
+		if blockDone {
+			result.Blocks++
+			log.Info("msg", "Imported block", "block", b.block)
+		}
+	}
+	if err == nil && readErr != errStopped {
+		err = readErr
+	}
+	return result, err
+}
+
+// ingest sends the batch to the ingestor, retrying it with an exponential
+// backoff while it is rejected because the ingest pipeline is saturated or
+// rate limited, until quit is closed. Such batches are not inserted at all, so
+// they are retried as they are.
+func (imp *Importer) ingest(b *batch, quit <-chan struct{}) error {
+	if len(b.series) == 0 {
+		return nil
+	}
+	backoff := minRetryBackoff
+	for {
+		var err error
+		if b.resent {
+			_, err = imp.ingestor.IngestResent(b.series, pgmodel.NewWriteRequest())
+		} else {
+			_, err = imp.ingestor.Ingest(b.series, pgmodel.NewWriteRequest())
+		}
+		if !errors.Is(err, pgmodel.ErrIngestSaturated) && !errors.Is(err, pgmodel.ErrIngestRateLimited) {
+			return err
+		}
+		log.Warn("msg", fmt.Sprintf("batch rejected, retrying it in %v", backoff), "block", b.block, "err", err)
+		select {
+		case <-time.After(backoff):
+		case <-quit:
+			return err
+		}
+		if backoff *= 2; backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// readBlocks sends the batches of the blocks not fully imported yet, until
+// quit is closed.
+func (imp *Importer) readBlocks(blocks []string, progress *progress, batches chan<- *batch, quit <-chan struct{}) error {
+	send := func(b *batch) error {
+		select {
+		case batches <- b:
+			return nil
+		case <-quit:
+			return errStopped
+		}
+	}
+	for _, dir := range blocks {
+		if err := imp.readBlock(dir, progress, send); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (imp *Importer) readBlock(dir string, progress *progress, send func(*batch) error) error {
+	block, err := tsdb.OpenBlock(log.GetLogger(), dir, nil)
+	if err != nil {
+		return fmt.Errorf("opening block %s: %w", dir, err)
+	}
+	defer block.Close()
+
+	meta := block.Meta()
+	id := meta.ULID.String()
+	bp := progress.get(id)
+	if bp.Done {
+		log.Info("msg", "Skipping block already imported", "block", id)
+		return nil
+	}
+	log.Info("msg", "Importing block", "block", id, "series", meta.Stats.NumSeries, "samples", meta.Stats.NumSamples,
+		"mint", timestamp.Time(meta.MinTime), "maxt", timestamp.Time(meta.MaxTime), "resumed_after_series", bp.Series)
+
+	q, err := tsdb.NewBlockQuerier(block, meta.MinTime, meta.MaxTime)
+	if err != nil {
+		return fmt.Errorf("querying block %s: %w", id, err)
+	}
+	defer q.Close()
+
+	if imp.decompressor != nil {
+		metrics, _, err := q.LabelValues(labels.MetricName)
+		if err != nil {
+			return fmt.Errorf("reading the metrics of block %s: %w", id, err)
+		}
+		for _, metric := range metrics {
+			if err = imp.decompressor.DecompressChunks(metric, meta.MinTime, meta.MaxTime); err != nil {
+				return err
+			}
+		}
+	}
+
+	resent := progress.resumed
+	cur := &batch{block: id, seriesDone: bp.Series, resent: resent}
+	flush := func() error {
+		b := cur
+		cur = &batch{block: id, seq: b.seq + 1, seriesDone: b.seriesDone, resent: resent}
+		return send(b)
+	}
+
+	ss := q.Select(true, nil, labels.MustNewMatcher(labels.MatchRegexp, labels.MetricName, ".+"))
+	for i := 0; ss.Next(); i++ {
+		if i < bp.Series {
+			continue
+		}
+		series := ss.At()
+		ts := prompb.TimeSeries{Labels: toPromLabels(series.Labels())}
+		it := series.Iterator()
+		for it.Next() {
+			// the batch is only flushed in the middle of a series if the
+			// series has samples left, so that the batch completing a
+			// series records it as done
+			if cur.samples+len(ts.Samples) >= imp.cfg.BatchSamples {
+				cur.series = append(cur.series, ts)
+				cur.samples += len(ts.Samples)
+				if err = flush(); err != nil {
+					return err
+				}
+				ts = prompb.TimeSeries{Labels: toPromLabels(series.Labels())}
+			}
+			t, v := it.At()
+			ts.Samples = append(ts.Samples, prompb.Sample{Timestamp: t, Value: v})
+		}
+		if err = it.Err(); err != nil {
+			return fmt.Errorf("reading block %s: %w", id, err)
+		}
+		if len(ts.Samples) > 0 {
+			cur.series = append(cur.series, ts)
+			cur.samples += len(ts.Samples)
+		}
+		cur.seriesDone = i + 1
+		if cur.samples >= imp.cfg.BatchSamples {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = ss.Err(); err != nil {
+		return fmt.Errorf("reading block %s: %w", id, err)
+	}
+	cur.last = true
+	return send(cur)
+}
+
+// toPromLabels copies the labels of a series, whose strings point to the
+// memory mapped index of the block, which is unmapped once the block is
+// closed while the ingestor may keep the labels in its caches.
+func toPromLabels(ls labels.Labels) []prompb.Label {
+	res := make([]prompb.Label, len(ls))
+	for i, l := range ls {
+		res[i] = prompb.Label{Name: string([]byte(l.Name)), Value: string([]byte(l.Value))}
+	}
+	return res
+}
+
+// findBlocks returns dir if it is a block, or else the blocks it holds,
+// sorted by ULID. The temporary directories of blocks being compacted or
+// deleted by Prometheus are skipped.
+func findBlocks(dir string) ([]string, error) {
+	if isBlock(dir) {
+		return []string{dir}, nil
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("listing blocks: %w", err)
+	}
+	var blocks []string
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+		if e.IsDir() && !strings.Contains(e.Name(), ".tmp") && isBlock(path) {
+			blocks = append(blocks, path)
+		}
+	}
+	return blocks, nil
+}
+
+func isBlock(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, metaFilename))
+	return err == nil
+}
@@ -0,0 +1,245 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package importer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+type mockIngestor struct {
+	mu sync.Mutex
+	// samples of every series, by their labels
+	samples map[string][]prompb.Sample
+	calls   int
+	// resentCalls is the number of successful calls sending resent samples
+	resentCalls int
+	// failAfter fails the calls after that many successful ones, if set
+	failAfter int
+	// saturated is the number of calls rejected with
+	// pgmodel.ErrIngestSaturated before accepting any
+	saturated int
+}
+
+func (m *mockIngestor) Ingest(tts []prompb.TimeSeries, _ *prompb.WriteRequest) (uint64, error) {
+	return m.ingest(tts, false)
+}
+
+func (m *mockIngestor) IngestResent(tts []prompb.TimeSeries, _ *prompb.WriteRequest) (uint64, error) {
+	return m.ingest(tts, true)
+}
+
+func (m *mockIngestor) ingest(tts []prompb.TimeSeries, resent bool) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failAfter > 0 && m.calls >= m.failAfter {
+		return 0, fmt.Errorf("ingest error")
+	}
+	if m.saturated > 0 {
+		m.saturated--
+		return 0, pgmodel.ErrIngestSaturated
+	}
+	m.calls++
+	if resent {
+		m.resentCalls++
+	}
+	if m.samples == nil {
+		m.samples = make(map[string][]prompb.Sample)
+	}
+	var n uint64
+	for _, ts := range tts {
+		key := fmt.Sprint(ts.Labels)
+		m.samples[key] = append(m.samples[key], ts.Samples...)
+		n += uint64(len(ts.Samples))
+	}
+	return n, nil
+}
+
+type mockDecompressor struct {
+	metrics []string
+}
+
+func (m *mockDecompressor) DecompressChunks(metric string, mint, maxt int64) error {
+	// the metric name points to the index of the block, closed after the import
+	m.metrics = append(m.metrics, string([]byte(metric)))
+	return nil
+}
+
+// createBlocks writes a block to dir for every time range, each holding the
+// samples of 3 series at every second of the range, and returns the samples
+// expected to be imported from them.
+func createBlocks(t *testing.T, dir string, ranges ...[2]int64) map[string][]prompb.Sample {
+	expected := make(map[string][]prompb.Sample)
+	for _, r := range ranges {
+		var samples []*tsdb.MetricSample
+		for _, name := range []string{"bar", "foo"} {
+			for _, job := range []string{"a", "b"} {
+				if name == "bar" && job == "b" {
+					continue
+				}
+				ls := labels.FromStrings(labels.MetricName, name, "job", job)
+				key := fmt.Sprint(toPromLabels(ls))
+				for ts := r[0]; ts < r[1]; ts += 1000 {
+					samples = append(samples, &tsdb.MetricSample{TimestampMs: ts, Value: float64(ts), Labels: ls})
+					expected[key] = append(expected[key], prompb.Sample{Timestamp: ts, Value: float64(ts)})
+				}
+			}
+		}
+		if _, err := tsdb.CreateBlock(samples, dir, r[0], r[1], kitlog.NewNopLogger()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return expected
+}
+
+func sortedSamples(samples map[string][]prompb.Sample) map[string][]prompb.Sample {
+	for _, s := range samples {
+		sort.Slice(s, func(i, j int) bool { return s[i].Timestamp < s[j].Timestamp })
+	}
+	return samples
+}
+
+func TestImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	expected := createBlocks(t, dir, [2]int64{0, 10000}, [2]int64{10000, 20000})
+
+	ingestor := &mockIngestor{}
+	decompressor := &mockDecompressor{}
+	importer := New(Config{Parallelism: 2, BatchSamples: 4}, ingestor, decompressor)
+	result, err := importer.Import(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Blocks != 2 || result.Samples != 60 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if !reflect.DeepEqual(sortedSamples(ingestor.samples), expected) {
+		t.Errorf("unexpected samples:\ngot\n%v\nwanted\n%v", ingestor.samples, expected)
+	}
+	if !reflect.DeepEqual(decompressor.metrics, []string{"bar", "foo", "bar", "foo"}) {
+		t.Errorf("unexpected decompressed metrics: %v", decompressor.metrics)
+	}
+}
+
+func TestImportResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	blocksDir := filepath.Join(dir, "data")
+	expected := createBlocks(t, blocksDir, [2]int64{0, 10000}, [2]int64{10000, 20000})
+	progressFile := filepath.Join(dir, "progress.json")
+
+	// the first block and the first series of the second one are imported
+	// before the failure
+	failing := &mockIngestor{failAfter: 4}
+	cfg := Config{Parallelism: 1, BatchSamples: 10, ProgressFile: progressFile}
+	if _, err = New(cfg, failing, nil).Import(blocksDir); err == nil {
+		t.Fatal("expected an ingest error")
+	}
+	if failing.resentCalls != 0 {
+		t.Errorf("%d batches of a new import were resent", failing.resentCalls)
+	}
+
+	ingestor := &mockIngestor{}
+	result, err := New(cfg, ingestor, nil).Import(blocksDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Blocks != 1 || result.Samples != 20 || ingestor.calls != 2 {
+		t.Errorf("unexpected result: %+v after %d calls", result, ingestor.calls)
+	}
+	// the batches of the resumed block may have been partially inserted
+	if ingestor.resentCalls != 2 {
+		t.Errorf("%d of the 2 batches of the resumed import were resent", ingestor.resentCalls)
+	}
+
+	for key, samples := range ingestor.samples {
+		failing.samples[key] = append(failing.samples[key], samples...)
+	}
+	if !reflect.DeepEqual(sortedSamples(failing.samples), expected) {
+		t.Errorf("unexpected samples:\ngot\n%v\nwanted\n%v", failing.samples, expected)
+	}
+
+	// nothing is left to import
+	ingestor = &mockIngestor{}
+	result, err = New(cfg, ingestor, nil).Import(blocksDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Blocks != 0 || ingestor.calls != 0 {
+		t.Errorf("unexpected result: %+v after %d calls", result, ingestor.calls)
+	}
+}
+
+func TestImportRetry(t *testing.T) {
+	defer func(backoff time.Duration) { minRetryBackoff = backoff }(minRetryBackoff)
+	minRetryBackoff = time.Millisecond
+
+	dir, err := ioutil.TempDir("", "import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	expected := createBlocks(t, dir, [2]int64{0, 10000})
+
+	ingestor := &mockIngestor{saturated: 3}
+	result, err := New(Config{Parallelism: 1, BatchSamples: 10}, ingestor, nil).Import(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Blocks != 1 || result.Samples != 30 || ingestor.saturated != 0 {
+		t.Errorf("unexpected result: %+v with %d rejections left", result, ingestor.saturated)
+	}
+	if !reflect.DeepEqual(sortedSamples(ingestor.samples), expected) {
+		t.Errorf("unexpected samples:\ngot\n%v\nwanted\n%v", ingestor.samples, expected)
+	}
+}
+
+func TestFindBlocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	createBlocks(t, dir, [2]int64{0, 10000}, [2]int64{10000, 20000})
+	if err = os.MkdirAll(filepath.Join(dir, "wal"), 0777); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks, err := findBlocks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 2 || !sort.StringsAreSorted(blocks) {
+		t.Fatalf("unexpected blocks: %v", blocks)
+	}
+
+	single, err := findBlocks(blocks[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(single, blocks[:1]) {
+		t.Errorf("unexpected blocks: %v", single)
+	}
+}
@@ -0,0 +1,136 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// blockProgress is how much of a block was imported.
+type blockProgress struct {
+	// Done is set once all the series of the block are imported.
+	Done bool `json:"done,omitempty"`
+	// Series is the number of series of the block, in the order of their
+	// labels, whose samples are all imported.
+	Series int `json:"series,omitempty"`
+}
+
+// progress records how much of every block was imported, in a file if it has
+// a path, so that an interrupted import resumes where it stopped. Batches
+// complete out of order, so a block only progresses up to its first batch
+// not yet imported.
+type progress struct {
+	path string
+	// resumed is set if the file already existed, so that the blocks not
+	// fully imported may be partially imported
+	resumed bool
+
+	mu     sync.Mutex
+	blocks map[string]*blockProgress
+	// batches of every block imported ahead of an earlier one, by sequence
+	// number, and the sequence number of the next batch to apply
+	completed map[string]map[int]*batch
+	next      map[string]int
+}
+
+// loadProgress reads the progress recorded in path, if it exists. Nothing is
+// recorded if path is empty.
+func loadProgress(path string) (*progress, error) {
+	p := &progress{
+		path:      path,
+		blocks:    make(map[string]*blockProgress),
+		completed: make(map[string]map[int]*batch),
+		next:      make(map[string]int),
+	}
+	if path == "" {
+		return p, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading the progress file: %w", err)
+	}
+	if err = json.Unmarshal(data, &p.blocks); err != nil {
+		return nil, fmt.Errorf("parsing the progress file %s: %w", path, err)
+	}
+	p.resumed = true
+	return p, nil
+}
+
+// get returns the progress of the block.
+func (p *progress) get(block string) blockProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if bp, ok := p.blocks[block]; ok {
+		return *bp
+	}
+	return blockProgress{}
+}
+
+// complete records that b was imported, and returns whether its block is now
+// fully imported.
+func (p *progress) complete(b *batch) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	completed, ok := p.completed[b.block]
+	if !ok {
+		completed = make(map[int]*batch)
+		p.completed[b.block] = completed
+	}
+	completed[b.seq] = b
+
+	bp, ok := p.blocks[b.block]
+	if !ok {
+		bp = &blockProgress{}
+		p.blocks[b.block] = bp
+	}
+	advanced := false
+	for {
+		next, ok := completed[p.next[b.block]]
+		if !ok {
+			break
+		}
+		delete(completed, next.seq)
+		p.next[b.block]++
+		bp.Series = next.seriesDone
+		bp.Done = next.last
+		advanced = true
+	}
+	if bp.Done {
+		delete(p.completed, b.block)
+		delete(p.next, b.block)
+	}
+	if !advanced {
+		return false, nil
+	}
+	return bp.Done, p.save()
+}
+
+// save writes the progress to its file, replacing it at once so that it is
+// never left half written.
+func (p *progress) save() error {
+	if p.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(p.blocks, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := p.path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("writing the progress file: %w", err)
+	}
+	if err = os.Rename(tmp, p.path); err != nil {
+		return fmt.Errorf("writing the progress file: %w", err)
+	}
+	return nil
+}
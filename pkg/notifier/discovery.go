@@ -0,0 +1,63 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package notifier
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	schemeLabel     = "__scheme__"
+	pathPrefixLabel = "__path_prefix__"
+)
+
+// targetGroup is a group of Alertmanagers in the Prometheus file_sd format.
+// The scheme and path prefix of the Alertmanagers can be set with the
+// __scheme__ and __path_prefix__ labels.
+type targetGroup struct {
+	Targets []string          `yaml:"targets"`
+	Labels  map[string]string `yaml:"labels"`
+}
+
+// readSDFile returns the base URLs of the Alertmanagers listed in a JSON or
+// YAML discovery file.
+func readSDFile(path string) ([]string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Alertmanager discovery file: %w", err)
+	}
+
+	var groups []targetGroup
+	// JSON is a subset of YAML, so both are parsed the same way
+	if err = yaml.UnmarshalStrict(content, &groups); err != nil {
+		return nil, fmt.Errorf("error parsing Alertmanager discovery file %s: %w", filepath.Base(path), err)
+	}
+
+	var res []string
+	for _, g := range groups {
+		scheme := "http"
+		if s, ok := g.Labels[schemeLabel]; ok {
+			scheme = s
+		}
+		for _, target := range g.Targets {
+			u := &url.URL{
+				Scheme: scheme,
+				Host:   target,
+				Path:   strings.TrimSuffix(g.Labels[pathPrefixLabel], "/"),
+			}
+			if err = validateURL(u.String()); err != nil {
+				return nil, fmt.Errorf("error in Alertmanager discovery file %s: %w", filepath.Base(path), err)
+			}
+			res = append(res, u.String())
+		}
+	}
+	return res, nil
+}
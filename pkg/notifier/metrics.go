@@ -0,0 +1,60 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package notifier
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+var (
+	sentAlerts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "notifications_sent_total",
+			Help:      "Total number of alerts sent to Alertmanager",
+		},
+		[]string{"alertmanager"},
+	)
+	sendErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "notifications_errors_total",
+			Help:      "Total number of batches of alerts that could not be sent to Alertmanager",
+		},
+		[]string{"alertmanager"},
+	)
+	droppedAlerts = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "notifications_dropped_total",
+			Help:      "Total number of alerts dropped because the queue was full or there was no Alertmanager",
+		},
+	)
+	queueLength = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "notifications_queue_length",
+			Help:      "Number of alerts waiting to be sent to Alertmanager",
+		},
+	)
+	alertmanagersDiscovered = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "notifications_alertmanagers_discovered",
+			Help:      "Number of Alertmanagers alerts are sent to",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		sentAlerts,
+		sendErrors,
+		droppedAlerts,
+		queueLength,
+		alertmanagersDiscovered,
+	)
+}
@@ -0,0 +1,315 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package notifier sends alerts to Alertmanager.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/log"
+)
+
+const (
+	alertsPath = "/api/v2/alerts"
+
+	defaultQueueCapacity   = 10000
+	defaultMaxBatchSize    = 64
+	defaultTimeout         = 10 * time.Second
+	defaultMaxRetries      = 3
+	defaultRetryBackoff    = time.Second
+	defaultRefreshInterval = time.Minute
+)
+
+// Alert is an alert as sent to Alertmanager.
+type Alert struct {
+	Labels       labels.Labels `json:"labels"`
+	Annotations  labels.Labels `json:"annotations"`
+	StartsAt     time.Time     `json:"startsAt,omitempty"`
+	EndsAt       time.Time     `json:"endsAt,omitempty"`
+	GeneratorURL string        `json:"generatorURL,omitempty"`
+}
+
+// Options configures a Manager.
+type Options struct {
+	// URLs are the base URLs of the Alertmanagers alerts are sent to.
+	URLs []string
+	// SDFiles are files listing more Alertmanagers in the Prometheus file_sd
+	// format, re-read every RefreshInterval.
+	SDFiles         []string
+	RefreshInterval time.Duration
+	// Timeout bounds every request to an Alertmanager.
+	Timeout time.Duration
+	// QueueCapacity is the number of alerts waiting to be sent after which
+	// the oldest ones are dropped.
+	QueueCapacity int
+	MaxBatchSize  int
+	// MaxRetries is the number of times a failed request is retried, waiting
+	// RetryBackoff, doubled on every retry, in between.
+	MaxRetries   int
+	RetryBackoff time.Duration
+	// Client is the HTTP client used to send the alerts.
+	Client *http.Client
+}
+
+// Manager queues alerts and sends them to all the Alertmanagers in batches.
+type Manager struct {
+	opts Options
+
+	mtx           sync.Mutex
+	queue         []*Alert
+	alertmanagers []string
+
+	more   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewManager returns a Manager sending alerts to the Alertmanagers of opts.
+func NewManager(opts Options) (*Manager, error) {
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = defaultRefreshInterval
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	if opts.QueueCapacity <= 0 {
+		opts.QueueCapacity = defaultQueueCapacity
+	}
+	if opts.MaxBatchSize <= 0 {
+		opts.MaxBatchSize = defaultMaxBatchSize
+	}
+	if opts.MaxRetries < 0 {
+		opts.MaxRetries = 0
+	} else if opts.MaxRetries == 0 {
+		opts.MaxRetries = defaultMaxRetries
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = defaultRetryBackoff
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{}
+	}
+	for _, u := range opts.URLs {
+		if err := validateURL(u); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		opts:   opts,
+		more:   make(chan struct{}, 1),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	if err := m.refresh(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return m, nil
+}
+
+func validateURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("invalid Alertmanager URL %s: %w", u, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("invalid Alertmanager URL %s: scheme must be http or https", u)
+	}
+	return nil
+}
+
+// refresh updates the list of Alertmanagers from the discovery files.
+func (m *Manager) refresh() error {
+	ams := append([]string(nil), m.opts.URLs...)
+	for _, file := range m.opts.SDFiles {
+		discovered, err := readSDFile(file)
+		if err != nil {
+			return err
+		}
+		ams = append(ams, discovered...)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.alertmanagers = ams
+	alertmanagersDiscovered.Set(float64(len(ams)))
+	return nil
+}
+
+// Alertmanagers returns the URLs of the Alertmanagers alerts are sent to.
+func (m *Manager) Alertmanagers() []string {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	res := make([]string, 0, len(m.alertmanagers))
+	for _, am := range m.alertmanagers {
+		res = append(res, strings.TrimSuffix(am, "/")+alertsPath)
+	}
+	return res
+}
+
+// Send queues alerts to be sent. If the queue is full, the oldest alerts are
+// dropped.
+func (m *Manager) Send(alerts ...*Alert) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if len(alerts) > m.opts.QueueCapacity {
+		droppedAlerts.Add(float64(len(alerts) - m.opts.QueueCapacity))
+		alerts = alerts[len(alerts)-m.opts.QueueCapacity:]
+	}
+	if overflow := len(m.queue) + len(alerts) - m.opts.QueueCapacity; overflow > 0 {
+		droppedAlerts.Add(float64(overflow))
+		m.queue = m.queue[overflow:]
+	}
+	m.queue = append(m.queue, alerts...)
+	queueLength.Set(float64(len(m.queue)))
+
+	select {
+	case m.more <- struct{}{}:
+	default:
+	}
+}
+
+// nextBatch takes up to MaxBatchSize alerts off the queue.
+func (m *Manager) nextBatch() []*Alert {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	n := len(m.queue)
+	if n > m.opts.MaxBatchSize {
+		n = m.opts.MaxBatchSize
+	}
+	batch := append([]*Alert(nil), m.queue[:n]...)
+	m.queue = m.queue[n:]
+	queueLength.Set(float64(len(m.queue)))
+	return batch
+}
+
+func (m *Manager) queueLen() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return len(m.queue)
+}
+
+// Run sends the queued alerts until Stop is called.
+func (m *Manager) Run() {
+	defer close(m.done)
+	ticker := time.NewTicker(m.opts.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.refresh(); err != nil {
+				log.Warn("msg", "error refreshing the Alertmanagers, keeping the previous ones", "err", err)
+			}
+		case <-m.more:
+			for m.queueLen() > 0 && m.ctx.Err() == nil {
+				m.sendAll(m.nextBatch())
+			}
+		}
+	}
+}
+
+// Stop stops sending alerts, dropping the ones still queued.
+func (m *Manager) Stop() {
+	m.cancel()
+	<-m.done
+}
+
+// sendAll sends alerts to every Alertmanager concurrently.
+func (m *Manager) sendAll(alerts []*Alert) {
+	if len(alerts) == 0 {
+		return
+	}
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		log.Error("msg", "error encoding alerts", "err", err)
+		return
+	}
+
+	ams := m.Alertmanagers()
+	if len(ams) == 0 {
+		droppedAlerts.Add(float64(len(alerts)))
+		log.Warn("msg", "no Alertmanager to send alerts to", "num_alerts", len(alerts))
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, am := range ams {
+		wg.Add(1)
+		go func(am string) {
+			defer wg.Done()
+			if err := m.sendWithRetries(am, body); err != nil {
+				sendErrors.WithLabelValues(am).Inc()
+				log.Warn("msg", "error sending alerts", "alertmanager", am, "num_alerts", len(alerts), "err", err)
+				return
+			}
+			sentAlerts.WithLabelValues(am).Add(float64(len(alerts)))
+		}(am)
+	}
+	wg.Wait()
+}
+
+// sendWithRetries posts body to the Alertmanager at u, retrying on server
+// and network errors.
+func (m *Manager) sendWithRetries(u string, body []byte) error {
+	backoff := m.opts.RetryBackoff
+	var err error
+	for attempt := 0; ; attempt++ {
+		var retriable bool
+		retriable, err = m.send(u, body)
+		if err == nil || !retriable || attempt >= m.opts.MaxRetries {
+			return err
+		}
+		select {
+		case <-m.ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// send posts body to the Alertmanager at u, reporting if a failure can be
+// retried.
+func (m *Manager) send(u string, body []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(m.ctx, m.opts.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequest("POST", u, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := m.opts.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+	err = fmt.Errorf("bad response status %s", resp.Status)
+	return resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests, err
+}
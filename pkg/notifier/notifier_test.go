@@ -0,0 +1,203 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package notifier
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// alertmanagerStub records the alerts posted to it. The first failures
+// requests fail with status.
+type alertmanagerStub struct {
+	mtx      sync.Mutex
+	failures int
+	status   int
+	requests int
+	alerts   []*Alert
+	received chan struct{}
+}
+
+func newAlertmanagerStub() *alertmanagerStub {
+	return &alertmanagerStub{received: make(chan struct{}, 100)}
+}
+
+func (s *alertmanagerStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.requests++
+	if r.Method != "POST" || r.URL.Path != alertsPath || r.Header.Get("Content-Type") != "application/json" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if s.failures > 0 {
+		s.failures--
+		w.WriteHeader(s.status)
+		return
+	}
+	var alerts []*Alert
+	if err := json.NewDecoder(r.Body).Decode(&alerts); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.alerts = append(s.alerts, alerts...)
+	s.received <- struct{}{}
+}
+
+func (s *alertmanagerStub) wait(t *testing.T) {
+	select {
+	case <-s.received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for alerts")
+	}
+}
+
+func testAlert(name string) *Alert {
+	return &Alert{
+		Labels:      labels.FromStrings("alertname", name),
+		Annotations: labels.FromStrings("summary", "test"),
+		StartsAt:    time.Unix(10, 0).UTC(),
+		EndsAt:      time.Unix(70, 0).UTC(),
+	}
+}
+
+func TestSend(t *testing.T) {
+	stubs := []*alertmanagerStub{newAlertmanagerStub(), newAlertmanagerStub()}
+	urls := make([]string, len(stubs))
+	for i, stub := range stubs {
+		server := httptest.NewServer(stub)
+		defer server.Close()
+		urls[i] = server.URL
+	}
+	// the first Alertmanager fails once and is retried
+	stubs[0].failures = 1
+	stubs[0].status = http.StatusServiceUnavailable
+
+	m, err := NewManager(Options{URLs: urls, MaxBatchSize: 2, RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go m.Run()
+	defer m.Stop()
+
+	alerts := []*Alert{testAlert("a"), testAlert("b"), testAlert("c")}
+	m.Send(alerts...)
+	for _, stub := range stubs {
+		// two batches
+		stub.wait(t)
+		stub.wait(t)
+		stub.mtx.Lock()
+		if !reflect.DeepEqual(stub.alerts, alerts) {
+			t.Errorf("unexpected alerts received: %v", stub.alerts)
+		}
+		stub.mtx.Unlock()
+	}
+	if stubs[0].requests != 3 {
+		t.Errorf("unexpected number of requests: %d", stubs[0].requests)
+	}
+}
+
+func TestSendNoRetry(t *testing.T) {
+	stub := newAlertmanagerStub()
+	stub.failures = 1
+	stub.status = http.StatusBadRequest
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	m, err := NewManager(Options{URLs: []string{server.URL}, RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.sendWithRetries(m.Alertmanagers()[0], []byte("[]")); err == nil {
+		t.Errorf("expected an error")
+	}
+	if stub.requests != 1 {
+		t.Errorf("client errors should not be retried, got %d requests", stub.requests)
+	}
+}
+
+func TestQueueOverflow(t *testing.T) {
+	m, err := NewManager(Options{QueueCapacity: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Send(testAlert("a"))
+	m.Send(testAlert("b"), testAlert("c"))
+	batch := m.nextBatch()
+	if len(batch) != 2 || batch[0].Labels.Get("alertname") != "b" || batch[1].Labels.Get("alertname") != "c" {
+		t.Errorf("the oldest alerts should be dropped, got %v", batch)
+	}
+}
+
+func TestDiscovery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stub := newAlertmanagerStub()
+	server := httptest.NewServer(stub)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	path := filepath.Join(dir, "alertmanagers.json")
+	if err = ioutil.WriteFile(path, []byte(`[{"targets": ["`+host+`"]}]`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewManager(Options{SDFiles: []string{path}, RefreshInterval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ams := m.Alertmanagers(); !reflect.DeepEqual(ams, []string{server.URL + alertsPath}) {
+		t.Fatalf("unexpected Alertmanagers: %v", ams)
+	}
+	go m.Run()
+	defer m.Stop()
+
+	m.Send(testAlert("a"))
+	stub.wait(t)
+
+	yamlFile := "- targets: [am1:9093, am2:9093]\n  labels:\n    __scheme__: https\n    __path_prefix__: /am/\n"
+	if err = ioutil.WriteFile(path, []byte(yamlFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"https://am1:9093/am" + alertsPath, "https://am2:9093/am" + alertsPath}
+	deadline := time.Now().Add(5 * time.Second)
+	for !reflect.DeepEqual(m.Alertmanagers(), expected) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Alertmanagers not refreshed: %v", m.Alertmanagers())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// invalid files keep the previous Alertmanagers
+	if err = ioutil.WriteFile(path, []byte("foo: bar"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.refresh(); err == nil {
+		t.Errorf("expected an error for an invalid file")
+	}
+	if !reflect.DeepEqual(m.Alertmanagers(), expected) {
+		t.Errorf("unexpected Alertmanagers after an invalid file: %v", m.Alertmanagers())
+	}
+}
+
+func TestInvalidURL(t *testing.T) {
+	if _, err := NewManager(Options{URLs: []string{"am:9093"}}); err == nil {
+		t.Errorf("expected an error for a URL without scheme")
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"runtime"
 	"strconv"
 
 	pgx "github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/prometheus/pkg/labels"
 
 	"github.com/timescale/promscale/pkg/clockcache"
 	"github.com/timescale/promscale/pkg/prompb"
@@ -29,12 +31,30 @@ type Config struct {
 	SslMode                 string
 	DbConnectRetries        int
 	AsyncAcks               bool
+	AsyncAcksWALDir         string
+	MaxInFlightSamples      int64
+	MaxInsertQueueDepth     int
 	ReportInterval          int
 	LabelsCacheSize         uint64
 	MetricsCacheSize        uint64
 	SeriesCacheSize         uint64
+	QueryCursorPageSize     int
+	MetricQueryParallelism  int
+	QueryLimits             pgmodel.QueryLimits
 	WriteConnectionsPerProc int
 	MaxConnections          int
+	Limits                  pgmodel.Limits
+	RelabelConfigFile       string
+	// DownsamplingResolutions lists the resolutions all metrics are
+	// downsampled to, as <resolution>:<retention> pairs.
+	DownsamplingResolutions string
+	// InsertMode names the pgmodel.InsertMode samples are written with.
+	InsertMode     string
+	CopyMinSamples int
+	// CacheInvalidator drops the cached query results of the samples
+	// changed or removed after their insertion, by this client or others.
+	// Not set by a flag.
+	CacheInvalidator pgmodel.CacheInvalidator
 }
 
 // ParseFlags parses the configuration flags specific to PostgreSQL and TimescaleDB
@@ -47,9 +67,30 @@ func ParseFlags(cfg *Config) *Config {
 	flag.StringVar(&cfg.SslMode, "db-ssl-mode", "require", "The TimescaleDB connection ssl mode")
 	flag.IntVar(&cfg.DbConnectRetries, "db-connect-retries", 0, "How many times to retry connecting to the database")
 	flag.BoolVar(&cfg.AsyncAcks, "async-acks", false, "Ack before data is written to DB")
+	flag.StringVar(&cfg.AsyncAcksWALDir, "async-acks-wal-dir", "", "Directory of the write-ahead log recording data acked before it is written to DB, replayed on startup. Only used with async-acks, disabled if empty")
+	flag.Int64Var(&cfg.MaxInFlightSamples, "ingest-max-inflight-samples", 0, "maximum number of samples waiting to be written to the DB, writes over the limit are rejected with HTTP 429. 0 means no limit")
+	flag.IntVar(&cfg.MaxInsertQueueDepth, "ingest-max-queue-depth", 0, "maximum number of write requests queued for a single metric, writes over the limit are rejected with HTTP 429. 0 means no limit")
+	flag.Float64Var(&cfg.Limits.IngestionRate, "ingest-rate-limit", 0, "maximum number of samples per second accepted, writes over the limit are rejected with HTTP 429. 0 means no limit")
+	flag.IntVar(&cfg.Limits.IngestionBurst, "ingest-rate-burst", 0, "maximum number of samples accepted at once under the ingest-rate-limit, defaults to a second worth of samples")
+	flag.Float64Var(&cfg.Limits.TenantIngestionRate, "tenant-ingest-rate-limit", 0, "maximum number of samples per second accepted from each tenant when multi-tenancy is enabled. 0 means no limit")
+	flag.IntVar(&cfg.Limits.TenantIngestionBurst, "tenant-ingest-rate-burst", 0, "maximum number of samples accepted at once from each tenant under the tenant-ingest-rate-limit, defaults to a second worth of samples")
+	flag.Int64Var(&cfg.Limits.MaxSeriesPerMetric, "max-series-per-metric", 0, "maximum number of series of a metric, samples of new series over the limit are rejected with HTTP 400. With async-acks the rejection is only logged, not reported to clients. 0 means no limit")
+	flag.IntVar(&cfg.Limits.MaxLabelsPerSeries, "max-labels-per-series", 0, "maximum number of labels of a series, including the metric name. 0 means no limit")
+	flag.IntVar(&cfg.Limits.MaxLabelNameLength, "max-label-name-length", 0, "maximum length of a label name. 0 means no limit")
+	flag.IntVar(&cfg.Limits.MaxLabelValueLength, "max-label-value-length", 0, "maximum length of a label value. 0 means no limit")
+	flag.StringVar(&cfg.RelabelConfigFile, "relabel-config-file", "", "file with Prometheus write_relabel_configs applied to the ingested series, reloaded on SIGHUP. No relabeling is done if empty")
+	flag.StringVar(&cfg.DownsamplingResolutions, "downsampling-resolutions", "", "comma separated list of <resolution>:<retention> pairs, such as 5m:30d,1h:1y, all metrics are downsampled to. Range queries read the coarsest resolution no longer than their step. Resolutions that aren't listed are left as is")
+	flag.StringVar(&cfg.InsertMode, "db-insert-mode", pgmodel.InsertModeInsert.String(), "how samples are written to the database: insert sends them as arrays in an INSERT, copy copies them into a staging table first, copy-direct copies them right into their table, falling back to copy for batches with duplicates, auto uses copy for batches of at least db-copy-min-samples samples and insert otherwise")
+	flag.IntVar(&cfg.CopyMinSamples, "db-copy-min-samples", pgmodel.DefaultCopyMinSamples, "number of samples from which the auto db-insert-mode copies a batch instead of inserting it")
 	flag.IntVar(&cfg.ReportInterval, "tput-report", 0, "interval in seconds at which throughput should be reported")
 	flag.Uint64Var(&cfg.LabelsCacheSize, "labels-cache-size", 10000, "maximum number of labels to cache")
 	flag.Uint64Var(&cfg.MetricsCacheSize, "metrics-cache-size", pgmodel.DefaultMetricCacheSize, "maximum number of metric names to cache")
+	flag.Uint64Var(&cfg.SeriesCacheSize, "series-cache-size", pgmodel.DefaultSeriesCacheSize, "maximum number of series ids to cache on the write path")
+	flag.IntVar(&cfg.QueryCursorPageSize, "db-query-cursor-page-size", pgmodel.DefaultCursorPageSize, "number of series fetched from the database at a time while evaluating PromQL queries")
+	flag.IntVar(&cfg.MetricQueryParallelism, "db-query-metric-parallelism", pgmodel.DefaultMetricQueryParallelism, "maximum number of metrics queried at once, over separate connections, by a remote read selector matching several metrics. PromQL selectors read them one at a time")
+	flag.IntVar(&cfg.QueryLimits.MaxSeriesPerSelector, "query-max-series-per-selector", 0, "maximum number of series a selector may select, queries over the limit fail. 0 means no limit")
+	flag.Int64Var(&cfg.QueryLimits.MaxRowsPerQuery, "query-max-sql-rows", 0, "maximum number of rows the SQL queries run for a read may return all together, reads over the limit fail. 0 means no limit")
+	flag.DurationVar(&cfg.QueryLimits.StatementTimeout, "query-statement-timeout", 0, "statement_timeout of the SQL queries run for reads. 0 means no timeout")
 	flag.IntVar(&cfg.WriteConnectionsPerProc, "db-writer-connection-concurrency", 4, "maximum number of database connections per go process writing to the database")
 	flag.IntVar(&cfg.MaxConnections, "db-connections-max", -1, "maximum connections that can be open at once, defaults to 80% of the max the DB can handle")
 	return cfg
@@ -60,10 +101,15 @@ type Client struct {
 	Connection    *pgxpool.Pool
 	ingestor      *pgmodel.DBIngestor
 	reader        *pgmodel.DBReader
+	deleter       *pgmodel.SeriesDeleter
 	queryable     *query.Queryable
 	cfg           *Config
 	ConnectionStr string
 	metricCache   *pgmodel.MetricNameCache
+	seriesCache   *pgmodel.SeriesCacheImpl
+	relabeler     *pgmodel.Relabeler
+	// modificationWatcher is set if the client has a CacheInvalidator
+	modificationWatcher *pgmodel.DataModificationWatcher
 }
 
 // Post connect validation function, useful for things such as acquiring locks
@@ -72,11 +118,51 @@ type LockFunc = func(ctx context.Context, conn *pgx.Conn) error
 
 // NewClient creates a new PostgreSQL client
 func NewClient(cfg *Config, schemaLocker LockFunc) (*Client, error) {
+	insertMode, err := cfg.getInsertMode()
+	if err != nil {
+		log.Error("msg", "err parsing insert mode", "err", err)
+		return nil, err
+	}
+	// only the copy insert modes go through the staging table
+	usesStagingTable := insertMode != pgmodel.InsertModeInsert
+
+	afterConnect := func(ctx context.Context, conn *pgx.Conn) error {
+		if schemaLocker != nil {
+			if err := schemaLocker(ctx, conn); err != nil {
+				return err
+			}
+		}
+		if !usesStagingTable {
+			return nil
+		}
+		return pgmodel.CreateStagingTable(ctx, conn)
+	}
+	connectionPool, numCopiers, err := newPool(cfg, afterConnect)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientWithPool(cfg, numCopiers, connectionPool)
+}
+
+// getInsertMode returns the insert mode named by the config, defaulting to
+// pgmodel.InsertModeInsert.
+func (cfg *Config) getInsertMode() (pgmodel.InsertMode, error) {
+	if cfg.InsertMode == "" {
+		return pgmodel.InsertModeInsert, nil
+	}
+	return pgmodel.ParseInsertMode(cfg.InsertMode)
+}
+
+// newPool connects the connection pool of a client, running afterConnect on
+// every new connection, and returns it with the number of its connections used
+// to insert data.
+func newPool(cfg *Config, afterConnect LockFunc) (*pgxpool.Pool, int, error) {
 	connectionStr := cfg.GetConnectionStr()
 	minConnections, maxConnections, numCopiers, err := cfg.GetNumConnections()
 	if err != nil {
 		log.Error("msg", "configuring number of connections", "err", util.MaskPassword(err.Error()))
-		return nil, err
+		return nil, 0, err
 	}
 
 	pgConfig, err := pgxpool.ParseConfig(connectionStr + fmt.Sprintf(" pool_max_conns=%d pool_min_conns=%d", maxConnections, minConnections))
@@ -84,45 +170,91 @@ func NewClient(cfg *Config, schemaLocker LockFunc) (*Client, error) {
 		log.Error("msg", "configuring connection", "err", util.MaskPassword(err.Error()))
 	}
 
-	pgConfig.AfterConnect = schemaLocker
+	pgConfig.AfterConnect = afterConnect
 	connectionPool, err := pgxpool.ConnectConfig(context.Background(), pgConfig)
 
 	log.Info("msg", util.MaskPassword(connectionStr), "numCopiers", numCopiers, "pool_max_conns", maxConnections, "pool_min_conns", minConnections)
 
 	if err != nil {
 		log.Error("msg", "err creating connection pool for new client", "err", util.MaskPassword(err.Error()))
-		return nil, err
+		return nil, 0, err
 	}
-
-	return NewClientWithPool(cfg, numCopiers, connectionPool)
+	return connectionPool, numCopiers, nil
 }
 
 // NewClientWithPool creates a new PostgreSQL client with an existing connection pool.
+// The pool must run pgmodel.CreateStagingTable on its connections for the copy
+// insert modes.
 func NewClientWithPool(cfg *Config, numCopiers int, pool *pgxpool.Pool) (*Client, error) {
 	cache := &pgmodel.MetricNameCache{Metrics: clockcache.WithMax(cfg.MetricsCacheSize)}
+	seriesCacheSize := cfg.SeriesCacheSize
+	if seriesCacheSize == 0 {
+		seriesCacheSize = pgmodel.DefaultSeriesCacheSize
+	}
+	seriesCache := pgmodel.NewSeriesCache(seriesCacheSize)
+
+	relabeler, err := pgmodel.NewRelabeler(cfg.RelabelConfigFile)
+	if err != nil {
+		log.Error("msg", "err loading relabel config", "err", err)
+		return nil, err
+	}
+
+	resolutions, err := pgmodel.ParseRollupResolutions(cfg.DownsamplingResolutions)
+	if err != nil {
+		log.Error("msg", "err parsing downsampling resolutions", "err", err)
+		return nil, err
+	}
+	if err = pgmodel.SetupRollupResolutions(pool, resolutions); err != nil {
+		log.Error("msg", "err setting up downsampling", "err", err)
+		return nil, err
+	}
+
+	insertMode, err := cfg.getInsertMode()
+	if err != nil {
+		log.Error("msg", "err parsing insert mode", "err", err)
+		return nil, err
+	}
 
 	c := pgmodel.Cfg{
-		AsyncAcks:       cfg.AsyncAcks,
-		ReportInterval:  cfg.ReportInterval,
-		SeriesCacheSize: cfg.SeriesCacheSize,
-		NumCopiers:      numCopiers,
+		AsyncAcks:           cfg.AsyncAcks,
+		ReportInterval:      cfg.ReportInterval,
+		SeriesCacheSize:     cfg.SeriesCacheSize,
+		NumCopiers:          numCopiers,
+		WALDir:              cfg.AsyncAcksWALDir,
+		MaxInFlightSamples:  cfg.MaxInFlightSamples,
+		MaxInsertQueueDepth: cfg.MaxInsertQueueDepth,
+		Limits:              cfg.Limits,
+		Relabeler:           relabeler,
+		InsertMode:          insertMode,
+		CopyMinSamples:      cfg.CopyMinSamples,
+		CacheInvalidator:    cfg.CacheInvalidator,
 	}
-	ingestor, err := pgmodel.NewPgxIngestorWithMetricCache(pool, cache, &c)
+	ingestor, err := pgmodel.NewPgxIngestorWithMetricCache(pool, cache, seriesCache, &c)
 	if err != nil {
 		log.Error("msg", "err starting ingestor", "err", err)
 		return nil, err
 	}
-	reader := pgmodel.NewPgxReaderWithMetricCache(pool, cache, cfg.LabelsCacheSize)
+	reader := pgmodel.NewPgxReaderWithMetricCache(pool, cache, cfg.readerCfg())
 
 	queryable := query.NewQueryable(reader.GetQuerier())
 
+	// the ids of deleted series must not be used by the ingestor anymore
+	deleter := pgmodel.NewSeriesDeleter(pool, seriesCache.Reset, cfg.CacheInvalidator)
+
 	client := &Client{
 		Connection:  pool,
 		ingestor:    ingestor,
 		reader:      reader,
+		deleter:     deleter,
 		queryable:   queryable,
 		cfg:         cfg,
 		metricCache: cache,
+		seriesCache: seriesCache,
+		relabeler:   relabeler,
+	}
+
+	if cfg.CacheInvalidator != nil {
+		client.modificationWatcher = pgmodel.WatchDataModifications(pool, cfg.CacheInvalidator, pgmodel.DefaultDataModificationPollInterval)
 	}
 
 	InitClientMetrics(client)
@@ -130,6 +262,45 @@ func NewClientWithPool(cfg *Config, numCopiers int, pool *pgxpool.Pool) (*Client
 	return client, nil
 }
 
+// readerCfg returns the configuration of the reader of a client.
+func (cfg *Config) readerCfg() *pgmodel.ReaderCfg {
+	return &pgmodel.ReaderCfg{
+		LabelsCacheSize:        cfg.LabelsCacheSize,
+		CursorPageSize:         cfg.QueryCursorPageSize,
+		MetricQueryParallelism: cfg.MetricQueryParallelism,
+		Limits:                 cfg.QueryLimits,
+	}
+}
+
+// ReadOnlyClient reads Prometheus samples from TimescaleDB without starting
+// the ingestor, nor its WAL, for the tools that only read, such as export.
+type ReadOnlyClient struct {
+	Connection *pgxpool.Pool
+	reader     *pgmodel.DBReader
+}
+
+// NewReadOnlyClient creates a new PostgreSQL client that only reads.
+func NewReadOnlyClient(cfg *Config, schemaLocker LockFunc) (*ReadOnlyClient, error) {
+	pool, _, err := newPool(cfg, schemaLocker)
+	if err != nil {
+		return nil, err
+	}
+	cache := &pgmodel.MetricNameCache{Metrics: clockcache.WithMax(cfg.MetricsCacheSize)}
+	reader := pgmodel.NewPgxReaderWithMetricCache(pool, cache, cfg.readerCfg())
+	return &ReadOnlyClient{Connection: pool, reader: reader}, nil
+}
+
+// StreamQuery calls fn with every series matching the remote read query as
+// soon as it is read from the database.
+func (c *ReadOnlyClient) StreamQuery(q *prompb.Query, fn func(*prompb.TimeSeries) error) error {
+	return c.reader.GetQuerier().StreamQuery(q, fn)
+}
+
+// Close closes the client
+func (c *ReadOnlyClient) Close() {
+	c.Connection.Close()
+}
+
 // GetConnectionStr returns a Postgres connection string
 func (cfg *Config) GetConnectionStr() string {
 	return fmt.Sprintf("host=%v port=%v user=%v dbname=%v password='%v' sslmode=%v connect_timeout=10",
@@ -193,6 +364,9 @@ func (cfg *Config) GetNumConnections() (min int, max int, numCopiers int, err er
 // Close closes the client and performs cleanup
 func (c *Client) Close() {
 	log.Info("msg", "Shutting down Client")
+	if c.modificationWatcher != nil {
+		c.modificationWatcher.Stop()
+	}
 	c.ingestor.Close()
 	c.Connection.Close()
 }
@@ -202,11 +376,62 @@ func (c *Client) Ingest(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint
 	return c.ingestor.Ingest(tts, req)
 }
 
+// IngestForTenant writes the timeseries object of tenant into the DB
+func (c *Client) IngestForTenant(tenant string, tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	return c.ingestor.IngestForTenant(tenant, tts, req)
+}
+
+// IngestResent writes the timeseries object sent again after an attempt
+// that may have written some of it into the DB
+func (c *Client) IngestResent(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	return c.ingestor.IngestResent(tts, req)
+}
+
+// ReloadRelabelConfig reads the relabel config file again, keeping the
+// current rules if it is invalid.
+func (c *Client) ReloadRelabelConfig() error {
+	return c.relabeler.Reload()
+}
+
 // Read returns the promQL query results
 func (c *Client) Read(req *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 	return c.reader.Read(req)
 }
 
+// StreamRead streams the query results as XOR chunks into w
+func (c *Client) StreamRead(req *prompb.ReadRequest, w io.Writer) error {
+	return c.reader.StreamRead(req, w)
+}
+
+// Metadata returns the stored metric metadata
+func (c *Client) Metadata(metric string, limit int) (map[string][]pgmodel.MetricMetadata, error) {
+	return c.reader.Metadata(metric, limit)
+}
+
+// Exemplars returns the stored exemplars of the selected series
+func (c *Client) Exemplars(start, end int64, matcherSets ...[]*labels.Matcher) ([]pgmodel.ExemplarQueryResult, error) {
+	return c.reader.Exemplars(start, end, matcherSets...)
+}
+
+// DeleteSeries deletes the samples between mint and maxt of the series of
+// tenant matching any of the matcher sets, and the series left empty if
+// dropEmptySeries is set
+func (c *Client) DeleteSeries(tenant string, mint, maxt int64, dropEmptySeries bool, matcherSets ...[]*labels.Matcher) (pgmodel.DeleteResult, error) {
+	return c.deleter.Delete(tenant, mint, maxt, dropEmptySeries, matcherSets...)
+}
+
+// MarkDataModified records that samples of tenant, or of all the tenants if
+// it is empty, were changed or removed, so that the connectors drop the query
+// results they cached for it.
+func (c *Client) MarkDataModified(tenant string) error {
+	return pgmodel.MarkDataModified(c.Connection, tenant)
+}
+
+// ForTenant returns a reader that only sees the series of tenant
+func (c *Client) ForTenant(tenant string) pgmodel.Reader {
+	return c.reader.ForTenant(tenant)
+}
+
 func (c *Client) NumCachedMetricNames() int {
 	return c.metricCache.NumElements()
 }
@@ -215,6 +440,18 @@ func (c *Client) MetricNamesCacheCapacity() int {
 	return c.metricCache.Capacity()
 }
 
+func (c *Client) NumCachedSeries() int {
+	return c.seriesCache.NumElements()
+}
+
+func (c *Client) SeriesCacheCapacity() int {
+	return c.seriesCache.Capacity()
+}
+
+func (c *Client) SeriesCacheEvictions() uint64 {
+	return c.seriesCache.Evictions()
+}
+
 func (c *Client) NumCachedLabels() int {
 	return c.reader.GetQuerier().NumCachedLabels()
 }
@@ -10,6 +10,9 @@ var (
 	cachedLabels        prometheus.CounterFunc
 	metricNamesCacheCap prometheus.GaugeFunc
 	labelsCacheCap      prometheus.GaugeFunc
+	cachedSeries        prometheus.CounterFunc
+	seriesCacheCap      prometheus.GaugeFunc
+	seriesCacheEvicted  prometheus.CounterFunc
 )
 
 func InitClientMetrics(client *Client) {
@@ -50,10 +53,37 @@ func InitClientMetrics(client *Client) {
 		return float64(client.LabelsCacheCapacity())
 	})
 
+	cachedSeries = prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: util.PromNamespace,
+		Name:      "series_cache_elements_stored",
+		Help:      "Total number of series stored in the series cache.",
+	}, func() float64 {
+		return float64(client.NumCachedSeries())
+	})
+
+	seriesCacheCap = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: util.PromNamespace,
+		Name:      "series_cache_capacity",
+		Help:      "Maximum number of elements in the series cache.",
+	}, func() float64 {
+		return float64(client.SeriesCacheCapacity())
+	})
+
+	seriesCacheEvicted = prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: util.PromNamespace,
+		Name:      "series_cache_evictions_total",
+		Help:      "Total number of series evicted from the series cache.",
+	}, func() float64 {
+		return float64(client.SeriesCacheEvictions())
+	})
+
 	prometheus.MustRegister(
 		cachedMetricNames,
 		metricNamesCacheCap,
 		cachedLabels,
 		labelsCacheCap,
+		cachedSeries,
+		seriesCacheCap,
+		seriesCacheEvicted,
 	)
 }
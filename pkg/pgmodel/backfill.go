@@ -0,0 +1,50 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/common/model"
+)
+
+// ChunkDecompressor decompresses the chunks samples are backfilled into ahead
+// of the inserts, instead of decompressing every chunk after the oldest
+// sample of a batch on insert failures.
+type ChunkDecompressor struct {
+	conn pgxConn
+}
+
+// NewChunkDecompressor returns a decompressor using the connections of pool.
+func NewChunkDecompressor(pool *pgxpool.Pool) *ChunkDecompressor {
+	return &ChunkDecompressor{conn: &pgxConnImpl{conn: pool}}
+}
+
+// DecompressChunks decompresses the chunks of the metric holding samples
+// between mint and maxt, inclusive. Metrics without a table have no chunks to
+// decompress.
+func (d *ChunkDecompressor) DecompressChunks(metric string, mint, maxt int64) error {
+	rows, err := d.conn.Query(context.Background(), getMetricsTableSQL, metric)
+	if err != nil {
+		return err
+	}
+	var table string
+	found := rows.Next()
+	if found {
+		err = rows.Scan(&table)
+	}
+	rows.Close()
+	if err != nil || !found {
+		return err
+	}
+
+	_, err = d.conn.Exec(context.Background(), decompressChunksBetweenSQL, table, model.Time(mint).Time(), model.Time(maxt).Time())
+	if err != nil {
+		return fmt.Errorf("decompressing the chunks of %s: %w", table, err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+)
+
+func TestDecompressChunks(t *testing.T) {
+	testCases := []struct {
+		name    string
+		queries []sqlQuery
+	}{
+		{
+			name: "existing metric",
+			queries: []sqlQuery{
+				{sql: getMetricsTableSQL, args: []interface{}{"foo"}, results: rowResults{{"foo_table"}}},
+				{sql: decompressChunksBetweenSQL, args: []interface{}{"foo_table", model.Time(1000).Time(), model.Time(2000).Time()}},
+			},
+		},
+		{
+			name: "new metric",
+			queries: []sqlQuery{
+				{sql: getMetricsTableSQL, args: []interface{}{"foo"}},
+			},
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := &sqlRecorder{t: t, queries: c.queries}
+			decompressor := &ChunkDecompressor{conn: mock}
+			if err := decompressor.DecompressChunks("foo", 1000, 2000); err != nil {
+				t.Fatal(err)
+			}
+			if mock.nextQuery != len(c.queries) {
+				t.Errorf("only %d of the %d queries were run", mock.nextQuery, len(c.queries))
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 const (
 	DefaultMetricCacheSize = 10000
+	DefaultSeriesCacheSize = 250000
 )
 
 var (
@@ -54,3 +55,53 @@ func (m *MetricNameCache) NumElements() int {
 func (m *MetricNameCache) Capacity() int {
 	return m.Metrics.Cap()
 }
+
+// SeriesCacheImpl caches the mapping from label sets to series ids. A single
+// instance is shared between all the inserter routines, and once full, the
+// least recently used series are evicted.
+type SeriesCacheImpl struct {
+	cache *clockcache.Cache
+}
+
+// NewSeriesCache creates a series cache holding at most max series.
+func NewSeriesCache(max uint64) *SeriesCacheImpl {
+	return &SeriesCacheImpl{clockcache.WithMax(max)}
+}
+
+// GetSeries returns the series id for the label set, or ErrEntryNotFound if
+// the series is not in the cache.
+func (s *SeriesCacheImpl) GetSeries(lset Labels) (SeriesID, error) {
+	result, ok := s.cache.Get(lset.str)
+	if !ok {
+		seriesCacheMisses.Inc()
+		return 0, ErrEntryNotFound
+	}
+	seriesCacheHits.Inc()
+	return result.(SeriesID), nil
+}
+
+// SetSeries stores the series id for the label set.
+func (s *SeriesCacheImpl) SetSeries(lset Labels, id SeriesID) error {
+	// lset.str is owned by the canonical Labels, so there is no need to copy it
+	s.cache.Insert(lset.str, id)
+	return nil
+}
+
+func (s *SeriesCacheImpl) NumElements() int {
+	return s.cache.Len()
+}
+
+func (s *SeriesCacheImpl) Capacity() int {
+	return s.cache.Cap()
+}
+
+// Reset empties the cache, which must be done once series are deleted from
+// the database since their ids are not valid anymore.
+func (s *SeriesCacheImpl) Reset() {
+	s.cache.Reset()
+}
+
+// Evictions returns the number of series evicted from the cache so far.
+func (s *SeriesCacheImpl) Evictions() uint64 {
+	return s.cache.Evictions()
+}
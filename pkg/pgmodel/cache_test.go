@@ -96,3 +96,45 @@ func TestMetricTableNameCache(t *testing.T) {
 		})
 	}
 }
+
+func TestSeriesCache(t *testing.T) {
+	cache := NewSeriesCache(2)
+
+	series := make([]*Labels, 3)
+	for i := range series {
+		lset, err := LabelsFromSlice(labels.Labels{
+			{Name: MetricNameLabelName, Value: "metric"},
+			{Name: "idx", Value: strings.Repeat("a", i+1)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		series[i] = lset
+	}
+
+	if _, err := cache.GetSeries(*series[0]); err != ErrEntryNotFound {
+		t.Fatalf("got unexpected error:\ngot\n%s\nwanted\n%s\n", err, ErrEntryNotFound)
+	}
+
+	for i, lset := range series {
+		if err := cache.SetSeries(*lset, SeriesID(i+1)); err != nil {
+			t.Fatalf("got unexpected error:\ngot\n%s\nwanted\nnil\n", err)
+		}
+	}
+
+	if cache.NumElements() != 2 || cache.Capacity() != 2 {
+		t.Fatalf("unexpected cache size: %d elements, %d capacity", cache.NumElements(), cache.Capacity())
+	}
+
+	if cache.Evictions() != 1 {
+		t.Fatalf("unexpected number of evictions: got %d wanted 1", cache.Evictions())
+	}
+
+	id, err := cache.GetSeries(*series[2])
+	if err != nil {
+		t.Fatalf("got unexpected error:\ngot\n%s\nwanted\nnil\n", err)
+	}
+	if id != 3 {
+		t.Fatalf("found wrong series id: got %d wanted 3", id)
+	}
+}
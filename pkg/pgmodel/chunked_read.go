@@ -0,0 +1,128 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const (
+	// samplesPerChunk is the maximum number of samples we put into a single
+	// XOR chunk. This is the same value the Prometheus TSDB uses.
+	samplesPerChunk = 120
+	// maxBytesInFrame is the soft limit on the size of a single
+	// ChunkedReadResponse frame. This matches the Prometheus default for
+	// --storage.remote.read-max-bytes-in-frame.
+	maxBytesInFrame = 1024 * 1024
+)
+
+// chunkedResponseWriter encodes series into XOR chunks and writes them as
+// serialized ChunkedReadResponse messages. Every call to Write on the
+// underlying writer corresponds to exactly one frame, the framing itself is
+// expected to be done by the writer.
+//
+// Series are written in the order they are read from the database, which is
+// not necessarily sorted by labels.
+type chunkedResponseWriter struct {
+	w               io.Writer
+	maxBytesInFrame int
+	chunks          []prompb.Chunk
+}
+
+func newChunkedResponseWriter(w io.Writer, maxBytesInFrame int) *chunkedResponseWriter {
+	return &chunkedResponseWriter{
+		w:               w,
+		maxBytesInFrame: maxBytesInFrame,
+	}
+}
+
+// writeSeries sends a single series. A series is never mixed with other series
+// in a frame, but it may be split over multiple frames if its chunks do not
+// fit into maxBytesInFrame.
+func (c *chunkedResponseWriter) writeSeries(queryIndex int64, ts *prompb.TimeSeries) error {
+	labelBytes := 0
+	for i := range ts.Labels {
+		labelBytes += ts.Labels[i].Size()
+	}
+
+	frameBytesLeft := c.maxBytesInFrame - labelBytes
+	samples := ts.Samples
+	for len(samples) > 0 {
+		numSamples := samplesPerChunk
+		if numSamples > len(samples) {
+			numSamples = len(samples)
+		}
+
+		chunk, err := encodeXORChunk(samples[:numSamples])
+		if err != nil {
+			return err
+		}
+		samples = samples[numSamples:]
+
+		c.chunks = append(c.chunks, chunk)
+		frameBytesLeft -= chunk.Size()
+
+		// We are fine with the frame being larger than maxBytesInFrame by at
+		// most one chunk.
+		if frameBytesLeft > 0 && len(samples) > 0 {
+			continue
+		}
+
+		if err = c.flush(queryIndex, ts.Labels); err != nil {
+			return err
+		}
+		frameBytesLeft = c.maxBytesInFrame - labelBytes
+	}
+
+	return nil
+}
+
+func (c *chunkedResponseWriter) flush(queryIndex int64, lbls []prompb.Label) error {
+	b, err := proto.Marshal(&prompb.ChunkedReadResponse{
+		ChunkedSeries: []*prompb.ChunkedSeries{
+			{Labels: lbls, Chunks: c.chunks},
+		},
+		QueryIndex: queryIndex,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal ChunkedReadResponse: %w", err)
+	}
+
+	for i := range c.chunks {
+		c.chunks[i] = prompb.Chunk{}
+	}
+	c.chunks = c.chunks[:0]
+
+	if _, err := c.w.Write(b); err != nil {
+		return fmt.Errorf("write to stream: %w", err)
+	}
+	return nil
+}
+
+// encodeXORChunk encodes the samples, which must be sorted by time, into a
+// single XOR chunk.
+func encodeXORChunk(samples []prompb.Sample) (prompb.Chunk, error) {
+	chk := chunkenc.NewXORChunk()
+	app, err := chk.Appender()
+	if err != nil {
+		return prompb.Chunk{}, err
+	}
+
+	for _, s := range samples {
+		app.Append(s.Timestamp, s.Value)
+	}
+
+	return prompb.Chunk{
+		MinTimeMs: samples[0].Timestamp,
+		MaxTimeMs: samples[len(samples)-1].Timestamp,
+		Type:      prompb.Chunk_XOR,
+		Data:      chk.Bytes(),
+	}, nil
+}
@@ -0,0 +1,179 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+type frameRecorder struct {
+	frames []prompb.ChunkedReadResponse
+}
+
+func (f *frameRecorder) Write(b []byte) (int, error) {
+	var resp prompb.ChunkedReadResponse
+	if err := proto.Unmarshal(b, &resp); err != nil {
+		return 0, err
+	}
+	f.frames = append(f.frames, resp)
+	return len(b), nil
+}
+
+func genSamples(count int) []prompb.Sample {
+	samples := make([]prompb.Sample, count)
+	for i := range samples {
+		samples[i] = prompb.Sample{Timestamp: int64(i * 1000), Value: float64(i)}
+	}
+	return samples
+}
+
+func decodeChunks(t *testing.T, chunks []prompb.Chunk) []prompb.Sample {
+	samples := make([]prompb.Sample, 0)
+	for _, c := range chunks {
+		if c.Type != prompb.Chunk_XOR {
+			t.Fatalf("unexpected chunk encoding %v", c.Type)
+		}
+		chk, err := chunkenc.FromData(chunkenc.EncXOR, c.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		it := chk.Iterator(nil)
+		for it.Next() {
+			ts, val := it.At()
+			if ts < c.MinTimeMs || ts > c.MaxTimeMs {
+				t.Errorf("sample %d outside of chunk range [%d, %d]", ts, c.MinTimeMs, c.MaxTimeMs)
+			}
+			samples = append(samples, prompb.Sample{Timestamp: ts, Value: val})
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+	}
+	return samples
+}
+
+func TestChunkedResponseWriter(t *testing.T) {
+	testCases := []struct {
+		name            string
+		numSamples      int
+		maxBytesInFrame int
+		expFrames       int
+		expChunks       int
+	}{
+		{
+			name:            "no samples",
+			numSamples:      0,
+			maxBytesInFrame: maxBytesInFrame,
+		},
+		{
+			name:            "single chunk",
+			numSamples:      10,
+			maxBytesInFrame: maxBytesInFrame,
+			expFrames:       1,
+			expChunks:       1,
+		},
+		{
+			name:            "multiple chunks single frame",
+			numSamples:      samplesPerChunk*2 + 1,
+			maxBytesInFrame: maxBytesInFrame,
+			expFrames:       1,
+			expChunks:       3,
+		},
+		{
+			name:            "frame per chunk",
+			numSamples:      samplesPerChunk*2 + 1,
+			maxBytesInFrame: 1,
+			expFrames:       3,
+			expChunks:       3,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			rec := &frameRecorder{}
+			cw := newChunkedResponseWriter(rec, c.maxBytesInFrame)
+			ts := &prompb.TimeSeries{
+				Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: "foo"}},
+				Samples: genSamples(c.numSamples),
+			}
+
+			if err := cw.writeSeries(3, ts); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(rec.frames) != c.expFrames {
+				t.Fatalf("unexpected number of frames: got %d wanted %d", len(rec.frames), c.expFrames)
+			}
+
+			chunks := make([]prompb.Chunk, 0)
+			for _, f := range rec.frames {
+				if f.QueryIndex != 3 {
+					t.Errorf("unexpected query index %d", f.QueryIndex)
+				}
+				if len(f.ChunkedSeries) != 1 {
+					t.Fatalf("expected exactly one series per frame, got %d", len(f.ChunkedSeries))
+				}
+				if !reflect.DeepEqual(f.ChunkedSeries[0].Labels, ts.Labels) {
+					t.Errorf("unexpected labels: got %v wanted %v", f.ChunkedSeries[0].Labels, ts.Labels)
+				}
+				chunks = append(chunks, f.ChunkedSeries[0].Chunks...)
+			}
+
+			if len(chunks) != c.expChunks {
+				t.Fatalf("unexpected number of chunks: got %d wanted %d", len(chunks), c.expChunks)
+			}
+
+			if c.numSamples == 0 {
+				return
+			}
+			if samples := decodeChunks(t, chunks); !reflect.DeepEqual(samples, ts.Samples) {
+				t.Errorf("unexpected samples decoded:\ngot\n%v\nwanted\n%v", samples, ts.Samples)
+			}
+		})
+	}
+}
+
+func TestDBReaderStreamRead(t *testing.T) {
+	tts := []*prompb.TimeSeries{
+		{
+			Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: "foo"}},
+			Samples: genSamples(2),
+		},
+		{
+			Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: "bar"}},
+			Samples: genSamples(3),
+		},
+	}
+
+	rec := &frameRecorder{}
+	r := DBReader{db: &mockQuerier{tts: tts}}
+	req := &prompb.ReadRequest{Queries: []*prompb.Query{{}, {}}}
+	if err := r.StreamRead(req, rec); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rec.frames) != 4 {
+		t.Fatalf("unexpected number of frames: got %d wanted 4", len(rec.frames))
+	}
+	for i, f := range rec.frames {
+		if f.QueryIndex != int64(i/2) {
+			t.Errorf("unexpected query index for frame %d: %d", i, f.QueryIndex)
+		}
+		if samples := decodeChunks(t, f.ChunkedSeries[0].Chunks); !reflect.DeepEqual(samples, tts[i%2].Samples) {
+			t.Errorf("unexpected samples in frame %d: %v", i, samples)
+		}
+	}
+
+	r = DBReader{db: &mockQuerier{err: fmt.Errorf("some error")}}
+	if err := r.StreamRead(req, rec); err == nil {
+		t.Error("expected query error to be returned")
+	}
+}
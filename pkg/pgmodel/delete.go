@@ -0,0 +1,205 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/log"
+)
+
+const (
+	deleteSeriesLookupSQLFormat = `SELECT m.table_name, array_agg(s.id)
+	FROM %[1]s.series s
+	INNER JOIN %[1]s.metric m
+	ON (m.id = s.metric_id)
+	WHERE %[2]s
+	GROUP BY m.table_name
+	ORDER BY m.table_name`
+	seriesTimeRangeSQLFormat   = "SELECT min(time), max(time) FROM %s WHERE series_id = ANY($1)"
+	decompressChunksBetweenSQL = "CALL " + catalogSchema + ".decompress_chunks_between($1, $2, $3)"
+	deleteSeriesSamplesSQL     = "SELECT " + catalogSchema + ".delete_series_samples($1, $2, $3, $4)"
+	deleteEmptySeriesSQL       = "SELECT " + catalogSchema + ".delete_empty_series($1, $2)"
+)
+
+var (
+	// the samples are deleted between these bounds at most, since the
+	// default bounds of the Prometheus API are out of the range of
+	// PostgreSQL timestamps
+	minDeleteTime = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxDeleteTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// DeleteResult counts what was removed by a series deletion.
+type DeleteResult struct {
+	Samples int64
+	Series  int
+}
+
+// SeriesDeleter deletes the samples of the series matching label matchers.
+type SeriesDeleter struct {
+	conn pgxConn
+	// onSeriesDeleted is called once series are deleted from the database,
+	// to invalidate the caches holding their ids.
+	onSeriesDeleted func()
+	// invalidator drops the cached query results of the deleted samples
+	invalidator CacheInvalidator
+}
+
+// NewSeriesDeleter returns a deleter calling onSeriesDeleted, if not nil,
+// every time series are deleted, and invalidating the query results cached
+// by invalidator, if not nil, every time samples are deleted.
+func NewSeriesDeleter(pool *pgxpool.Pool, onSeriesDeleted func(), invalidator CacheInvalidator) *SeriesDeleter {
+	return &SeriesDeleter{conn: &pgxConnImpl{conn: pool}, onSeriesDeleted: onSeriesDeleted, invalidator: invalidator}
+}
+
+// Delete deletes the samples between mint and maxt, inclusive, of the series
+// matching any of the matcher sets. If tenant is set, only the series of that
+// tenant are deleted. With dropEmptySeries, the matching series without
+// samples left are deleted as well, along with the labels they alone used.
+// Compressed chunks holding samples to delete are decompressed, and compressed
+// again by the compression policy of the metric.
+func (d *SeriesDeleter) Delete(tenant string, mint, maxt int64, dropEmptySeries bool, matcherSets ...[]*labels.Matcher) (DeleteResult, error) {
+	var result DeleteResult
+	start, end := deleteTime(mint), deleteTime(maxt)
+	for _, ms := range matcherSets {
+		tables, series, err := d.lookupSeries(scopeToTenant(ms, tenant))
+		if err != nil {
+			return result, err
+		}
+		for i, table := range tables {
+			ids := make([]int64, len(series[i]))
+			for j, id := range series[i] {
+				ids[j] = int64(id)
+			}
+
+			if err = d.decompressBetween(table, ids, start, end); err != nil {
+				return result, fmt.Errorf("decompressing the chunks of %s: %w", table, err)
+			}
+			samples, err := d.queryCount(deleteSeriesSamplesSQL, table, ids, start, end)
+			if err != nil {
+				return result, fmt.Errorf("deleting the samples of %s: %w", table, err)
+			}
+			result.Samples += samples
+			if samples > 0 {
+				d.invalidate(tenant, mint)
+			}
+
+			if dropEmptySeries {
+				deleted, err := d.queryCount(deleteEmptySeriesSQL, table, ids)
+				if err != nil {
+					return result, fmt.Errorf("deleting the empty series of %s: %w", table, err)
+				}
+				if deleted > 0 && d.onSeriesDeleted != nil {
+					d.onSeriesDeleted()
+				}
+				result.Series += int(deleted)
+			}
+		}
+	}
+	log.Info("msg", "Deleted series", "tenant", tenant, "samples", result.Samples, "series", result.Series)
+	return result, nil
+}
+
+// decompressBetween decompresses the chunks of table holding samples of the
+// series between start and end. The bounds are first narrowed to the samples
+// of the series, so that deleting all the samples of a few series only
+// decompresses the chunks they are in.
+func (d *SeriesDeleter) decompressBetween(table string, ids []int64, start, end time.Time) error {
+	rows, err := d.conn.Query(context.Background(), fmt.Sprintf(seriesTimeRangeSQLFormat, pgx.Identifier{dataSchema, table}.Sanitize()), ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var first, last pgtype.Timestamptz
+	if rows.Next() {
+		if err = rows.Scan(&first, &last); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	if first.Status != pgtype.Present || last.Status != pgtype.Present {
+		return nil
+	}
+	if first.Time.After(start) {
+		start = first.Time
+	}
+	if last.Time.Before(end) {
+		end = last.Time
+	}
+	if start.After(end) {
+		return nil
+	}
+	_, err = d.conn.Exec(context.Background(), decompressChunksBetweenSQL, table, start, end)
+	return err
+}
+
+// invalidate drops the cached query results of tenant that samples at or
+// after mint contribute to, and records the modification for the other
+// connectors.
+func (d *SeriesDeleter) invalidate(tenant string, mint int64) {
+	if d.invalidator != nil {
+		d.invalidator.Invalidate(tenant, mint)
+	}
+	if err := markDataModified(d.conn, tenant); err != nil {
+		log.Warn("msg", "could not record the deletion of samples", "tenant", tenant, "err", err)
+	}
+}
+
+// lookupSeries returns the tables of the metrics with series matching ms,
+// with the ids of those series.
+func (d *SeriesDeleter) lookupSeries(ms []*labels.Matcher) ([]string, [][]SeriesID, error) {
+	_, cases, values, err := buildSubQueries(ms)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := d.conn.Query(context.Background(), fmt.Sprintf(deleteSeriesLookupSQLFormat, catalogSchema, strings.Join(cases, " AND ")), values...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	return getSeriesPerMetric(rows)
+}
+
+// queryCount runs a query returning a single count.
+func (d *SeriesDeleter) queryCount(sql string, args ...interface{}) (int64, error) {
+	rows, err := d.conn.Query(context.Background(), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var count int64
+	if rows.Next() {
+		if err = rows.Scan(&count); err != nil {
+			return 0, err
+		}
+	}
+	return count, rows.Err()
+}
+
+func deleteTime(t int64) time.Time {
+	tm := model.Time(t).Time()
+	if t < minDeleteTime.Unix()*1000 {
+		return minDeleteTime
+	}
+	if t > maxDeleteTime.Unix()*1000 {
+		return maxDeleteTime
+	}
+	return tm
+}
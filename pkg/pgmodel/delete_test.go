@@ -0,0 +1,232 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// recordingInvalidator records the invalidations of cached query results.
+type recordingInvalidator struct {
+	tenants []string
+	mints   []int64
+}
+
+func (r *recordingInvalidator) Invalidate(tenant string, mint int64) bool {
+	r.tenants = append(r.tenants, tenant)
+	r.mints = append(r.mints, mint)
+	return true
+}
+
+func TestSeriesDeleterDelete(t *testing.T) {
+	lookupSQL := "SELECT m.table_name, array_agg(s.id)\n\t" +
+		"FROM _prom_catalog.series s\n\t" +
+		"INNER JOIN _prom_catalog.metric m\n\t" +
+		"ON (m.id = s.metric_id)\n\t" +
+		"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2) AND " +
+		"labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4)\n\t" +
+		"GROUP BY m.table_name\n\t" +
+		"ORDER BY m.table_name"
+	start, end := time.Unix(1, 0), time.Unix(2, 0)
+	timeRangeSQL := `SELECT min(time), max(time) FROM "prom_data"."foo" WHERE series_id = ANY($1)`
+
+	testCases := []struct {
+		name            string
+		tenant          string
+		dropEmptySeries bool
+		queries         []sqlQuery
+		result          DeleteResult
+		seriesDeleted   bool
+		invalidated     []string
+		err             bool
+	}{
+		{
+			name: "samples",
+			queries: []sqlQuery{
+				{
+					sql:     lookupSQL,
+					args:    []interface{}{"__name__", "foo", "job", "a"},
+					results: rowResults{{"foo", []int64{1, 2}}},
+				},
+				{
+					sql:     timeRangeSQL,
+					args:    []interface{}{[]int64{1, 2}},
+					results: rowResults{{time.Unix(0, 0), time.Unix(3, 0)}},
+				},
+				{sql: decompressChunksBetweenSQL, args: []interface{}{"foo", start, end}},
+				{
+					sql:     deleteSeriesSamplesSQL,
+					args:    []interface{}{"foo", []int64{1, 2}, start, end},
+					results: rowResults{{int64(5)}},
+				},
+				{sql: markDataModifiedSQL, args: []interface{}{""}},
+			},
+			result:      DeleteResult{Samples: 5},
+			invalidated: []string{""},
+		},
+		{
+			name:            "empty series",
+			dropEmptySeries: true,
+			queries: []sqlQuery{
+				{
+					sql:     lookupSQL,
+					args:    []interface{}{"__name__", "foo", "job", "a"},
+					results: rowResults{{"foo", []int64{1}}},
+				},
+				{
+					sql:     timeRangeSQL,
+					args:    []interface{}{[]int64{1}},
+					results: rowResults{{time.Unix(0, 0), time.Unix(3, 0)}},
+				},
+				{sql: decompressChunksBetweenSQL, args: []interface{}{"foo", start, end}},
+				{
+					sql:     deleteSeriesSamplesSQL,
+					args:    []interface{}{"foo", []int64{1}, start, end},
+					results: rowResults{{int64(3)}},
+				},
+				{sql: markDataModifiedSQL, args: []interface{}{""}},
+				{
+					sql:     deleteEmptySeriesSQL,
+					args:    []interface{}{"foo", []int64{1}},
+					results: rowResults{{int64(1)}},
+				},
+			},
+			result:        DeleteResult{Samples: 3, Series: 1},
+			seriesDeleted: true,
+			invalidated:   []string{""},
+		},
+		{
+			name: "bounds narrowed to the samples",
+			queries: []sqlQuery{
+				{
+					sql:     lookupSQL,
+					args:    []interface{}{"__name__", "foo", "job", "a"},
+					results: rowResults{{"foo", []int64{1}}},
+				},
+				{
+					sql:     timeRangeSQL,
+					args:    []interface{}{[]int64{1}},
+					results: rowResults{{time.Unix(1, 5e8), time.Unix(1, 8e8)}},
+				},
+				{sql: decompressChunksBetweenSQL, args: []interface{}{"foo", time.Unix(1, 5e8), time.Unix(1, 8e8)}},
+				{
+					sql:     deleteSeriesSamplesSQL,
+					args:    []interface{}{"foo", []int64{1}, start, end},
+					results: rowResults{{int64(2)}},
+				},
+				{sql: markDataModifiedSQL, args: []interface{}{""}},
+			},
+			result:      DeleteResult{Samples: 2},
+			invalidated: []string{""},
+		},
+		{
+			name: "no samples to decompress",
+			queries: []sqlQuery{
+				{
+					sql:     lookupSQL,
+					args:    []interface{}{"__name__", "foo", "job", "a"},
+					results: rowResults{{"foo", []int64{1}}},
+				},
+				{
+					sql:     timeRangeSQL,
+					args:    []interface{}{[]int64{1}},
+					results: rowResults{{time.Unix(3, 0), time.Unix(4, 0)}},
+				},
+				{
+					sql:     deleteSeriesSamplesSQL,
+					args:    []interface{}{"foo", []int64{1}, start, end},
+					results: rowResults{{int64(0)}},
+				},
+			},
+		},
+		{
+			name:   "no matching series",
+			tenant: "tenant-a",
+			queries: []sqlQuery{
+				{
+					sql: "SELECT m.table_name, array_agg(s.id)\n\t" +
+						"FROM _prom_catalog.series s\n\t" +
+						"INNER JOIN _prom_catalog.metric m\n\t" +
+						"ON (m.id = s.metric_id)\n\t" +
+						"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2) AND " +
+						"labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4) AND " +
+						"labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $5 and l.value = $6)\n\t" +
+						"GROUP BY m.table_name\n\t" +
+						"ORDER BY m.table_name",
+					args: []interface{}{"__name__", "foo", "job", "a", TenantLabelName, "tenant-a"},
+				},
+			},
+		},
+		{
+			name: "error",
+			queries: []sqlQuery{
+				{
+					sql:     lookupSQL,
+					args:    []interface{}{"__name__", "foo", "job", "a"},
+					results: rowResults{{"foo", []int64{1}}},
+				},
+				{
+					sql:     timeRangeSQL,
+					args:    []interface{}{[]int64{1}},
+					results: rowResults{{time.Unix(0, 0), time.Unix(3, 0)}},
+				},
+				{sql: decompressChunksBetweenSQL, args: []interface{}{"foo", start, end}, err: fmt.Errorf("some error")},
+			},
+			err: true,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			seriesDeleted := false
+			invalidator := &recordingInvalidator{}
+			deleter := &SeriesDeleter{
+				conn:            &sqlRecorder{t: t, queries: c.queries},
+				onSeriesDeleted: func() { seriesDeleted = true },
+				invalidator:     invalidator,
+			}
+			ms := []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "foo"),
+				labels.MustNewMatcher(labels.MatchEqual, "job", "a"),
+			}
+
+			result, err := deleter.Delete(c.tenant, 1000, 2000, c.dropEmptySeries, ms)
+			if c.err != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != c.result {
+				t.Errorf("unexpected result: got %+v, wanted %+v", result, c.result)
+			}
+			if seriesDeleted != c.seriesDeleted {
+				t.Errorf("unexpected series cache invalidation: %v", seriesDeleted)
+			}
+			if !reflect.DeepEqual(invalidator.tenants, c.invalidated) {
+				t.Errorf("unexpected results cache invalidation: %v", invalidator.tenants)
+			}
+			for _, mint := range invalidator.mints {
+				if mint != 1000 {
+					t.Errorf("unexpected invalidation from %d", mint)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteTime(t *testing.T) {
+	if got := deleteTime(1000); !got.Equal(time.Unix(1, 0)) {
+		t.Errorf("unexpected time: %v", got)
+	}
+	if got := deleteTime(-1 << 62); !got.Equal(minDeleteTime) {
+		t.Errorf("unexpected min time: %v", got)
+	}
+	if got := deleteTime(1 << 62); !got.Equal(maxDeleteTime) {
+		t.Errorf("unexpected max time: %v", got)
+	}
+}
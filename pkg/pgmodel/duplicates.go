@@ -0,0 +1,205 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/timescale/promscale/pkg/log"
+)
+
+const (
+	getDuplicatePoliciesSQL = "SELECT metric_name, duplicate_policy FROM " + catalogSchema + ".get_duplicate_policies()"
+
+	// duplicatePolicyRefreshInterval is how long the duplicate policies are
+	// cached before being read again from the database.
+	duplicatePolicyRefreshInterval = time.Minute
+
+	// overwriteSamplesSQLFormat keeps the last of the samples of a batch with
+	// the same series and time, since a row can't be updated twice by the same
+	// statement. Only the rows inserted, not the updated ones, have a zero
+	// xmax, so the rows affected are the samples that weren't duplicates.
+	overwriteSamplesSQLFormat = "WITH upserted AS (INSERT INTO %s(time, value, series_id) SELECT DISTINCT ON (s,t) t,v,s FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) WITH ORDINALITY a(t,v,s,o) ORDER BY s,t,o DESC ON CONFLICT (series_id, time) DO UPDATE SET value = EXCLUDED.value RETURNING xmax) SELECT FROM upserted WHERE xmax = 0"
+	rejectSamplesSQLFormat    = "INSERT INTO %s(time, value, series_id) SELECT * FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) a(t,v,s) ORDER BY s,t"
+
+	// the staging table is filled by a single COPY, so its ctid follows the
+	// order of the samples in the batch.
+	overwriteFromStagingSQLFormat = "WITH upserted AS (INSERT INTO %s(time, value, series_id) SELECT DISTINCT ON (series_id, time) time, value, series_id FROM " + stagingTable + " ORDER BY series_id, time, ctid DESC ON CONFLICT (series_id, time) DO UPDATE SET value = EXCLUDED.value RETURNING xmax) SELECT FROM upserted WHERE xmax = 0"
+	rejectFromStagingSQLFormat    = "INSERT INTO %s(time, value, series_id) SELECT time, value, series_id FROM " + stagingTable + " ORDER BY series_id, time"
+)
+
+// DuplicatePolicy is what happens to the samples of a series at a time for
+// which the series already has a sample.
+type DuplicatePolicy int
+
+const (
+	// DuplicatePolicyIgnore keeps the sample already stored and drops the
+	// new one.
+	DuplicatePolicyIgnore DuplicatePolicy = iota
+	// DuplicatePolicyOverwrite replaces the value of the sample already
+	// stored with the new one.
+	DuplicatePolicyOverwrite
+	// DuplicatePolicyReject fails the writes holding duplicate samples with
+	// a LimitError. The other writes of the same batch are still inserted.
+	DuplicatePolicyReject
+)
+
+var duplicatePolicyNames = map[DuplicatePolicy]string{
+	DuplicatePolicyIgnore:    "ignore",
+	DuplicatePolicyOverwrite: "overwrite",
+	DuplicatePolicyReject:    "reject",
+}
+
+func (p DuplicatePolicy) String() string {
+	if name, ok := duplicatePolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("DuplicatePolicy(%d)", int(p))
+}
+
+// ParseDuplicatePolicy returns the duplicate policy of the given name.
+func ParseDuplicatePolicy(name string) (DuplicatePolicy, error) {
+	for p, n := range duplicatePolicyNames {
+		if n == name {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid duplicate policy %q, must be one of ignore, overwrite or reject", name)
+}
+
+// insertSamplesSQL returns the query inserting the unnested sample arrays
+// into table according to the policy.
+func (p DuplicatePolicy) insertSamplesSQL(table string) string {
+	format := insertSamplesSQLFormat
+	switch p {
+	case DuplicatePolicyOverwrite:
+		format = overwriteSamplesSQLFormat
+	case DuplicatePolicyReject:
+		format = rejectSamplesSQLFormat
+	}
+	return fmt.Sprintf(format, pgx.Identifier{dataSchema, table}.Sanitize())
+}
+
+// insertFromStagingSQL returns the query moving the samples of the staging
+// table into table according to the policy.
+func (p DuplicatePolicy) insertFromStagingSQL(table string) string {
+	format := insertFromStagingSQLFormat
+	switch p {
+	case DuplicatePolicyOverwrite:
+		format = overwriteFromStagingSQLFormat
+	case DuplicatePolicyReject:
+		format = rejectFromStagingSQLFormat
+	}
+	return fmt.Sprintf(format, pgx.Identifier{dataSchema, table}.Sanitize())
+}
+
+// duplicatePolicies caches the duplicate policies of the metrics, which are
+// set with the prom_api SQL functions.
+type duplicatePolicies struct {
+	conn pgxConn
+
+	mu        sync.Mutex
+	def       DuplicatePolicy
+	overrides map[string]DuplicatePolicy
+	loadedAt  time.Time
+}
+
+func newDuplicatePolicies(conn pgxConn) *duplicatePolicies {
+	return &duplicatePolicies{conn: conn}
+}
+
+// get returns the duplicate policy of the metric. The policies are read from
+// the database at most once per refresh interval. If they can't be read
+// again the previous ones are kept.
+func (d *duplicatePolicies) get(metric string) (DuplicatePolicy, error) {
+	if d == nil {
+		return DuplicatePolicyIgnore, nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if time.Since(d.loadedAt) >= duplicatePolicyRefreshInterval {
+		err := d.load()
+		if err != nil {
+			if d.loadedAt.IsZero() {
+				return 0, err
+			}
+			log.Warn("msg", "Error reading the duplicate policies, keeping the previous ones", "err", err)
+		}
+		d.loadedAt = time.Now()
+	}
+
+	if p, ok := d.overrides[metric]; ok {
+		return p, nil
+	}
+	return d.def, nil
+}
+
+func (d *duplicatePolicies) load() error {
+	rows, err := d.conn.Query(context.Background(), getDuplicatePoliciesSQL)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	def := DuplicatePolicyIgnore
+	overrides := make(map[string]DuplicatePolicy)
+	for rows.Next() {
+		var metric, name string
+		if err = rows.Scan(&metric, &name); err != nil {
+			return err
+		}
+		p, err := ParseDuplicatePolicy(name)
+		if err != nil {
+			return err
+		}
+		if metric == "" {
+			def = p
+		} else {
+			overrides[metric] = p
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	d.def = def
+	d.overrides = overrides
+	return nil
+}
+
+// insertRejectingDuplicates inserts the samples of every write of req on its
+// own, after the batch failed with duplicates under DuplicatePolicyReject, so
+// that only the writes holding duplicates are rejected.
+func insertRejectingDuplicates(conn pgxConn, req copyRequest, method insertMethod) {
+	start := 0
+	for i := range req.data.needsResponse {
+		task := &req.data.needsResponse[i]
+		infos := req.data.batch.sampleInfos[start : start+task.numSeries]
+		start += task.numSeries
+
+		sub := &pendingBuffer{batch: NewSampleInfoIterator()}
+		numSamples := 0
+		for j := range infos {
+			sub.batch.Append(infos[j])
+			numSamples += len(infos[j].samples)
+		}
+
+		err := doInsert(conn, copyRequest{data: sub, table: req.table, metric: req.metric, policy: req.policy}, method)
+		switch {
+		case isUniqueViolation(err):
+			metricDuplicateSamples.WithLabelValues(req.metric, req.policy.String()).Add(float64(numSamples))
+			limitErr := &LimitError{}
+			limitErr.add(reasonDuplicate, numSamples, fmt.Sprintf("metric %s rejects duplicate samples", req.metric))
+			task.reportError(limitErr)
+		case err != nil:
+			task.reportError(err)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/prometheus/common/model"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestParseDuplicatePolicy(t *testing.T) {
+	for _, p := range []DuplicatePolicy{DuplicatePolicyIgnore, DuplicatePolicyOverwrite, DuplicatePolicyReject} {
+		parsed, err := ParseDuplicatePolicy(p.String())
+		if err != nil || parsed != p {
+			t.Errorf("unexpected policy parsed from %s: %v, %v", p, parsed, err)
+		}
+	}
+	if _, err := ParseDuplicatePolicy("foo"); err == nil {
+		t.Errorf("expected an error for an invalid policy")
+	}
+}
+
+func TestDuplicatePoliciesGet(t *testing.T) {
+	mock := &sqlRecorder{t: t, queries: []sqlQuery{
+		{
+			sql:     getDuplicatePoliciesSQL,
+			results: rowResults{{"", "reject"}, {"foo", "overwrite"}},
+		},
+	}}
+	policies := newDuplicatePolicies(mock)
+	for metric, expected := range map[string]DuplicatePolicy{"foo": DuplicatePolicyOverwrite, "bar": DuplicatePolicyReject} {
+		p, err := policies.get(metric)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p != expected {
+			t.Errorf("unexpected policy for %s: got %s, wanted %s", metric, p, expected)
+		}
+	}
+	if mock.nextQuery != 1 {
+		t.Errorf("the policies were read %d times, wanted once", mock.nextQuery)
+	}
+
+	var noPolicies *duplicatePolicies
+	if p, err := noPolicies.get("foo"); err != nil || p != DuplicatePolicyIgnore {
+		t.Errorf("unexpected policy without a cache: %v, %v", p, err)
+	}
+}
+
+func TestDuplicatePoliciesGetError(t *testing.T) {
+	readErr := errors.New("read error")
+	mock := &sqlRecorder{t: t, queries: []sqlQuery{
+		{sql: getDuplicatePoliciesSQL, err: readErr},
+		{sql: getDuplicatePoliciesSQL, results: rowResults{{"", "overwrite"}}},
+		{sql: getDuplicatePoliciesSQL, err: readErr},
+	}}
+	policies := newDuplicatePolicies(mock)
+	if _, err := policies.get("foo"); !errors.Is(err, readErr) {
+		t.Fatalf("unexpected error: got %v, wanted %v", err, readErr)
+	}
+	if p, err := policies.get("foo"); err != nil || p != DuplicatePolicyOverwrite {
+		t.Fatalf("unexpected policy: %v, %v", p, err)
+	}
+
+	// a failed refresh keeps the previous policies
+	policies.loadedAt = time.Now().Add(-duplicatePolicyRefreshInterval)
+	if p, err := policies.get("foo"); err != nil || p != DuplicatePolicyOverwrite {
+		t.Fatalf("unexpected policy: %v, %v", p, err)
+	}
+	if mock.nextQuery != 3 {
+		t.Errorf("only %d of the 3 queries were run", mock.nextQuery)
+	}
+}
+
+func TestDoInsertDuplicatePolicies(t *testing.T) {
+	copyStagingSQL := `COPY "promscale_staging"(time, value, series_id)`
+
+	t1 := model.Time(1000).Time()
+	rows := []interface{}{
+		[]interface{}{t1, 0.1, int64(1)},
+		[]interface{}{t1, 0.2, int64(1)},
+	}
+	insertArgs := []interface{}{[]time.Time{t1, t1}, []float64{0.1, 0.2}, []int64{1, 1}}
+
+	testCases := []struct {
+		name    string
+		policy  DuplicatePolicy
+		method  insertMethod
+		queries []sqlQuery
+		err     bool
+	}{
+		{
+			name:   "overwrite insert",
+			policy: DuplicatePolicyOverwrite,
+			method: insertMethod{mode: InsertModeInsert},
+			queries: []sqlQuery{{
+				sql:  `WITH upserted AS (INSERT INTO "prom_data"."foo"(time, value, series_id) SELECT DISTINCT ON (s,t) t,v,s FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) WITH ORDINALITY a(t,v,s,o) ORDER BY s,t,o DESC ON CONFLICT (series_id, time) DO UPDATE SET value = EXCLUDED.value RETURNING xmax) SELECT FROM upserted WHERE xmax = 0`,
+				args: insertArgs,
+			}},
+		},
+		{
+			name:   "overwrite copy",
+			policy: DuplicatePolicyOverwrite,
+			method: insertMethod{mode: InsertModeCopy},
+			queries: []sqlQuery{
+				{sql: copyStagingSQL, args: rows},
+				{
+					sql:     `WITH upserted AS (INSERT INTO "prom_data"."foo"(time, value, series_id) SELECT DISTINCT ON (series_id, time) time, value, series_id FROM promscale_staging ORDER BY series_id, time, ctid DESC ON CONFLICT (series_id, time) DO UPDATE SET value = EXCLUDED.value RETURNING xmax) SELECT FROM upserted WHERE xmax = 0`,
+					results: rowResults{{int64(1)}},
+				},
+			},
+		},
+		{
+			name:   "reject insert",
+			policy: DuplicatePolicyReject,
+			method: insertMethod{mode: InsertModeInsert},
+			queries: []sqlQuery{{
+				sql:  `INSERT INTO "prom_data"."foo"(time, value, series_id) SELECT * FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) a(t,v,s) ORDER BY s,t`,
+				args: insertArgs,
+				err:  &pgconn.PgError{Code: pgerrcode.UniqueViolation},
+			}},
+			err: true,
+		},
+		{
+			name:   "reject copy",
+			policy: DuplicatePolicyReject,
+			method: insertMethod{mode: InsertModeCopy},
+			queries: []sqlQuery{
+				{sql: copyStagingSQL, args: rows},
+				{
+					sql:     `INSERT INTO "prom_data"."foo"(time, value, series_id) SELECT time, value, series_id FROM promscale_staging ORDER BY series_id, time`,
+					results: rowResults{{int64(2)}},
+				},
+			},
+		},
+		{
+			name:   "reject copy direct",
+			policy: DuplicatePolicyReject,
+			method: insertMethod{mode: InsertModeCopyDirect},
+			queries: []sqlQuery{
+				{sql: `COPY "prom_data"."foo"(time, value, series_id)`, args: rows, err: &pgconn.PgError{Code: pgerrcode.UniqueViolation}},
+			},
+			err: true,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := &sqlRecorder{t: t, queries: c.queries}
+			pending := &pendingBuffer{batch: NewSampleInfoIterator()}
+			pending.batch.Append(samplesInfo{seriesID: 1, samples: []prompb.Sample{{Timestamp: 1000, Value: 0.1}, {Timestamp: 1000, Value: 0.2}}})
+
+			err := doInsert(mock, copyRequest{data: pending, table: "foo", metric: "foo", policy: c.policy}, c.method)
+			if c.err != isUniqueViolation(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mock.nextQuery != len(c.queries) {
+				t.Errorf("only %d of the %d queries were run", mock.nextQuery, len(c.queries))
+			}
+		})
+	}
+}
+
+func TestInsertErrorFallbackRejectsDuplicates(t *testing.T) {
+	insertSQL := `INSERT INTO "prom_data"."foo"(time, value, series_id) SELECT * FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) a(t,v,s) ORDER BY s,t`
+	t1, t2 := model.Time(1000).Time(), model.Time(2000).Time()
+	mock := &sqlRecorder{t: t, queries: []sqlQuery{
+		{
+			sql:  insertSQL,
+			args: []interface{}{[]time.Time{t1}, []float64{0.1}, []int64{2}},
+		},
+		{
+			sql:  insertSQL,
+			args: []interface{}{[]time.Time{t2}, []float64{0.2}, []int64{1}},
+			err:  &pgconn.PgError{Code: pgerrcode.UniqueViolation},
+		},
+	}}
+
+	tasks := []insertDataTask{
+		{errChan: make(chan error, 1), numSeries: 1},
+		{errChan: make(chan error, 1), numSeries: 1},
+	}
+	pending := &pendingBuffer{needsResponse: tasks, batch: NewSampleInfoIterator()}
+	pending.batch.Append(samplesInfo{seriesID: 2, samples: []prompb.Sample{{Timestamp: 1000, Value: 0.1}}})
+	pending.batch.Append(samplesInfo{seriesID: 1, samples: []prompb.Sample{{Timestamp: 2000, Value: 0.2}}})
+
+	req := copyRequest{data: pending, table: "foo", metric: "foo", policy: DuplicatePolicyReject}
+	err := insertErrorFallback(mock, req, &pgconn.PgError{Code: pgerrcode.UniqueViolation}, insertMethod{mode: InsertModeInsert})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mock.nextQuery != 2 {
+		t.Errorf("only %d of the 2 queries were run", mock.nextQuery)
+	}
+
+	select {
+	case err := <-tasks[0].errChan:
+		t.Errorf("unexpected error for the write without duplicates: %v", err)
+	default:
+	}
+
+	select {
+	case err := <-tasks[1].errChan:
+		var limitErr *LimitError
+		if !errors.As(err, &limitErr) || limitErr.Reason != reasonDuplicate || limitErr.Samples != 1 {
+			t.Errorf("unexpected error for the write with duplicates: %v", err)
+		}
+	default:
+		t.Errorf("the write with duplicates wasn't rejected")
+	}
+}
+
+func TestInsertHandlerResentData(t *testing.T) {
+	mock := &sqlRecorder{t: t, queries: []sqlQuery{
+		{
+			sql:     getDuplicatePoliciesSQL,
+			results: rowResults{{"", "reject"}},
+		},
+	}}
+	toCopiers := make(chan copyRequest, 3)
+	handler := insertHandler{
+		pending:           pendingBuffers.Get().(*pendingBuffer),
+		seriesCache:       NewSeriesCache(DefaultSeriesCacheSize),
+		metricName:        "foo",
+		metricTableName:   "foo",
+		toCopiers:         toCopiers,
+		duplicatePolicies: newDuplicatePolicies(mock),
+	}
+
+	// resent data is never batched with new data
+	for _, resent := range []bool{false, true, false} {
+		req := insertDataRequest{
+			metric:   "foo",
+			data:     []samplesInfo{{seriesID: 1, samples: []prompb.Sample{{Timestamp: 1000, Value: 0.1}}}},
+			finished: &sync.WaitGroup{},
+			errChan:  make(chan error, 1),
+			resent:   resent,
+		}
+		req.finished.Add(1)
+		handler.handleReq(req)
+	}
+	handler.flush()
+	close(toCopiers)
+
+	var policies []DuplicatePolicy
+	for req := range toCopiers {
+		if len(req.data.batch.sampleInfos) != 1 {
+			t.Errorf("unexpected batch of %d series", len(req.data.batch.sampleInfos))
+		}
+		policies = append(policies, req.policy)
+	}
+	expected := []DuplicatePolicy{DuplicatePolicyReject, DuplicatePolicyIgnore, DuplicatePolicyReject}
+	if len(policies) != len(expected) {
+		t.Fatalf("unexpected policies: %v", policies)
+	}
+	for i := range expected {
+		if policies[i] != expected[i] {
+			t.Errorf("unexpected policies: got %v, wanted %v", policies, expected)
+			break
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/jackc/pgerrcode"
 	pgx "github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/timescale/promscale/pkg/prompb"
 
 	_ "github.com/jackc/pgx/v4/stdlib"
@@ -461,6 +462,158 @@ func TestSQLIngest(t *testing.T) {
 	}
 }
 
+func TestSQLIngestMetadata(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ingestor, err := NewPgxIngestor(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+
+		req := NewWriteRequest()
+		req.Metadata = []prompb.MetricMetadata{
+			{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total", Help: "Total requests"},
+			{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total", Help: "Total requests"},
+			{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "temperature", Help: "Temperature", Unit: "celsius"},
+		}
+		if _, err = ingestor.Ingest(nil, req); err != nil {
+			t.Fatal(err)
+		}
+
+		reader := NewPgxReader(db, nil, 100)
+		metadata, err := reader.Metadata("", -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string][]MetricMetadata{
+			"http_requests_total": {{Type: "counter", Help: "Total requests"}},
+			"temperature":         {{Type: "gauge", Help: "Temperature", Unit: "celsius"}},
+		}
+		if !reflect.DeepEqual(metadata, expected) {
+			t.Fatalf("unexpected metadata:\ngot\n%v\nwanted\n%v", metadata, expected)
+		}
+
+		metadata, err = reader.Metadata("", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metadata) != 1 {
+			t.Fatalf("limit not respected, got %d metrics", len(metadata))
+		}
+
+		// tenants only see the metadata they wrote
+		req = NewWriteRequest()
+		req.Metadata = []prompb.MetricMetadata{
+			{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "queue_length", Help: "Queue length"},
+		}
+		if _, err = ingestor.IngestForTenant("tenant-a", nil, req); err != nil {
+			t.Fatal(err)
+		}
+		metadata, err = reader.ForTenant("tenant-a").Metadata("", -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected = map[string][]MetricMetadata{
+			"queue_length": {{Type: "gauge", Help: "Queue length"}},
+		}
+		if !reflect.DeepEqual(metadata, expected) {
+			t.Fatalf("unexpected metadata of the tenant:\ngot\n%v\nwanted\n%v", metadata, expected)
+		}
+		if metadata, err = reader.ForTenant("tenant-b").Metadata("", -1); err != nil || len(metadata) != 0 {
+			t.Fatalf("unexpected metadata of another tenant: %v %v", metadata, err)
+		}
+	})
+}
+
+func TestSQLIngestExemplars(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ts := []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: "latency_bucket"},
+					{Name: "le", Value: "0.5"},
+				},
+				Samples: []prompb.Sample{
+					{Timestamp: 1000, Value: 1},
+				},
+				Exemplars: []prompb.Exemplar{
+					{Labels: []prompb.Label{{Name: "trace_id", Value: "abc"}}, Timestamp: 1000, Value: 0.25},
+				},
+			},
+			{
+				// exemplars may be sent without samples
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: "latency_bucket"},
+					{Name: "le", Value: "1"},
+				},
+				Exemplars: []prompb.Exemplar{
+					{Labels: []prompb.Label{{Name: "trace_id", Value: "def"}}, Timestamp: 2000, Value: 0.75},
+				},
+			},
+			{
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: "no_exemplars"},
+				},
+				Samples: []prompb.Sample{
+					{Timestamp: 1000, Value: 1},
+				},
+			},
+		}
+		ingestor, err := NewPgxIngestor(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err = ingestor.Ingest(copyMetrics(ts), NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		// inserting the same exemplars again is a no-op
+		if _, err = ingestor.Ingest(copyMetrics(ts), NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+
+		reader := NewPgxReader(db, nil, 100)
+		results, err := reader.Exemplars(0, 1500,
+			[]*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "latency_bucket")},
+			[]*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, MetricNameLabelName, "no_.*")},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []ExemplarQueryResult{{
+			SeriesLabels: labels.FromStrings(MetricNameLabelName, "latency_bucket", "le", "0.5"),
+			Exemplars: []Exemplar{
+				{Labels: labels.FromStrings("trace_id", "abc"), Timestamp: 1000, Value: 0.25},
+			},
+		}}
+		if !reflect.DeepEqual(results, expected) {
+			t.Fatalf("unexpected exemplars:\ngot\n%v\nwanted\n%v", results, expected)
+		}
+
+		results, err = reader.Exemplars(0, 3000,
+			[]*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "le", "1")},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected = []ExemplarQueryResult{{
+			SeriesLabels: labels.FromStrings(MetricNameLabelName, "latency_bucket", "le", "1"),
+			Exemplars: []Exemplar{
+				{Labels: labels.FromStrings("trace_id", "def"), Timestamp: 2000, Value: 0.75},
+			},
+		}}
+		if !reflect.DeepEqual(results, expected) {
+			t.Fatalf("unexpected exemplars:\ngot\n%v\nwanted\n%v", results, expected)
+		}
+	})
+}
+
 func TestInsertCompressedDuplicates(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -2,6 +2,7 @@ package end_to_end_tests
 
 import (
 	"io/ioutil"
+	"math"
 	"os"
 
 	"github.com/golang/snappy"
@@ -57,6 +58,37 @@ func generateSmallTimeseries() []prompb.TimeSeries {
 
 // This function is used to generate timeseries used for ingesting into
 // Prometheus and the connector to verify same results are being returned.
+// generateNaNTimeseries returns a series sampling NaN every third step among
+// numbers and a series sampling only NaN.
+func generateNaNTimeseries() []prompb.TimeSeries {
+	metrics := []prompb.TimeSeries{
+		{
+			Labels: []prompb.Label{
+				{Name: pgmodel.MetricNameLabelName, Value: "nan_metric"},
+				{Name: "instance", Value: "1"},
+			},
+		},
+		{
+			Labels: []prompb.Label{
+				{Name: pgmodel.MetricNameLabelName, Value: "nan_metric"},
+				{Name: "instance", Value: "2"},
+			},
+		},
+	}
+
+	for i := 0; i < 120; i++ {
+		ts := startTime + int64(i)*30*1000
+		v := float64(i % 7)
+		if i%3 == 0 {
+			v = math.NaN()
+		}
+		metrics[0].Samples = append(metrics[0].Samples, prompb.Sample{Timestamp: ts, Value: v})
+		metrics[1].Samples = append(metrics[1].Samples, prompb.Sample{Timestamp: ts, Value: math.NaN()})
+	}
+
+	return metrics
+}
+
 func generateLargeTimeseries() []prompb.TimeSeries {
 	metrics := []prompb.TimeSeries{
 		{
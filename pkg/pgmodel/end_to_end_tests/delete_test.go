@@ -0,0 +1,127 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package end_to_end_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/prompb"
+
+	. "github.com/timescale/promscale/pkg/pgmodel"
+)
+
+func TestSQLDeleteSeries(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ts := []prompb.TimeSeries{
+			{
+				//this series is left without samples and deleted with its label
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: "test"},
+					{Name: "user", Value: "a"},
+				},
+				Samples: []prompb.Sample{
+					{Timestamp: 1000, Value: 0.1},
+					{Timestamp: 2000, Value: 0.2},
+				},
+			},
+			{
+				//this series keeps the samples out of the deleted range
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: "test"},
+					{Name: "user", Value: "b"},
+				},
+				Samples: []prompb.Sample{
+					{Timestamp: 1000, Value: 0.1},
+					{Timestamp: 5000, Value: 0.5},
+				},
+			},
+			{
+				//this series doesn't match
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: "test"},
+					{Name: "user", Value: "c"},
+				},
+				Samples: []prompb.Sample{
+					{Timestamp: 1000, Value: 0.1},
+				},
+			},
+		}
+		ingestor, err := NewPgxIngestor(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err = ingestor.Ingest(copyMetrics(ts), NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+
+		if *useTimescaleDB {
+			if err = ingestor.CompleteMetricCreation(); err != nil {
+				t.Fatal(err)
+			}
+			_, err = db.Exec(context.Background(), "SELECT compress_chunk(i) from show_chunks('prom_data.test') i;")
+			if err != nil {
+				if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.SQLState() == "42710" {
+					//already compressed (could happen if policy already ran). This is fine
+				} else {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		seriesDeleted := false
+		deleter := NewSeriesDeleter(db, func() { seriesDeleted = true }, nil)
+		result, err := deleter.Delete("", 0, 2000, true, []*labels.Matcher{
+			labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "test"),
+			labels.MustNewMatcher(labels.MatchRegexp, "user", "a|b"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Samples != 3 || result.Series != 1 || !seriesDeleted {
+			t.Errorf("unexpected result: %+v, series deleted: %v", result, seriesDeleted)
+		}
+
+		count := 0
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data.test`).Scan(&count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 2 {
+			t.Errorf("unexpected row count: %v", count)
+		}
+
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM _prom_catalog.series`).Scan(&count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 2 {
+			t.Errorf("unexpected series count: %v", count)
+		}
+
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM _prom_catalog.label WHERE key = 'user'`).Scan(&count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 2 {
+			t.Errorf("unexpected labels count: %v", count)
+		}
+
+		var generation int64
+		err = db.QueryRow(context.Background(), `SELECT generation FROM _prom_catalog.data_modification WHERE tenant = ''`).Scan(&generation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if generation != 1 {
+			t.Errorf("unexpected data generation: %v", generation)
+		}
+	})
+}
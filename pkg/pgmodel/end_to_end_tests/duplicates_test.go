@@ -0,0 +1,95 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package end_to_end_tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/timescale/promscale/pkg/prompb"
+
+	. "github.com/timescale/promscale/pkg/pgmodel"
+)
+
+func TestSQLDuplicatePolicies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		_, err := db.Exec(context.Background(), "SELECT prom_api.set_metric_duplicate_policy('overwritten', 'overwrite')")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = db.Exec(context.Background(), "SELECT prom_api.set_metric_duplicate_policy('rejected', 'reject')")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = db.Exec(context.Background(), "SELECT prom_api.set_metric_duplicate_policy('reset', 'reject')")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = db.Exec(context.Background(), "SELECT prom_api.reset_metric_duplicate_policy('reset')")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = db.Exec(context.Background(), "SELECT prom_api.set_default_duplicate_policy('foo')"); err == nil {
+			t.Errorf("expected an error for an invalid policy")
+		}
+
+		for metric, expected := range map[string]string{"overwritten": "overwrite", "rejected": "reject", "reset": "ignore", "unknown": "ignore"} {
+			var policy string
+			err = db.QueryRow(context.Background(), "SELECT _prom_catalog.get_metric_duplicate_policy($1)", metric).Scan(&policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if policy != expected {
+				t.Errorf("unexpected policy for %s: got %s, wanted %s", metric, policy, expected)
+			}
+		}
+
+		series := func(metric string, value float64) prompb.TimeSeries {
+			return prompb.TimeSeries{
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: metric},
+					{Name: "foo", Value: "bar"},
+				},
+				Samples: []prompb.Sample{
+					{Timestamp: 1000, Value: value},
+				},
+			}
+		}
+
+		ingestor, err := NewPgxIngestor(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+
+		for _, metric := range []string{"ignored", "overwritten", "rejected"} {
+			if _, err = ingestor.Ingest(copyMetrics([]prompb.TimeSeries{series(metric, 0.1)}), NewWriteRequest()); err != nil {
+				t.Fatal(err)
+			}
+			_, err = ingestor.Ingest(copyMetrics([]prompb.TimeSeries{series(metric, 0.2)}), NewWriteRequest())
+			var limitErr *LimitError
+			if rejected := errors.As(err, &limitErr); rejected != (metric == "rejected") {
+				t.Errorf("unexpected error for %s: %v", metric, err)
+			}
+		}
+
+		for metric, expected := range map[string]float64{"ignored": 0.1, "overwritten": 0.2, "rejected": 0.1} {
+			var count int
+			var value float64
+			err = db.QueryRow(context.Background(), fmt.Sprintf("SELECT count(*), max(value) FROM prom_data.%s", metric)).Scan(&count, &value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != 1 || value != expected {
+				t.Errorf("unexpected samples for %s: %d samples, value %v", metric, count, value)
+			}
+		}
+	})
+}
@@ -0,0 +1,100 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package end_to_end_tests
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb"
+	"github.com/timescale/promscale/pkg/importer"
+	"github.com/timescale/promscale/pkg/prompb"
+
+	. "github.com/timescale/promscale/pkg/pgmodel"
+)
+
+func TestImportTSDBBlocks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ingestor, err := NewPgxIngestor(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+
+		//the chunk the block is imported into is compressed beforehand
+		ts := []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{
+					{Name: MetricNameLabelName, Value: "imported"},
+					{Name: "job", Value: "a"},
+				},
+				Samples: []prompb.Sample{
+					{Timestamp: 1000, Value: 0.1},
+				},
+			},
+		}
+		if _, err = ingestor.Ingest(copyMetrics(ts), NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		if *useTimescaleDB {
+			if err = ingestor.CompleteMetricCreation(); err != nil {
+				t.Fatal(err)
+			}
+			_, err = db.Exec(context.Background(), "SELECT compress_chunk(i) from show_chunks('prom_data.imported') i;")
+			if err != nil {
+				if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.SQLState() == "42710" {
+					//already compressed (could happen if policy already ran). This is fine
+				} else {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		dir, err := ioutil.TempDir("", "import")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		var samples []*tsdb.MetricSample
+		for _, job := range []string{"a", "b"} {
+			for ts := int64(2000); ts < 12000; ts += 1000 {
+				samples = append(samples, &tsdb.MetricSample{
+					TimestampMs: ts,
+					Value:       float64(ts),
+					Labels:      labels.FromStrings(labels.MetricName, "imported", "job", job),
+				})
+			}
+		}
+		if _, err = tsdb.CreateBlock(samples, dir, 0, 12000, kitlog.NewNopLogger()); err != nil {
+			t.Fatal(err)
+		}
+
+		imp := importer.New(importer.Config{BatchSamples: 5}, ingestor, NewChunkDecompressor(db))
+		result, err := imp.Import(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Blocks != 1 || result.Samples != 20 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+
+		count := 0
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data.imported`).Scan(&count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 21 {
+			t.Errorf("unexpected row count: %v", count)
+		}
+	})
+}
@@ -298,3 +298,56 @@ func TestMigrationLib(t *testing.T) {
 		verifyLogs(t, db, expected)
 	})
 }
+
+func TestMigrationLibDryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	testhelpers.WithDB(t, *testDatabase, testhelpers.NoSuperuser, func(db *pgxpool.Pool, t testing.TB, connectURL string) {
+		testTOC := map[string][]string{
+			"idempotent": {
+				"2-toc-run_first.sql",
+				"1-toc-run_second.sql",
+			},
+		}
+		c, err := db.Acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer c.Release()
+		mig := pgmodel.NewMigrator(c.Conn(), test_migrations.MigrationFiles, testTOC)
+
+		plan, err := mig.Plan(semver.MustParse("0.2.0"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		dryRun, err := mig.DryRun(semver.MustParse("0.2.0"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dryRun.Scripts) != 3 || !reflect.DeepEqual(plan, dryRun) {
+			t.Errorf("unexpected dry run:\n%+v\nplanned:\n%+v", dryRun, plan)
+		}
+
+		//nothing is left by the dry run
+		var exists bool
+		err = db.QueryRow(context.Background(), "SELECT to_regclass('log') IS NOT NULL OR to_regclass('prom_schema_migrations') IS NOT NULL").Scan(&exists)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists {
+			t.Error("the dry run was not rolled back")
+		}
+
+		if err = mig.Migrate(semver.MustParse("0.1.1")); err != nil {
+			t.Fatal(err)
+		}
+		plan, err = mig.Plan(semver.MustParse("0.2.0"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if plan.From.String() != "0.1.1" || len(plan.Scripts) != 3 || plan.Scripts[0].Name != "versions/dev/0.2.0-dev/1-migration.sql" {
+			t.Errorf("unexpected plan: %+v", plan)
+		}
+	})
+}
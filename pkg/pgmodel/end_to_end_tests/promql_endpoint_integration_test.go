@@ -250,5 +250,5 @@ func buildRouter(pool *pgxpool.Pool) (http.Handler, error) {
 		return nil, errors.New("Cannot run test, cannot instantiate pgClient")
 	}
 
-	return api.GenerateRouter(apiConfig, metrics, pgClient, nil), nil
+	return api.GenerateRouter(apiConfig, metrics, pgClient, nil, nil), nil
 }
@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"reflect"
 	"runtime"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +21,8 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
 	"github.com/prometheus/prometheus/tsdb"
 	"github.com/prometheus/prometheus/util/testutil"
 	"github.com/timescale/promscale/pkg/internal/testhelpers"
@@ -1124,7 +1128,7 @@ func TestPushdown(t *testing.T) {
 
 		r := NewPgxReader(readOnly, nil, 100)
 		queryable := query.NewQueryable(r.GetQuerier())
-		queryEngine := query.NewEngine(log.GetLogger(), time.Minute)
+		queryEngine := query.NewEngine(log.GetLogger(), query.EngineConfig{Timeout: time.Minute})
 
 		for _, c := range testCases {
 			tc := c
@@ -1147,3 +1151,104 @@ func TestPushdown(t *testing.T) {
 		}
 	})
 }
+
+// noPushdownQuerier never passes the path of the selectors to the database,
+// so that the engine evaluates the whole query.
+type noPushdownQuerier struct {
+	Querier
+}
+
+func (q noPushdownQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	return q.Querier.Select(mint, maxt, sortSeries, hints, nil, ms...)
+}
+
+func TestPushdownMatchesEngine(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	queries := []string{
+		`rate(metric_1[5m])`,
+		`increase(metric_1[5m])`,
+		`irate(metric_1[5m])`,
+		`avg_over_time(metric_1[5m])`,
+		`min_over_time(metric_1[5m])`,
+		`max_over_time(metric_1[5m])`,
+		`sum_over_time(metric_1[5m])`,
+		`count_over_time(metric_1[5m])`,
+		`stddev_over_time(metric_1[5m])`,
+		`stdvar_over_time(metric_1[5m])`,
+		`sum by (foo) (rate(metric_1[5m]))`,
+		`avg(avg_over_time(metric_1[5m]))`,
+		`max by (instance) (irate(metric_1[5m]))`,
+		`count by (foo, instance) (count_over_time(metric_1[5m]))`,
+		`min_over_time(nan_metric[5m])`,
+		`max_over_time(nan_metric[5m])`,
+		`min(min_over_time(nan_metric[5m]))`,
+		`max(max_over_time(nan_metric[5m]))`,
+	}
+
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ingestQueryTestDataset(db, t, generateLargeTimeseries())
+		ingestQueryTestDataset(db, t, generateNaNTimeseries())
+		readOnly := testhelpers.GetReadOnlyConnection(t, *testDatabase)
+		defer readOnly.Close()
+
+		var tester *testing.T
+		var ok bool
+		if tester, ok = t.(*testing.T); !ok {
+			t.Fatalf("Cannot run test, not an instance of testing.T")
+			return
+		}
+
+		r := NewPgxReader(readOnly, nil, 100)
+		pushdown := query.NewQueryable(r.GetQuerier())
+		engineOnly := query.NewQueryable(noPushdownQuerier{r.GetQuerier()})
+		queryEngine := query.NewEngine(log.GetLogger(), query.EngineConfig{Timeout: time.Minute})
+
+		for _, q := range queries {
+			qs := q
+			tester.Run(qs, func(t *testing.T) {
+				if !ExtensionIsInstalled && (strings.HasPrefix(qs, "rate") || strings.HasPrefix(qs, "increase")) {
+					t.Skip("extension not installed")
+				}
+				eval := func(queryable *query.Queryable) promql.Matrix {
+					qry, err := queryEngine.NewRangeQuery(queryable, qs, model.Time(startTime+300*1000).Time(), model.Time(startTime+1800*1000).Time(), 30*time.Second)
+					if err != nil {
+						t.Fatal(err)
+					}
+					res := qry.Exec(context.Background())
+					if res.Err != nil {
+						t.Fatal(res.Err)
+					}
+					mat := res.Value.(promql.Matrix)
+					sort.Sort(mat)
+					return mat
+				}
+
+				expected := eval(engineOnly)
+				got := eval(pushdown)
+				if len(got) != len(expected) {
+					t.Fatalf("unexpected number of series: got %d wanted %d", len(got), len(expected))
+				}
+				for i := range got {
+					testutil.Equals(t, expected[i].Metric, got[i].Metric)
+					testutil.Equals(t, len(expected[i].Points), len(got[i].Points))
+					for j := range got[i].Points {
+						testutil.Equals(t, expected[i].Points[j].T, got[i].Points[j].T)
+						e, g := expected[i].Points[j].V, got[i].Points[j].V
+						if math.IsNaN(e) || math.IsNaN(g) {
+							if math.IsNaN(e) != math.IsNaN(g) {
+								t.Errorf("unexpected value for %s at %d: got %v wanted %v", got[i].Metric, got[i].Points[j].T, g, e)
+							}
+							continue
+						}
+						if math.Abs(e-g) > 1e-9*math.Max(1, math.Abs(e)) {
+							t.Errorf("unexpected value for %s at %d: got %v wanted %v", got[i].Metric, got[i].Points[j].T, got[i].Points[j].V, expected[i].Points[j].V)
+						}
+					}
+				}
+			})
+		}
+	})
+}
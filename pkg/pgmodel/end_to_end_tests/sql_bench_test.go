@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/prompb"
 )
 
 const (
@@ -280,6 +283,78 @@ func BenchmarkGetOrCreateMetricTableName(b *testing.B) {
 	})
 }
 
+func BenchmarkIngestInsertModes(b *testing.B) {
+	modes := []pgmodel.InsertMode{pgmodel.InsertModeInsert, pgmodel.InsertModeCopy, pgmodel.InsertModeCopyDirect}
+	for i, mode := range modes {
+		for _, numSeries := range []int{10, 100, 1000} {
+			mode, numSeries := mode, numSeries
+			dbName := fmt.Sprintf("bench_8_%d_%d", i, numSeries)
+			b.Run(fmt.Sprintf("%s/%d_series", mode, numSeries), func(b *testing.B) {
+				benchmarkIngest(b, dbName, mode, numSeries)
+			})
+		}
+	}
+}
+
+// benchmarkIngest writes b.N batches of 10 samples for each of numSeries
+// series of a single metric, with mode.
+func benchmarkIngest(b *testing.B, dbName string, mode pgmodel.InsertMode, numSeries int) {
+	const samplesPerSeries = 10
+	b.StopTimer()
+	withDB(b, dbName, func(db *pgxpool.Pool, t testing.TB) {
+		// the copy modes need the staging table on every connection
+		poolCfg := db.Config()
+		poolCfg.AfterConnect = pgmodel.CreateStagingTable
+		pool, err := pgxpool.ConnectConfig(context.Background(), poolCfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer pool.Close()
+
+		cache := &pgmodel.MetricNameCache{Metrics: clockcache.WithMax(pgmodel.DefaultMetricCacheSize)}
+		ingestor, err := pgmodel.NewPgxIngestorWithMetricCache(pool, cache, nil, &pgmodel.Cfg{InsertMode: mode})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+
+		batches := make([][]prompb.TimeSeries, b.N)
+		for n := range batches {
+			batches[n] = make([]prompb.TimeSeries, numSeries)
+			for i := range batches[n] {
+				samples := make([]prompb.Sample, samplesPerSeries)
+				for j := range samples {
+					samples[j] = prompb.Sample{Timestamp: int64((n*samplesPerSeries + j) * 1000), Value: float64(j)}
+				}
+				batches[n][i] = prompb.TimeSeries{
+					Labels: []prompb.Label{
+						{Name: pgmodel.MetricNameLabelName, Value: metricName},
+						{Name: "series", Value: fmt.Sprint(i)},
+					},
+					Samples: samples,
+				}
+			}
+		}
+
+		// create the metric and its series beforehand
+		if _, err = ingestor.Ingest(copyMetrics(batches[0]), pgmodel.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = db.Exec(context.Background(), "TRUNCATE prom_data."+metricName); err != nil {
+			t.Fatal(err)
+		}
+
+		b.ResetTimer()
+		b.StartTimer()
+		for n := range batches {
+			if _, err = ingestor.Ingest(batches[n], pgmodel.NewWriteRequest()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		b.StopTimer()
+	})
+}
+
 func keyValueArrayToLabelArray(db *pgxpool.Pool, metricName string, keys []string, values []string) error {
 	var labelArray []int
 	return db.QueryRow(context.Background(), "SELECT get_or_create_label_array($1, $2, $3)", metricName, keys, values).Scan(&labelArray)
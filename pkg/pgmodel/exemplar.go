@@ -0,0 +1,255 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const (
+	createExemplarTableSQL = "SELECT " + catalogSchema + ".create_exemplar_table_if_not_exists($1)"
+
+	insertExemplarsSQLFormat = `INSERT INTO %s(time, value, series_id, exemplar_labels)
+		SELECT t, v, s, l::jsonb FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[], $4::TEXT[]) a(t,v,s,l)
+		ORDER BY s,t ON CONFLICT DO NOTHING`
+
+	exemplarsSQLFormat = `SELECT s.id, s.labels, array_agg(e.time ORDER BY e.time), array_agg(e.value ORDER BY e.time), array_agg(e.exemplar_labels::text ORDER BY e.time)
+	FROM %[1]s e
+	INNER JOIN %[2]s s
+	ON e.series_id = s.id
+	WHERE %[3]s
+	AND e.time >= '%[4]s'
+	AND e.time <= '%[5]s'
+	GROUP BY s.id`
+)
+
+// Exemplar is a sample of a series along with the labels identifying what it
+// was recorded for, usually a trace.
+type Exemplar struct {
+	Labels    labels.Labels
+	Value     float64
+	Timestamp int64
+}
+
+// ExemplarQueryResult holds the exemplars of a single series.
+type ExemplarQueryResult struct {
+	SeriesLabels labels.Labels
+	Exemplars    []Exemplar
+}
+
+// ExemplarReader returns the stored exemplars.
+type ExemplarReader interface {
+	// Exemplars returns the exemplars between start and end, in
+	// milliseconds, of the series selected by any of the matcher sets.
+	Exemplars(start, end int64, matcherSets ...[]*labels.Matcher) ([]ExemplarQueryResult, error)
+}
+
+// exemplarLabelsJSON encodes the labels of an exemplar as a JSON object.
+func exemplarLabelsJSON(ls []prompb.Label) (string, error) {
+	m := make(map[string]string, len(ls))
+	for _, l := range ls {
+		m[l.Name] = l.Value
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// insertExemplars stores the exemplars of the request in the exemplar table
+// of the metric, creating it if needed. The series ids must already be set.
+func insertExemplars(conn pgxConn, req copyRequest) error {
+	numExemplars := 0
+	for i := range req.data.batch.sampleInfos {
+		numExemplars += len(req.data.batch.sampleInfos[i].exemplars)
+	}
+	if numExemplars == 0 {
+		return nil
+	}
+
+	times := make([]time.Time, 0, numExemplars)
+	vals := make([]float64, 0, numExemplars)
+	series := make([]int64, 0, numExemplars)
+	exemplarLabels := make([]string, 0, numExemplars)
+	for _, si := range req.data.batch.sampleInfos {
+		for _, e := range si.exemplars {
+			ls, err := exemplarLabelsJSON(e.Labels)
+			if err != nil {
+				return err
+			}
+			times = append(times, timestamp.Time(e.Timestamp))
+			vals = append(vals, e.Value)
+			series = append(series, int64(si.seriesID))
+			exemplarLabels = append(exemplarLabels, ls)
+		}
+	}
+
+	// the table must exist before the insert is prepared, so the two
+	// statements cannot be batched
+	if _, err := conn.Exec(context.Background(), createExemplarTableSQL, req.table); err != nil {
+		return err
+	}
+	queryString := fmt.Sprintf(insertExemplarsSQLFormat, pgx.Identifier{dataExemplarSchema, req.table}.Sanitize())
+	ct, err := conn.Exec(context.Background(), queryString, times, vals, series, exemplarLabels)
+	if err != nil {
+		return err
+	}
+	if int64(numExemplars) != ct.RowsAffected() {
+		log.Debug("msg", "duplicate exemplars", "table", req.table, "duplicate_count", int64(numExemplars)-ct.RowsAffected())
+	}
+	return nil
+}
+
+func buildExemplarsQuery(filter metricTimeRangeFilter, cases []string) string {
+	return fmt.Sprintf(
+		exemplarsSQLFormat,
+		pgx.Identifier{dataExemplarSchema, filter.metric}.Sanitize(),
+		pgx.Identifier{dataSeriesSchema, filter.metric}.Sanitize(),
+		strings.Join(cases, " AND "),
+		filter.startTime,
+		filter.endTime,
+	)
+}
+
+func (q *pgxQuerier) Exemplars(start, end int64, matcherSets ...[]*labels.Matcher) ([]ExemplarQueryResult, error) {
+	results := make(map[SeriesID]ExemplarQueryResult)
+	for _, matchers := range matcherSets {
+		metric, cases, values, err := buildSubQueries(q.scopeMatchers(matchers))
+		if err != nil {
+			return nil, err
+		}
+
+		filter := metricTimeRangeFilter{
+			metric:    metric,
+			startTime: toRFC3339Nano(start),
+			endTime:   toRFC3339Nano(end),
+		}
+
+		if metric != "" {
+			err = q.queryMetricExemplars(metric, filter, cases, values, results)
+		} else {
+			err = q.queryMultipleMetricsExemplars(filter, cases, values, results)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sorted := make([]ExemplarQueryResult, 0, len(results))
+	for _, r := range results {
+		sorted = append(sorted, r)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return labels.Compare(sorted[i].SeriesLabels, sorted[j].SeriesLabels) < 0
+	})
+	return sorted, nil
+}
+
+// queryMultipleMetricsExemplars looks up the series selected by the label
+// clauses across all metrics, then fetches their exemplars metric by metric.
+func (q *pgxQuerier) queryMultipleMetricsExemplars(filter metricTimeRangeFilter, cases []string, values []interface{}, results map[SeriesID]ExemplarQueryResult) error {
+	rows, err := q.conn.Query(q.queryContext(), buildMetricNameSeriesIDQuery(cases), values...)
+	if err != nil {
+		return err
+	}
+	metrics, series, err := getSeriesPerMetric(rows)
+	rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for i, metric := range metrics {
+		ids := make([]string, 0, len(series[i]))
+		for _, id := range series[i] {
+			ids = append(ids, fmt.Sprintf("%d", id))
+		}
+		seriesCase := []string{fmt.Sprintf("s.id IN (%s)", strings.Join(ids, ","))}
+		if err = q.queryMetricExemplars(metric, filter, seriesCase, nil, results); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *pgxQuerier) queryMetricExemplars(metric string, filter metricTimeRangeFilter, cases []string, values []interface{}, results map[SeriesID]ExemplarQueryResult) error {
+	tableName, err := q.getMetricTableName(metric)
+	if err != nil {
+		// If the metric table is missing, there are no results for this query.
+		if err == errMissingTableName {
+			return nil
+		}
+		return err
+	}
+	filter.metric = tableName
+
+	rows, err := q.conn.Query(q.queryContext(), buildExemplarsQuery(filter, cases), values...)
+	if err != nil {
+		// The exemplar table is only created along with the first exemplar
+		// of the metric.
+		if e, ok := err.(*pgconn.PgError); ok && e.Code == pgerrcode.UndefinedTable {
+			return nil
+		}
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			seriesID       SeriesID
+			labelIds       []int64
+			times          []time.Time
+			vals           []float64
+			exemplarLabels []string
+		)
+		if err = rows.Scan(&seriesID, &labelIds, &times, &vals, &exemplarLabels); err != nil {
+			return err
+		}
+		if len(times) != len(vals) || len(times) != len(exemplarLabels) {
+			return fmt.Errorf("query returned a mismatch in exemplar timestamps, values and labels: %d, %d, %d", len(times), len(vals), len(exemplarLabels))
+		}
+		if _, ok := results[seriesID]; ok {
+			// selected by more than one matcher set
+			continue
+		}
+
+		seriesLabels, err := q.getLabelsForIds(labelIds)
+		if err != nil {
+			return err
+		}
+		sort.Sort(seriesLabels)
+
+		result := ExemplarQueryResult{
+			SeriesLabels: seriesLabels,
+			Exemplars:    make([]Exemplar, 0, len(times)),
+		}
+		for i := range times {
+			m := make(map[string]string)
+			if err = json.Unmarshal([]byte(exemplarLabels[i]), &m); err != nil {
+				return err
+			}
+			result.Exemplars = append(result.Exemplars, Exemplar{
+				Labels:    labels.FromMap(m),
+				Value:     vals[i],
+				Timestamp: timestamp.FromTime(times[i]),
+			})
+		}
+		results[seriesID] = result
+	}
+	return rows.Err()
+}
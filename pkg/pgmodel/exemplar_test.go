@@ -0,0 +1,141 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestInsertExemplars(t *testing.T) {
+	req := copyRequest{
+		data: &pendingBuffer{
+			batch: SampleInfoIterator{sampleInfos: []samplesInfo{
+				{seriesID: 1, samples: []prompb.Sample{{Timestamp: 1, Value: 0.1}}},
+				{
+					seriesID: 2,
+					exemplars: []prompb.Exemplar{
+						{Labels: []prompb.Label{{Name: "trace_id", Value: "abc"}}, Timestamp: 2, Value: 0.2},
+						{Timestamp: 3, Value: 0.3},
+					},
+				},
+			}},
+		},
+		table: "foo",
+	}
+	mock := &sqlRecorder{
+		queries: []sqlQuery{
+			{
+				sql:  createExemplarTableSQL,
+				args: []interface{}{"foo"},
+			},
+			{
+				sql: "INSERT INTO \"prom_data_exemplar\".\"foo\"(time, value, series_id, exemplar_labels)\n\t\t" +
+					"SELECT t, v, s, l::jsonb FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[], $4::TEXT[]) a(t,v,s,l)\n\t\t" +
+					"ORDER BY s,t ON CONFLICT DO NOTHING",
+				args: []interface{}{
+					[]time.Time{timestamp.Time(2), timestamp.Time(3)},
+					[]float64{0.2, 0.3},
+					[]int64{2, 2},
+					[]string{`{"trace_id":"abc"}`, `{}`},
+				},
+			},
+		},
+		t: t,
+	}
+
+	if err := insertExemplars(mock, req); err != nil {
+		t.Fatal(err)
+	}
+	if mock.nextQuery != len(mock.queries) {
+		t.Errorf("missing queries: got %d wanted %d", mock.nextQuery, len(mock.queries))
+	}
+
+	// no exemplars, no queries
+	req.data.batch.sampleInfos = req.data.batch.sampleInfos[:1]
+	if err := insertExemplars(&sqlRecorder{t: t}, req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPGXQuerierExemplars(t *testing.T) {
+	mock := &sqlRecorder{
+		queries: []sqlQuery{
+			{
+				sql:     getMetricsTableSQL,
+				args:    []interface{}{"foo"},
+				results: rowResults{{"foo"}},
+			},
+			{
+				sql: "SELECT s.id, s.labels, array_agg(e.time ORDER BY e.time), array_agg(e.value ORDER BY e.time), array_agg(e.exemplar_labels::text ORDER BY e.time)\n\t" +
+					"FROM \"prom_data_exemplar\".\"foo\" e\n\t" +
+					"INNER JOIN \"prom_data_series\".\"foo\" s\n\t" +
+					"ON e.series_id = s.id\n\t" +
+					"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
+					"AND e.time >= '1970-01-01T00:00:01Z'\n\t" +
+					"AND e.time <= '1970-01-01T00:00:02Z'\n\t" +
+					"GROUP BY s.id",
+				args: []interface{}{"__name__", "foo"},
+				results: rowResults{{
+					int64(1),
+					[]int64{1, 2},
+					[]time.Time{timestamp.Time(1500)},
+					[]float64{0.5},
+					[]string{`{"trace_id":"abc"}`},
+				}},
+			},
+			// the series is selected a second time
+			{
+				sql: "SELECT s.id, s.labels, array_agg(e.time ORDER BY e.time), array_agg(e.value ORDER BY e.time), array_agg(e.exemplar_labels::text ORDER BY e.time)\n\t" +
+					"FROM \"prom_data_exemplar\".\"foo\" e\n\t" +
+					"INNER JOIN \"prom_data_series\".\"foo\" s\n\t" +
+					"ON e.series_id = s.id\n\t" +
+					"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
+					"AND e.time >= '1970-01-01T00:00:01Z'\n\t" +
+					"AND e.time <= '1970-01-01T00:00:02Z'\n\t" +
+					"GROUP BY s.id",
+				args: []interface{}{"__name__", "foo"},
+				results: rowResults{{
+					int64(1),
+					[]int64{1, 2},
+					[]time.Time{timestamp.Time(1500)},
+					[]float64{0.5},
+					[]string{`{"trace_id":"abc"}`},
+				}},
+			},
+		},
+		t: t,
+	}
+	querier := pgxQuerier{conn: mock, metricTableNames: &mockMetricCache{metricCache: map[string]string{}}, labels: clockcache.WithMax(10)}
+	querier.labels.InsertBatch(
+		[]interface{}{int64(1), int64(2)},
+		[]interface{}{labels.Label{Name: MetricNameLabelName, Value: "foo"}, labels.Label{Name: "job", Value: "a"}},
+	)
+
+	matchers := []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "foo")}
+	// the same series selected twice is only returned once
+	result, err := querier.Exemplars(1000, 2000, matchers, matchers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []ExemplarQueryResult{{
+		SeriesLabels: labels.FromStrings(MetricNameLabelName, "foo", "job", "a"),
+		Exemplars: []Exemplar{{
+			Labels:    labels.FromStrings("trace_id", "abc"),
+			Value:     0.5,
+			Timestamp: 1500,
+		}},
+	}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("unexpected result:\ngot\n%+v\nwanted\n%+v", result, expected)
+	}
+}
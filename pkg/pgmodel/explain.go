@@ -0,0 +1,131 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+)
+
+const explainAnalyzeSQL = "EXPLAIN (ANALYZE, BUFFERS) "
+
+// Explainer describes the SQL statements a querier runs to select series.
+type Explainer interface {
+	// ExplainSelect returns the plan of the select with the same arguments,
+	// and the top node of any pushdown applied like Select. With analyze, the
+	// statements are run with EXPLAIN (ANALYZE, BUFFERS).
+	ExplainSelect(ctx context.Context, mint, maxt int64, hints *storage.SelectHints, path []parser.Node, analyze bool, ms ...*labels.Matcher) (SelectorPlan, parser.Node, error)
+}
+
+// SelectorPlan holds the SQL statements run to answer a selector.
+type SelectorPlan struct {
+	Selector   string               `json:"selector"`
+	Statements []ExplainedStatement `json:"statements"`
+	// Pushdown is true if the functions around the selector are evaluated
+	// by the database.
+	Pushdown bool     `json:"pushdown"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ExplainedStatement is an SQL statement with its bound parameters, and its
+// EXPLAIN output if requested.
+type ExplainedStatement struct {
+	SQL  string        `json:"sql"`
+	Args []interface{} `json:"args"`
+	Plan []string      `json:"plan,omitempty"`
+}
+
+var _ Explainer = (*pgxQuerier)(nil)
+
+// ExplainSelect implements the Explainer interface. The series of selectors
+// matching several metrics are looked up to build their per-metric queries.
+func (q *pgxQuerier) ExplainSelect(ctx context.Context, mint, maxt int64, hints *storage.SelectHints, path []parser.Node, analyze bool, ms ...*labels.Matcher) (SelectorPlan, parser.Node, error) {
+	plan := SelectorPlan{Selector: selectorString(ms), Statements: []ExplainedStatement{}}
+	metric, cases, values, err := buildSubQueries(q.scopeMatchers(ms))
+	if err != nil {
+		return plan, nil, err
+	}
+
+	filter := metricTimeRangeFilter{
+		metric:    metric,
+		startTime: toRFC3339Nano(mint),
+		endTime:   toRFC3339Nano(maxt),
+	}
+
+	var topNode parser.Node
+	if metric == "" {
+		plan.Statements = append(plan.Statements, ExplainedStatement{SQL: buildMetricNameSeriesIDQuery(cases), Args: values})
+		queries, err := q.buildMultipleMetricQueries(filter, cases, values)
+		if err != nil {
+			return plan, nil, err
+		}
+		for _, sqlQuery := range queries {
+			plan.Statements = append(plan.Statements, ExplainedStatement{SQL: sqlQuery, Args: []interface{}{}})
+		}
+	} else {
+		var (
+			sqlQuery string
+			warnings storage.Warnings
+		)
+		sqlQuery, values, topNode, warnings, err = q.buildSingleMetricQuery(metric, filter, cases, values, hints, path)
+		if err == errMissingTableName {
+			plan.Warnings = append(plan.Warnings, "metric "+metric+" doesn't exist, no statement is run")
+			return plan, nil, nil
+		}
+		if err != nil {
+			return plan, nil, err
+		}
+		plan.Statements = append(plan.Statements, ExplainedStatement{SQL: sqlQuery, Args: values})
+		plan.Pushdown = topNode != nil
+		for _, w := range warnings {
+			plan.Warnings = append(plan.Warnings, w.Error())
+		}
+	}
+
+	if analyze {
+		for i := range plan.Statements {
+			st := &plan.Statements[i]
+			if st.Plan, err = q.explainAnalyze(ctx, st.SQL, st.Args); err != nil {
+				return plan, nil, err
+			}
+		}
+	}
+	return plan, topNode, nil
+}
+
+// explainAnalyze runs sqlQuery with EXPLAIN (ANALYZE, BUFFERS), under the
+// statement timeout of the reads, and returns the lines of its plan.
+func (q *pgxQuerier) explainAnalyze(ctx context.Context, sqlQuery string, args []interface{}) ([]string, error) {
+	rows, err := q.conn.Query(ctx, explainAnalyzeSQL+sqlQuery, args...)
+	if err != nil {
+		if rows != nil {
+			rows.Close()
+		}
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var line string
+		if err = rows.Scan(&line); err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, rows.Err()
+}
+
+func selectorString(ms []*labels.Matcher) string {
+	matchers := make([]string, 0, len(ms))
+	for _, m := range ms {
+		matchers = append(matchers, m.String())
+	}
+	return "{" + strings.Join(matchers, ", ") + "}"
+}
@@ -0,0 +1,65 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+)
+
+func TestExplainSelect(t *testing.T) {
+	mock := &sqlRecorder{t: t}
+	querier := &pgxQuerier{
+		conn:             mock,
+		metricTableNames: &mockMetricCache{metricCache: map[string]string{"foo": "foo_table"}},
+		labels:           clockcache.WithMax(0),
+	}
+	hints := &storage.SelectHints{Start: 1000, End: 2000}
+	matchers := []*labels.Matcher{
+		labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "foo"),
+		labels.MustNewMatcher(labels.MatchRegexp, "job", "api.*"),
+	}
+	metric, cases, values, err := buildSubQueries(matchers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter := metricTimeRangeFilter{metric: metric, startTime: toRFC3339Nano(1000), endTime: toRFC3339Nano(2000)}
+	sqlText, args, _, _, err := querier.buildSingleMetricQuery(metric, filter, cases, values, hints, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, topNode, err := querier.ExplainSelect(context.Background(), 1000, 2000, hints, nil, false, matchers...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := SelectorPlan{
+		Selector:   `{__name__="foo", job=~"api.*"}`,
+		Statements: []ExplainedStatement{{SQL: sqlText, Args: args}},
+	}
+	if !reflect.DeepEqual(plan, expected) || topNode != nil {
+		t.Errorf("unexpected plan:\ngot\n\t%+v\nwanted\n\t%+v", plan, expected)
+	}
+
+	// with analyze, the plan of the statement is read from the database
+	mock.queries = []sqlQuery{{
+		sql:     explainAnalyzeSQL + sqlText,
+		args:    args,
+		results: rowResults{{"Seq Scan on foo_table"}, {"Execution Time: 0.1 ms"}},
+	}}
+	plan, _, err = querier.ExplainSelect(context.Background(), 1000, 2000, hints, nil, true, matchers...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedPlan := []string{"Seq Scan on foo_table", "Execution Time: 0.1 ms"}
+	if !reflect.DeepEqual(plan.Statements[0].Plan, expectedPlan) {
+		t.Errorf("unexpected EXPLAIN output: %v", plan.Statements[0].Plan)
+	}
+}
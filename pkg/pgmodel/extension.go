@@ -20,6 +20,53 @@ var (
 	ExtensionIsInstalled = false
 )
 
+// ExtensionStatus is the state of an extension used by the connector.
+type ExtensionStatus struct {
+	Name string
+	// Installed is the installed version, empty if the extension isn't
+	// installed.
+	Installed string
+	// Target is the highest available version the connector works with,
+	// which migrating installs or updates the extension to, empty if none is
+	// available.
+	Target string
+	// Required is the range of versions the connector works with.
+	Required string
+}
+
+// getExtensionsStatus returns the state of the TimescaleDB and promscale
+// extensions.
+func getExtensionsStatus(conn *pgx.Conn) ([]ExtensionStatus, error) {
+	extensions := []struct {
+		name          string
+		validRange    semver.Range
+		requiredRange string
+	}{
+		{"timescaledb", version.TimescaleVersionRange, version.TimescaleVersionRangeFullString},
+		{"promscale", version.ExtVersionRange, version.ExtVersionRangeString},
+	}
+	statuses := make([]ExtensionStatus, 0, len(extensions))
+	for _, ext := range extensions {
+		status := ExtensionStatus{Name: ext.name, Required: ext.requiredRange}
+		installed, isInstalled, err := fetchInstalledExtensionVersion(conn, ext.name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get the installed %s version: %w", ext.name, err)
+		}
+		if isInstalled {
+			status.Installed = installed.String()
+		}
+		available, err := fetchAvailableExtensionVersions(conn, ext.name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get the available %s versions: %w", ext.name, err)
+		}
+		if target, ok := getNewExtensionVersion(available, ext.validRange); ok {
+			status.Target = target.String()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
 // checkExtensionsVersion checks for the correct version and enables the extension if
 // it is at the right version
 func checkExtensionsVersion(conn *pgx.Conn) error {
@@ -0,0 +1,101 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/wal"
+)
+
+// encodeWALRecord serializes the rows as a WriteRequest, which is what we
+// store in the WAL.
+func encodeWALRecord(rows map[string][]samplesInfo) ([]byte, error) {
+	req := prompb.WriteRequest{}
+	for _, data := range rows {
+		for _, si := range data {
+			ts := prompb.TimeSeries{
+				Labels:    make([]prompb.Label, len(si.labels.names)),
+				Samples:   si.samples,
+				Exemplars: si.exemplars,
+			}
+			for i := range si.labels.names {
+				ts.Labels[i].Name = si.labels.names[i]
+				ts.Labels[i].Value = si.labels.values[i]
+			}
+			req.Timeseries = append(req.Timeseries, ts)
+		}
+	}
+	return req.Marshal()
+}
+
+// appendToWAL durably records the rows before they are acked to the client,
+// returning the WAL segment to acknowledge once the rows are inserted, or -1
+// if there is nothing to acknowledge.
+func (p *pgxInserter) appendToWAL(rows map[string][]samplesInfo) (int, error) {
+	if p.wal == nil || !p.asyncAcks || len(rows) == 0 {
+		return -1, nil
+	}
+	rec, err := encodeWALRecord(rows)
+	if err != nil {
+		return -1, err
+	}
+	segment, err := p.wal.Append(rec)
+	if err != nil {
+		return -1, fmt.Errorf("writing to the WAL: %w", err)
+	}
+	return segment, nil
+}
+
+// replayWAL inserts the data that was acked but not confirmed to be in the
+// database before the last shutdown. Some of it may have made it to the
+// database, and is inserted again as resent data, ignoring the samples
+// already stored even under DuplicatePolicyReject. The samples rejected by the
+// duplicate policy or the limits are dropped, since no client is left to
+// report them to and replaying them again would be rejected the same way.
+func (p *pgxInserter) replayWAL() error {
+	return replayWAL(p.wal, p.InsertResentData)
+}
+
+// replayWAL inserts the records of w with insert, waiting for every record to
+// be inserted.
+func replayWAL(w *wal.WAL, insert func(rows map[string][]samplesInfo) (uint64, error)) error {
+	var replayed, dropped uint64
+	err := w.Replay(func(rec []byte) error {
+		req := prompb.WriteRequest{}
+		if err := req.Unmarshal(rec); err != nil {
+			log.Warn("msg", "skipping undecodable WAL record", "err", err)
+			return nil
+		}
+		rows, _, err := parseTimeSeries(req.Timeseries)
+		if err != nil {
+			log.Warn("msg", "skipping invalid WAL record", "err", err)
+			return nil
+		}
+		numRows, err := insert(rows)
+		var limitErr *LimitError
+		if errors.As(err, &limitErr) {
+			log.Warn("msg", "dropping rejected WAL samples", "err", err)
+			walReplayDroppedSamples.Add(float64(limitErr.Samples))
+			dropped += uint64(limitErr.Samples)
+			err = nil
+		}
+		if err != nil {
+			return err
+		}
+		replayed += numRows
+		return nil
+	})
+	if replayed > 0 {
+		log.Info("msg", fmt.Sprintf("replayed %d datapoints from the WAL, %d of them rejected", replayed, dropped))
+	}
+	if err != nil {
+		return fmt.Errorf("replaying the WAL: %w", err)
+	}
+	return nil
+}
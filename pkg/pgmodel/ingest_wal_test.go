@@ -0,0 +1,199 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/wal"
+)
+
+func TestWALRecordRoundTrip(t *testing.T) {
+	tts := []prompb.TimeSeries{
+		{
+			Labels: []prompb.Label{
+				{Name: MetricNameLabelName, Value: "first"},
+				{Name: "job", Value: "a"},
+			},
+			Samples: []prompb.Sample{{Timestamp: 1, Value: 0.1}, {Timestamp: 2, Value: 0.2}},
+			Exemplars: []prompb.Exemplar{
+				{Labels: []prompb.Label{{Name: "trace_id", Value: "abc"}}, Timestamp: 2, Value: 0.2},
+			},
+		},
+		{
+			Labels: []prompb.Label{
+				{Name: MetricNameLabelName, Value: "first"},
+				{Name: "job", Value: "b"},
+			},
+			Exemplars: []prompb.Exemplar{{Timestamp: 3, Value: 0.3}},
+		},
+		{
+			Labels: []prompb.Label{
+				{Name: MetricNameLabelName, Value: "second"},
+			},
+			Samples: []prompb.Sample{{Timestamp: 3, Value: 0.3}},
+		},
+	}
+	rows, numRows, err := parseTimeSeries(tts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := encodeWALRecord(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := prompb.WriteRequest{}
+	if err = req.Unmarshal(rec); err != nil {
+		t.Fatal(err)
+	}
+	decoded, decodedRows, err := parseTimeSeries(req.Timeseries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decodedRows != numRows {
+		t.Errorf("unexpected number of rows: got %d wanted %d", decodedRows, numRows)
+	}
+	if !reflect.DeepEqual(decoded, rows) {
+		t.Errorf("unexpected rows:\ngot\n%+v\nwanted\n%+v", decoded, rows)
+	}
+}
+
+func TestReplayWALDropsRejectedSamples(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := wal.Open(dir, wal.DefaultSegmentSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, metric := range []string{"rejected", "inserted"} {
+		rows, _, err := parseTimeSeries([]prompb.TimeSeries{{
+			Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: metric}},
+			Samples: []prompb.Sample{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 2}},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec, err := encodeWALRecord(rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Append(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if w, err = wal.Open(dir, wal.DefaultSegmentSize); err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	// other errors stop the replay, keeping the records
+	failing := func(rows map[string][]samplesInfo) (uint64, error) {
+		return 0, errors.New("connection refused")
+	}
+	if err = replayWAL(w, failing); err == nil {
+		t.Error("expected other errors to fail the replay")
+	}
+
+	var inserted []string
+	insert := func(rows map[string][]samplesInfo) (uint64, error) {
+		if _, ok := rows["rejected"]; ok {
+			limitErr := &LimitError{}
+			limitErr.add(reasonDuplicate, 2, "metric rejected rejects duplicate samples")
+			return 2, fmt.Errorf("inserting: %w", limitErr)
+		}
+		for metric := range rows {
+			inserted = append(inserted, metric)
+		}
+		return 2, nil
+	}
+	if err = replayWAL(w, insert); err != nil {
+		t.Fatalf("rejected samples should not fail the replay: %v", err)
+	}
+	if !reflect.DeepEqual(inserted, []string{"inserted"}) {
+		t.Errorf("unexpected inserted metrics: %v", inserted)
+	}
+}
+
+func TestCompleteAsyncInsert(t *testing.T) {
+	closing := make(chan struct{})
+	close(closing)
+	p := &pgxInserter{insertedDatapoints: new(int64), closing: closing}
+
+	failedAsyncInsert := func(err error) {
+		finished := &sync.WaitGroup{}
+		errChan := make(chan error, 1)
+		errChan <- err
+		atomic.AddInt64(&p.inFlightSamples, 3)
+		p.completeAsyncInsert(nil, 3, -1, finished, errChan)
+	}
+
+	// the samples rejected by the limits are not retried
+	limitErr := &LimitError{}
+	limitErr.add(reasonDuplicate, 1, "metric foo rejects duplicate samples")
+	failedAsyncInsert(limitErr)
+	if inserted := atomic.LoadInt64(p.insertedDatapoints); inserted != 2 {
+		t.Errorf("unexpected number of inserted samples: got %d wanted 2", inserted)
+	}
+
+	// other errors are retried until the inserter is closed
+	failed := testutil.ToFloat64(asyncInsertFailedSamples)
+	failedAsyncInsert(errors.New("connection refused"))
+	if diff := testutil.ToFloat64(asyncInsertFailedSamples) - failed; diff != 3 {
+		t.Errorf("unexpected number of failed samples: got %v wanted 3", diff)
+	}
+	if p.inFlightSamples != 0 {
+		t.Errorf("the samples should not be in-flight anymore: %d", p.inFlightSamples)
+	}
+}
+
+func TestCloseWaitsForAsyncInserts(t *testing.T) {
+	p := &pgxInserter{
+		closing:                make(chan struct{}),
+		completeMetricCreation: make(chan struct{}),
+		toCopiers:              make(chan copyRequest),
+	}
+
+	finished := &sync.WaitGroup{}
+	finished.Add(1)
+	p.asyncInserts.Add(1)
+	go func() {
+		defer p.asyncInserts.Done()
+		p.completeAsyncInsert(nil, 0, -1, finished, make(chan error, 1))
+	}()
+
+	closed := make(chan struct{})
+	go func() {
+		p.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+		t.Fatal("the inserter was closed before the async insert completed")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	finished.Done()
+	<-closed
+}
@@ -5,6 +5,7 @@
 package pgmodel
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/timescale/promscale/pkg/prompb"
@@ -12,10 +13,16 @@ import (
 
 const (
 	MetricNameLabelName = "__name__"
+	// TenantLabelName is the reserved label holding the tenant a series
+	// was written by when multi-tenancy is enabled.
+	TenantLabelName = "__tenant__"
 )
 
 var (
 	ErrNoMetricName = fmt.Errorf("metric name missing")
+	// ErrIngestSaturated is returned when data is rejected because too much
+	// data is already waiting to be inserted.
+	ErrIngestSaturated = fmt.Errorf("ingest pipeline saturated, retry later")
 )
 
 // SeriesID represents a globally unique id for the series. This should be equivalent
@@ -25,6 +32,10 @@ type SeriesID int64
 // inserter is responsible for inserting label, series and data into the storage.
 type inserter interface {
 	InsertNewData(rows map[string][]samplesInfo) (uint64, error)
+	// InsertResentData inserts data like InsertNewData, ignoring the samples
+	// already stored by a previous attempt of metrics rejecting duplicates.
+	InsertResentData(rows map[string][]samplesInfo) (uint64, error)
+	InsertMetadata(tenant string, metadata []prompb.MetricMetadata) (uint64, error)
 	CompleteMetricCreation() error
 	Close()
 }
@@ -38,14 +49,17 @@ type SeriesCache interface {
 }
 
 type samplesInfo struct {
-	labels   *Labels
-	seriesID SeriesID
-	samples  []prompb.Sample
+	labels    *Labels
+	seriesID  SeriesID
+	samples   []prompb.Sample
+	exemplars []prompb.Exemplar
 }
 
 // DBIngestor ingest the TimeSeries data into Timescale database.
 type DBIngestor struct {
-	db inserter
+	db        inserter
+	limiter   *ingestLimiter
+	relabeler *Relabeler
 }
 
 // Ingest transforms and ingests the timeseries data into Timescale database.
@@ -54,17 +68,80 @@ type DBIngestor struct {
 //     req the WriteRequest backing tts. It will be added to our WriteRequest
 //         pool when it is no longer needed.
 func (i *DBIngestor) Ingest(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
-	data, totalRows, err := i.parseData(tts, req)
+	return i.IngestForTenant("", tts, req)
+}
+
+// IngestForTenant ingests the data written by tenant like Ingest, storing
+// the metric metadata of req for tenant. The series must already hold the
+// label of the tenant.
+func (i *DBIngestor) IngestForTenant(tenant string, tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	return i.ingest(tenant, tts, req, false)
+}
+
+// IngestResent ingests data sent again after an attempt that may have
+// inserted some of it, such as the batches of a resumed import. The samples
+// already stored are ignored even by the metrics rejecting duplicates, as
+// they are not duplicates of other writes.
+func (i *DBIngestor) IngestResent(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	return i.ingest("", tts, req, true)
+}
+
+func (i *DBIngestor) ingest(tenant string, tts []prompb.TimeSeries, req *prompb.WriteRequest, resent bool) (uint64, error) {
+	// req is recycled while parsing the data, so we need to hold on to the
+	// metadata separately.
+	var metadata []prompb.MetricMetadata
+	if len(req.Metadata) > 0 {
+		metadata = make([]prompb.MetricMetadata, len(req.Metadata))
+		copy(metadata, req.Metadata)
+	}
+
+	data, totalRows, limitErr, err := i.parseData(tts, req)
 
 	if err != nil {
 		return 0, err
 	}
 
-	rowsInserted, err := i.db.InsertNewData(data)
+	var rowsInserted uint64
+	if resent {
+		rowsInserted, err = i.db.InsertResentData(data)
+	} else {
+		rowsInserted, err = i.db.InsertNewData(data)
+	}
+	// series rejected by the series limit don't prevent inserting the rest
+	var seriesLimitErr *LimitError
+	if errors.As(err, &seriesLimitErr) {
+		limitErr = mergeLimitErrors(limitErr, seriesLimitErr)
+		err = nil
+	}
 	if err == nil && int(rowsInserted) != totalRows {
 		return rowsInserted, fmt.Errorf("Failed to insert all the data! Expected: %d, Got: %d", totalRows, rowsInserted)
 	}
-	return rowsInserted, err
+	if seriesLimitErr != nil {
+		rowsInserted -= uint64(seriesLimitErr.Samples)
+	}
+	if err != nil {
+		return rowsInserted, err
+	}
+
+	if _, err = i.db.InsertMetadata(tenant, metadata); err != nil {
+		return rowsInserted, fmt.Errorf("inserting metric metadata: %w", err)
+	}
+	if limitErr != nil {
+		return rowsInserted, limitErr
+	}
+	return rowsInserted, nil
+}
+
+// mergeLimitErrors combines the rejections reported by a and b, either of
+// which may be nil.
+func mergeLimitErrors(a, b *LimitError) *LimitError {
+	if a == nil {
+		return b
+	}
+	if b != nil {
+		a.Samples += b.Samples
+	}
+	return a
 }
 
 // Parts of metric creation not needed to insert data
@@ -72,17 +149,49 @@ func (i *DBIngestor) CompleteMetricCreation() error {
 	return i.db.CompleteMetricCreation()
 }
 
-// Parse data into a set of samplesInfo infos per-metric.
-// returns: map[metric name][]SamplesInfo, total rows to insert
+// Parse data into a set of samplesInfo infos per-metric, applying the relabel
+// rules and enforcing the ingestion limits. Rate limited data is rejected altogether, while series
+// whose labels exceed the limits are dropped and reported in the returned
+// LimitError.
+// returns: map[metric name][]SamplesInfo, total rows to insert, dropped samples
 // NOTE: req will be added to our WriteRequest pool in this function, it must
 //       not be used afterwards.
-func (i *DBIngestor) parseData(tts []prompb.TimeSeries, req *prompb.WriteRequest) (map[string][]samplesInfo, int, error) {
+func (i *DBIngestor) parseData(tts []prompb.TimeSeries, req *prompb.WriteRequest) (map[string][]samplesInfo, int, *LimitError, error) {
+	i.relabeler.apply(tts)
+
+	var limitErr *LimitError
+	if i.limiter != nil {
+		if err := i.limiter.checkRate(tts); err != nil {
+			return nil, 0, nil, err
+		}
+		limitErr = i.limiter.dropInvalidSeries(tts)
+	}
+
+	dataSamples, rows, err := parseTimeSeries(tts)
+	if err != nil {
+		return nil, rows, nil, err
+	}
+
+	// WriteRequests can contain pointers into the original buffer we deserialized
+	// them out of, and can be quite large in and of themselves. In order to prevent
+	// memory blowup, and to allow faster deserializing, we recycle the WriteRequest
+	// here, allowing it to be either garbage collected or reused for a new request.
+	// In order for this to work correctly, any data we wish to keep using (e.g.
+	// samples) must no longer be reachable from req.
+	FinishWriteRequest(req)
+
+	return dataSamples, rows, limitErr, nil
+}
+
+// Group the samples and exemplars of tts by metric, normalizing the labels of
+// every series. The samples and exemplars are moved out of tts.
+func parseTimeSeries(tts []prompb.TimeSeries) (map[string][]samplesInfo, int, error) {
 	dataSamples := make(map[string][]samplesInfo)
 	rows := 0
 
 	for i := range tts {
 		t := &tts[i]
-		if len(t.Samples) == 0 {
+		if len(t.Samples) == 0 && len(t.Exemplars) == 0 {
 			continue
 		}
 
@@ -99,23 +208,17 @@ func (i *DBIngestor) parseData(tts []prompb.TimeSeries, req *prompb.WriteRequest
 			seriesLabels,
 			-1, // sentinel marking the seriesId as unset
 			t.Samples,
+			t.Exemplars,
 		}
 		rows += len(t.Samples)
 
 		dataSamples[metricName] = append(dataSamples[metricName], sample)
-		// we're going to free req after this, but we still need the samples,
-		// so nil the field
+		// we're going to free req after this, but we still need the samples
+		// and exemplars, so nil the fields
 		t.Samples = nil
+		t.Exemplars = nil
 	}
 
-	// WriteRequests can contain pointers into the original buffer we deserialized
-	// them out of, and can be quite large in and of themselves. In order to prevent
-	// memory blowup, and to allow faster deserializing, we recycle the WriteRequest
-	// here, allowing it to be either garbage collected or reused for a new request.
-	// In order for this to work correctly, any data we wish to keep using (e.g.
-	// samples) must no longer be reachable from req.
-	FinishWriteRequest(req)
-
 	return dataSamples, rows, nil
 }
 
@@ -12,4 +12,7 @@ type DBInserter interface {
 	// Ingest takes an array of TimeSeries and attepts to store it into the database.
 	// Returns the number of metrics ingested and any error encountered before finishing.
 	Ingest([]prompb.TimeSeries, *prompb.WriteRequest) (uint64, error)
+	// IngestForTenant ingests the data written by a tenant, whose series
+	// already hold its label.
+	IngestForTenant(string, []prompb.TimeSeries, *prompb.WriteRequest) (uint64, error)
 }
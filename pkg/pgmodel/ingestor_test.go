@@ -4,7 +4,9 @@
 package pgmodel
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/timescale/promscale/pkg/prompb"
@@ -45,10 +47,15 @@ func (m *mockCache) Capacity() int {
 }
 
 type mockInserter struct {
-	insertedSeries  map[string]SeriesID
-	insertedData    []map[string][]samplesInfo
-	insertSeriesErr error
-	insertDataErr   error
+	insertedSeries    map[string]SeriesID
+	insertedData      []map[string][]samplesInfo
+	insertedMetadata  []prompb.MetricMetadata
+	metadataTenant    string
+	insertSeriesErr   error
+	insertDataErr     error
+	insertMetadataErr error
+	// resentData is the data inserted by InsertResentData
+	resentData []map[string][]samplesInfo
 }
 
 func (m *mockInserter) Close() {
@@ -59,6 +66,20 @@ func (m *mockInserter) InsertNewData(rows map[string][]samplesInfo) (uint64, err
 	return m.InsertData(rows)
 }
 
+func (m *mockInserter) InsertResentData(rows map[string][]samplesInfo) (uint64, error) {
+	m.resentData = append(m.resentData, rows)
+	return m.InsertData(rows)
+}
+
+func (m *mockInserter) InsertMetadata(tenant string, metadata []prompb.MetricMetadata) (uint64, error) {
+	if m.insertMetadataErr != nil {
+		return 0, m.insertMetadataErr
+	}
+	m.metadataTenant = tenant
+	m.insertedMetadata = append(m.insertedMetadata, metadata...)
+	return uint64(len(metadata)), nil
+}
+
 func (m *mockInserter) CompleteMetricCreation() error {
 	return nil
 }
@@ -298,3 +319,53 @@ func TestDBIngestorIngest(t *testing.T) {
 		})
 	}
 }
+
+func TestDBIngestorIngestMetadata(t *testing.T) {
+	metadata := []prompb.MetricMetadata{
+		{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "test_total", Help: "help", Unit: "seconds"},
+		{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "test", Help: "other help"},
+	}
+
+	inserter := mockInserter{insertedSeries: make(map[string]SeriesID)}
+	i := DBIngestor{db: &inserter}
+
+	req := NewWriteRequest()
+	req.Metadata = append(req.Metadata, metadata...)
+	if _, err := i.IngestForTenant("tenant-a", nil, req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(inserter.insertedMetadata, metadata) {
+		t.Errorf("unexpected metadata inserted:\ngot\n%v\nwanted\n%v", inserter.insertedMetadata, metadata)
+	}
+	if inserter.metadataTenant != "tenant-a" {
+		t.Errorf("unexpected metadata tenant: %q", inserter.metadataTenant)
+	}
+
+	metadataErr := fmt.Errorf("some error")
+	inserter = mockInserter{insertedSeries: make(map[string]SeriesID), insertMetadataErr: metadataErr}
+	i = DBIngestor{db: &inserter}
+
+	req = NewWriteRequest()
+	req.Metadata = append(req.Metadata, metadata...)
+	if _, err := i.Ingest(nil, req); !errors.Is(err, metadataErr) {
+		t.Errorf("wrong error returned: got\n%s\nwant\n%s\n", err, metadataErr)
+	}
+}
+
+func TestDBIngestorIngestResent(t *testing.T) {
+	inserter := mockInserter{insertedSeries: make(map[string]SeriesID)}
+	i := DBIngestor{db: &inserter}
+
+	tts := []prompb.TimeSeries{{
+		Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: "test"}},
+		Samples: []prompb.Sample{{Timestamp: 1, Value: 0.1}},
+	}}
+	count, err := i.IngestResent(tts, NewWriteRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if count != 1 || len(inserter.resentData) != 1 || len(inserter.insertedData) != 1 {
+		t.Errorf("the data wasn't inserted as resent: %d samples, %d resent calls", count, len(inserter.resentData))
+	}
+}
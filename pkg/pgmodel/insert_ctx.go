@@ -24,9 +24,17 @@ func FinishWriteRequest(wr *prompb.WriteRequest) {
 		}
 		ts.Labels = ts.Labels[:0]
 		ts.Samples = ts.Samples[:0]
+		for j := range ts.Exemplars {
+			ts.Exemplars[j] = prompb.Exemplar{}
+		}
+		ts.Exemplars = ts.Exemplars[:0]
 		ts.XXX_unrecognized = nil
 	}
 	wr.Timeseries = wr.Timeseries[:0]
+	for i := range wr.Metadata {
+		wr.Metadata[i] = prompb.MetricMetadata{}
+	}
+	wr.Metadata = wr.Metadata[:0]
 	wr.XXX_unrecognized = nil
 	wrPool.Put(wr)
 }
@@ -0,0 +1,244 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/timescale/promscale/pkg/prompb"
+	"golang.org/x/time/rate"
+)
+
+// ErrIngestRateLimited is returned, wrapped in a LimitError, when a write is
+// rejected because it exceeds the ingestion rate limits.
+var ErrIngestRateLimited = errors.New("ingestion rate limit exceeded")
+
+// reasons samples are rejected for, used as the label of the rejected
+// samples metric
+const (
+	reasonRateLimited       = "rate_limited"
+	reasonTenantRateLimited = "tenant_rate_limited"
+	reasonTooManyLabels     = "too_many_labels"
+	reasonLabelNameTooLong  = "label_name_too_long"
+	reasonLabelValueTooLong = "label_value_too_long"
+	reasonSeriesLimit       = "series_limit"
+	reasonDuplicate         = "duplicate"
+)
+
+// Limits bounds the data accepted from remote-write. A zero value disables
+// the corresponding limit.
+type Limits struct {
+	// IngestionRate is the maximum number of samples per second accepted,
+	// with bursts of up to IngestionBurst samples.
+	IngestionRate  float64
+	IngestionBurst int
+	// TenantIngestionRate and TenantIngestionBurst are the same limits for
+	// each tenant when multi-tenancy is enabled.
+	TenantIngestionRate  float64
+	TenantIngestionBurst int
+	// MaxSeriesPerMetric is the maximum number of series a metric can have.
+	// New series over the limit are rejected. The limit is approximate when
+	// several connectors write to the same database.
+	MaxSeriesPerMetric int64
+	// MaxLabelsPerSeries is the maximum number of labels of a series,
+	// including the metric name.
+	MaxLabelsPerSeries  int
+	MaxLabelNameLength  int
+	MaxLabelValueLength int
+}
+
+// LimitError reports the samples of a write that were rejected for exceeding
+// the limits. Unless the write was rate limited, the rest of the samples were
+// ingested.
+type LimitError struct {
+	// Reason is the reason the first sample was rejected for.
+	Reason string
+	// Samples is the number of rejected samples.
+	Samples int
+	// Msg describes the first rejection.
+	Msg string
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%d samples rejected: %s", e.Samples, e.Msg)
+}
+
+// Unwrap returns ErrIngestRateLimited for rate limited writes so they can be
+// retried later.
+func (e *LimitError) Unwrap() error {
+	if e.Reason == reasonRateLimited || e.Reason == reasonTenantRateLimited {
+		return ErrIngestRateLimited
+	}
+	return nil
+}
+
+// add records the rejection of samples for reason, keeping the description
+// of the first one.
+func (e *LimitError) add(reason string, samples int, msg string) {
+	rejectedSamples.WithLabelValues(reason).Add(float64(samples))
+	if e.Msg == "" {
+		e.Reason = reason
+		e.Msg = msg
+	}
+	e.Samples += samples
+}
+
+// ingestLimiter enforces the Limits of the ingestion path that don't need
+// the database.
+type ingestLimiter struct {
+	limits Limits
+	global *rate.Limiter
+
+	mu      sync.Mutex
+	tenants map[string]*rate.Limiter
+}
+
+func newIngestLimiter(limits Limits) *ingestLimiter {
+	l := &ingestLimiter{
+		limits:  limits,
+		tenants: make(map[string]*rate.Limiter),
+	}
+	if limits.IngestionRate > 0 {
+		l.global = newRateLimiter(limits.IngestionRate, limits.IngestionBurst)
+	}
+	return l
+}
+
+// newRateLimiter returns a limiter of r samples per second. If no burst is
+// given, a second worth of samples is allowed at once.
+func newRateLimiter(r float64, burst int) *rate.Limiter {
+	if burst <= 0 {
+		burst = int(r)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+	return rate.NewLimiter(rate.Limit(r), burst)
+}
+
+func (l *ingestLimiter) tenantLimiter(tenant string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limiter, ok := l.tenants[tenant]
+	if !ok {
+		limiter = newRateLimiter(l.limits.TenantIngestionRate, l.limits.TenantIngestionBurst)
+		l.tenants[tenant] = limiter
+	}
+	return limiter
+}
+
+// checkRate reserves room for the samples of tts in the global and tenant
+// rate limiters, returning a LimitError if any of them is exhausted. Nothing
+// is reserved if the write is rejected.
+func (l *ingestLimiter) checkRate(tts []prompb.TimeSeries) error {
+	if l.global == nil && l.limits.TenantIngestionRate <= 0 {
+		return nil
+	}
+
+	total := 0
+	perTenant := make(map[string]int)
+	for i := range tts {
+		total += len(tts[i].Samples)
+		if l.limits.TenantIngestionRate > 0 {
+			if tenant := tenantOf(tts[i].Labels); tenant != "" {
+				perTenant[tenant] += len(tts[i].Samples)
+			}
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	reservations := make([]*rate.Reservation, 0, len(perTenant)+1)
+	reject := func(reason, msg string) error {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+		err := &LimitError{}
+		err.add(reason, total, msg)
+		return err
+	}
+
+	for tenant, n := range perTenant {
+		r := l.tenantLimiter(tenant).ReserveN(now, n)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			return reject(reasonTenantRateLimited, fmt.Sprintf("ingestion rate limit of %g samples/s exceeded for tenant %s", l.limits.TenantIngestionRate, tenant))
+		}
+		reservations = append(reservations, r)
+	}
+	if l.global != nil {
+		r := l.global.ReserveN(now, total)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			return reject(reasonRateLimited, fmt.Sprintf("ingestion rate limit of %g samples/s exceeded", l.limits.IngestionRate))
+		}
+	}
+	return nil
+}
+
+// dropInvalidSeries removes the samples and exemplars of the series of tts
+// whose labels exceed the limits, so that they are skipped on insert.
+// Returns a LimitError describing the rejected samples, if any.
+func (l *ingestLimiter) dropInvalidSeries(tts []prompb.TimeSeries) *LimitError {
+	if l.limits.MaxLabelsPerSeries <= 0 && l.limits.MaxLabelNameLength <= 0 && l.limits.MaxLabelValueLength <= 0 {
+		return nil
+	}
+
+	limitErr := &LimitError{}
+	for i := range tts {
+		t := &tts[i]
+		reason, msg := l.validateLabels(t.Labels)
+		if reason == "" {
+			continue
+		}
+		limitErr.add(reason, len(t.Samples), msg)
+		t.Samples = nil
+		t.Exemplars = nil
+	}
+	if limitErr.Msg == "" {
+		return nil
+	}
+	return limitErr
+}
+
+// validateLabels returns the reason the labels exceed the limits and a
+// description of the problem, or an empty reason if they don't.
+func (l *ingestLimiter) validateLabels(ls []prompb.Label) (string, string) {
+	if l.limits.MaxLabelsPerSeries > 0 && len(ls) > l.limits.MaxLabelsPerSeries {
+		return reasonTooManyLabels, fmt.Sprintf("series %s has %d labels, more than the limit of %d", metricNameOf(ls), len(ls), l.limits.MaxLabelsPerSeries)
+	}
+	for _, label := range ls {
+		if l.limits.MaxLabelNameLength > 0 && len(label.Name) > l.limits.MaxLabelNameLength {
+			return reasonLabelNameTooLong, fmt.Sprintf("label name %.64q of series %s is longer than the limit of %d", label.Name, metricNameOf(ls), l.limits.MaxLabelNameLength)
+		}
+		if l.limits.MaxLabelValueLength > 0 && len(label.Value) > l.limits.MaxLabelValueLength {
+			return reasonLabelValueTooLong, fmt.Sprintf("value of label %s of series %s is longer than the limit of %d", label.Name, metricNameOf(ls), l.limits.MaxLabelValueLength)
+		}
+	}
+	return "", ""
+}
+
+func metricNameOf(ls []prompb.Label) string {
+	for _, l := range ls {
+		if l.Name == MetricNameLabelName {
+			return l.Value
+		}
+	}
+	return ""
+}
+
+func tenantOf(ls []prompb.Label) string {
+	for _, l := range ls {
+		if l.Name == TenantLabelName {
+			return l.Value
+		}
+	}
+	return ""
+}
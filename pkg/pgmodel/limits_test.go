@@ -0,0 +1,161 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func samplesFor(tenant string, n int) prompb.TimeSeries {
+	ts := prompb.TimeSeries{
+		Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: "foo"}},
+		Samples: make([]prompb.Sample, n),
+	}
+	if tenant != "" {
+		ts.Labels = append(ts.Labels, prompb.Label{Name: TenantLabelName, Value: tenant})
+	}
+	return ts
+}
+
+func TestIngestLimiterCheckRate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		limits Limits
+		writes [][]prompb.TimeSeries
+		// reason the last write is rejected for, empty if accepted
+		reason string
+	}{
+		{
+			name:   "no limits",
+			writes: [][]prompb.TimeSeries{{samplesFor("", 1000)}},
+		},
+		{
+			name:   "under the global limit",
+			limits: Limits{IngestionRate: 10},
+			writes: [][]prompb.TimeSeries{{samplesFor("", 5)}, {samplesFor("", 5)}},
+		},
+		{
+			name:   "over the global limit",
+			limits: Limits{IngestionRate: 10},
+			writes: [][]prompb.TimeSeries{{samplesFor("", 5)}, {samplesFor("", 6)}},
+			reason: reasonRateLimited,
+		},
+		{
+			name:   "over the burst",
+			limits: Limits{IngestionRate: 10, IngestionBurst: 20},
+			writes: [][]prompb.TimeSeries{{samplesFor("", 21)}},
+			reason: reasonRateLimited,
+		},
+		{
+			name:   "tenants are limited separately",
+			limits: Limits{TenantIngestionRate: 10},
+			writes: [][]prompb.TimeSeries{{samplesFor("a", 10)}, {samplesFor("b", 10)}},
+		},
+		{
+			name:   "over the tenant limit",
+			limits: Limits{TenantIngestionRate: 10},
+			writes: [][]prompb.TimeSeries{{samplesFor("a", 10)}, {samplesFor("b", 1), samplesFor("a", 1)}},
+			reason: reasonTenantRateLimited,
+		},
+		{
+			name:   "rejected writes don't use up the limit",
+			limits: Limits{IngestionRate: 10, TenantIngestionRate: 10},
+			writes: [][]prompb.TimeSeries{{samplesFor("a", 11)}, {samplesFor("a", 10)}},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			l := newIngestLimiter(c.limits)
+			var err error
+			for _, w := range c.writes {
+				err = l.checkRate(w)
+			}
+			if c.reason == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var limitErr *LimitError
+			if !errors.As(err, &limitErr) {
+				t.Fatalf("expected a LimitError, got %v", err)
+			}
+			if limitErr.Reason != c.reason {
+				t.Errorf("unexpected reason: got %s wanted %s", limitErr.Reason, c.reason)
+			}
+			if !errors.Is(err, ErrIngestRateLimited) {
+				t.Errorf("rate limited writes should be retriable")
+			}
+		})
+	}
+}
+
+func TestIngestLimiterDropInvalidSeries(t *testing.T) {
+	l := newIngestLimiter(Limits{
+		MaxLabelsPerSeries:  2,
+		MaxLabelNameLength:  8,
+		MaxLabelValueLength: 5,
+	})
+	tts := []prompb.TimeSeries{
+		{
+			Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: "ok"}},
+			Samples: make([]prompb.Sample, 1),
+		},
+		{
+			Labels: []prompb.Label{
+				{Name: MetricNameLabelName, Value: "foo"},
+				{Name: "a", Value: "1"},
+				{Name: "b", Value: "2"},
+			},
+			Samples: make([]prompb.Sample, 2),
+		},
+		{
+			Labels: []prompb.Label{
+				{Name: MetricNameLabelName, Value: "foo"},
+				{Name: "toolongname", Value: "1"},
+			},
+			Samples:   make([]prompb.Sample, 3),
+			Exemplars: make([]prompb.Exemplar, 1),
+		},
+		{
+			Labels: []prompb.Label{
+				{Name: MetricNameLabelName, Value: "foo"},
+				{Name: "a", Value: "toolong"},
+			},
+			Samples: make([]prompb.Sample, 4),
+		},
+	}
+
+	limitErr := l.dropInvalidSeries(tts)
+	if limitErr == nil {
+		t.Fatal("expected a LimitError")
+	}
+	if limitErr.Samples != 9 || limitErr.Reason != reasonTooManyLabels {
+		t.Errorf("unexpected error: %+v", limitErr)
+	}
+	if !strings.Contains(limitErr.Error(), "9 samples rejected") {
+		t.Errorf("unexpected error message: %s", limitErr)
+	}
+	if errors.Is(limitErr, ErrIngestRateLimited) {
+		t.Errorf("invalid series should not be retried")
+	}
+	if len(tts[0].Samples) != 1 {
+		t.Errorf("valid series should be kept")
+	}
+	for _, ts := range tts[1:] {
+		if ts.Samples != nil || ts.Exemplars != nil {
+			t.Errorf("invalid series should be dropped: %v", ts.Labels)
+		}
+	}
+
+	if limitErr := l.dropInvalidSeries(tts[:1]); limitErr != nil {
+		t.Errorf("unexpected error: %v", limitErr)
+	}
+}
@@ -0,0 +1,116 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const (
+	insertMetadataSQL = `INSERT INTO ` + catalogSchema + `.metadata (last_seen, tenant, metric_family, type, unit, help)
+		SELECT current_timestamp, $1::TEXT, * FROM unnest($2::TEXT[], $3::TEXT[], $4::TEXT[], $5::TEXT[])
+		ON CONFLICT (tenant, metric_family, type, unit, help) DO UPDATE SET last_seen = EXCLUDED.last_seen`
+	getMetadataSQL = `SELECT metric_family, type, unit, help FROM ` + catalogSchema + `.metadata
+		WHERE ($1 = '' OR metric_family = $1) AND ($2 = '' OR tenant = $2)
+		GROUP BY metric_family, type, unit, help
+		ORDER BY metric_family, max(last_seen) DESC`
+)
+
+// MetricMetadata is the metadata Prometheus keeps for a metric family, in the
+// format used by the Prometheus HTTP API.
+type MetricMetadata struct {
+	Type string `json:"type"`
+	Help string `json:"help"`
+	Unit string `json:"unit"`
+}
+
+// MetadataReader returns the stored metric metadata.
+type MetadataReader interface {
+	// Metadata returns the metadata of the metric families, keyed by the
+	// family name. If metric is not empty only that family is returned, and
+	// if limit is positive at most limit families are returned. Readers
+	// scoped to a tenant only return the metadata written by the tenant.
+	Metadata(metric string, limit int) (map[string][]MetricMetadata, error)
+}
+
+// metadataType converts the protobuf metric type into the lower case name
+// Prometheus uses for it in the text exposition format and its HTTP API.
+func metadataType(t prompb.MetricMetadata_MetricType) string {
+	return strings.ToLower(t.String())
+}
+
+// InsertMetadata stores the metadata written by tenant, refreshing the last
+// time we have seen metadata that is already stored.
+func (p *pgxInserter) InsertMetadata(tenant string, metadata []prompb.MetricMetadata) (uint64, error) {
+	if len(metadata) == 0 {
+		return 0, nil
+	}
+
+	// ON CONFLICT DO UPDATE fails if the same row is affected twice in one
+	// statement, so we need to deduplicate the batch ourselves.
+	type metadataKey struct {
+		family string
+		MetricMetadata
+	}
+	seen := make(map[metadataKey]bool, len(metadata))
+	families := make([]string, 0, len(metadata))
+	types := make([]string, 0, len(metadata))
+	units := make([]string, 0, len(metadata))
+	helps := make([]string, 0, len(metadata))
+	for _, md := range metadata {
+		key := metadataKey{
+			family:         md.MetricFamilyName,
+			MetricMetadata: MetricMetadata{Type: metadataType(md.Type), Help: md.Help, Unit: md.Unit},
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		families = append(families, key.family)
+		types = append(types, key.Type)
+		units = append(units, key.Unit)
+		helps = append(helps, key.Help)
+	}
+
+	_, err := p.conn.Exec(context.Background(), insertMetadataSQL, tenant, families, types, units, helps)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(families)), nil
+}
+
+func (q *pgxQuerier) Metadata(metric string, limit int) (map[string][]MetricMetadata, error) {
+	rows, err := q.conn.Query(q.queryContext(), getMetadataSQL, metric, q.tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	result := make(map[string][]MetricMetadata)
+
+	for rows.Next() {
+		var (
+			family string
+			md     MetricMetadata
+		)
+		if err := rows.Scan(&family, &md.Type, &md.Unit, &md.Help); err != nil {
+			return nil, err
+		}
+
+		// rows are ordered by family, so once we see a new family after
+		// reaching the limit there is nothing more to add
+		if _, ok := result[family]; !ok && limit > 0 && len(result) >= limit {
+			break
+		}
+		result[family] = append(result[family], md)
+	}
+
+	return result, rows.Err()
+}
@@ -20,6 +20,12 @@ var (
 			Help:      "Total number of writes that contained duplicates",
 		},
 	)
+	metricDuplicateSamples = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "metric_duplicate_samples_total",
+			Help:      "Total number of duplicate samples per metric, by the duplicate policy applied to them",
+		}, []string{"metric", "policy"})
 	decompressCalls = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace: util.PromNamespace,
@@ -27,6 +33,61 @@ var (
 			Help:      "Total number of calls to decompress_chunks_after",
 		},
 	)
+	seriesCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "series_cache_hits_total",
+			Help:      "Total number of series id lookups served from the series cache",
+		},
+	)
+	seriesCacheMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "series_cache_misses_total",
+			Help:      "Total number of series id lookups not found in the series cache",
+		},
+	)
+	walReplayDroppedSamples = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "wal_replay_dropped_samples_total",
+			Help:      "Total number of samples replayed from the WAL and dropped, as they were rejected by the duplicate policy or the limits",
+		},
+	)
+	asyncInsertFailedSamples = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_async_failed_samples_total",
+			Help:      "Total number of asynchronously acked samples that could not be inserted after retrying, kept in the WAL if there is one and dropped otherwise",
+		},
+	)
+	inFlightSamples = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_inflight_samples",
+			Help:      "Number of samples sent to the inserters and not yet inserted",
+		},
+	)
+	ingestRejectedRequests = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_rejected_requests_total",
+			Help:      "Total number of write requests rejected because the ingest pipeline was saturated",
+		},
+	)
+	relabelDroppedSamples = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "relabel_dropped_samples_total",
+			Help:      "Total number of samples dropped by the relabel rules",
+		},
+	)
+	rejectedSamples = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_rejected_samples_total",
+			Help:      "Total number of samples rejected for exceeding the ingestion limits",
+		}, []string{"reason"})
 	decompressEarliest = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: util.PromNamespace,
@@ -39,7 +100,16 @@ func init() {
 	prometheus.MustRegister(
 		duplicateSamples,
 		duplicateWrites,
+		metricDuplicateSamples,
 		decompressCalls,
 		decompressEarliest,
+		seriesCacheHits,
+		seriesCacheMisses,
+		walReplayDroppedSamples,
+		asyncInsertFailedSamples,
+		inFlightSamples,
+		ingestRejectedRequests,
+		rejectedSamples,
+		relabelDroppedSamples,
 	)
 }
@@ -28,6 +28,7 @@ const (
 	metadataUpdateWithExtension = "SELECT update_tsprom_metadata($1, $2, $3)"
 	metadataUpdateNoExtension   = "INSERT INTO _timescaledb_catalog.metadata(key, value, include_in_telemetry) VALUES ('promscale_' || $1, $2, $3) ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, include_in_telemetry = EXCLUDED.include_in_telemetry"
 	createMigrationsTable       = "CREATE TABLE IF NOT EXISTS prom_schema_migrations (version text not null primary key)"
+	migrationsTableExists       = "SELECT to_regclass('prom_schema_migrations') IS NOT NULL"
 	getVersion                  = "SELECT version FROM prom_schema_migrations LIMIT 1"
 	setVersion                  = "INSERT INTO prom_schema_migrations (version) VALUES ($1)"
 	truncateMigrationsTable     = "TRUNCATE prom_schema_migrations"
@@ -137,6 +138,74 @@ func Migrate(db *pgx.Conn, versionInfo VersionInfo) (err error) {
 	return nil
 }
 
+// PlanMigration returns what Migrate would run to migrate the database schema
+// to the version of the connector, without changing the database.
+func PlanMigration(db *pgx.Conn, versionInfo VersionInfo) (*MigrationPlan, error) {
+	appVersion, err := semver.Make(versionInfo.Version)
+	if err != nil {
+		return nil, fmt.Errorf("app version is not semver format")
+	}
+	return NewMigrator(db, migrations.MigrationFiles, tableOfContets).Plan(appVersion)
+}
+
+// DryRunMigration runs the migration of the database schema to the version of
+// the connector in a transaction that is rolled back, and returns what was
+// run. The extensions are neither installed nor updated.
+func DryRunMigration(db *pgx.Conn, versionInfo VersionInfo) (*MigrationPlan, error) {
+	migrateMutex.Lock()
+	defer migrateMutex.Unlock()
+
+	appVersion, err := semver.Make(versionInfo.Version)
+	if err != nil {
+		return nil, fmt.Errorf("app version is not semver format")
+	}
+	return NewMigrator(db, migrations.MigrationFiles, tableOfContets).DryRun(appVersion)
+}
+
+// MigrationStatus is the state of the database schema and extensions
+// compared to what the connector needs.
+type MigrationStatus struct {
+	// InstalledVersion is the version of the schema, zero if it isn't
+	// installed.
+	InstalledVersion semver.Version
+	AppVersion       semver.Version
+	// Pending are the names of the scripts migrating to AppVersion runs,
+	// empty if the schema is above it.
+	Pending    []string
+	Extensions []ExtensionStatus
+}
+
+// GetMigrationStatus returns the state of the database schema and extensions
+// without changing the database.
+func GetMigrationStatus(db *pgx.Conn, versionInfo VersionInfo) (*MigrationStatus, error) {
+	appVersion, err := semver.Make(versionInfo.Version)
+	if err != nil {
+		return nil, fmt.Errorf("app version is not semver format")
+	}
+	dbVersion, err := getSchemaVersionIfInstalled(db)
+	if err != nil {
+		return nil, err
+	}
+	status := &MigrationStatus{InstalledVersion: dbVersion, AppVersion: appVersion}
+
+	if dbVersion.Compare(appVersion) <= 0 {
+		mig := NewMigrator(db, migrations.MigrationFiles, tableOfContets)
+		plan, err := mig.plan(dbVersion, appVersion)
+		if err != nil {
+			return nil, err
+		}
+		for _, script := range plan.Scripts {
+			status.Pending = append(status.Pending, script.Name)
+		}
+	}
+
+	status.Extensions, err = getExtensionsStatus(db)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
 // CheckDependencies makes sure all project dependencies, including the DB schema
 // the extension, are set up correctly. This will set the ExtensionIsInstalled
 // flag and thus should only be called once, at initialization.
@@ -181,6 +250,127 @@ func (t *Migrator) Migrate(appVersion semver.Version) error {
 		return fmt.Errorf("failed to get the version from database: %w", err)
 	}
 
+	plan, err := t.plan(dbVersion, appVersion)
+	if err != nil {
+		return err
+	}
+	if len(plan.Scripts) == 0 && !plan.SetsVersion {
+		return nil
+	}
+
+	tx, err := t.db.Begin(context.Background())
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+	if err = execMigrationPlan(tx, plan); err != nil {
+		return err
+	}
+	if err = tx.Commit(context.Background()); err != nil {
+		return fmt.Errorf("unable to commit migration transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Plan returns what migrating the database to appVersion would run, without
+// changing the database.
+func (t *Migrator) Plan(appVersion semver.Version) (*MigrationPlan, error) {
+	dbVersion, err := getSchemaVersionIfInstalled(t.db)
+	if err != nil {
+		return nil, err
+	}
+	return t.plan(dbVersion, appVersion)
+}
+
+// DryRun migrates the database to appVersion in a transaction, which is then
+// rolled back, and returns what was run. Errors of the migration scripts are
+// returned as they would be by Migrate.
+func (t *Migrator) DryRun(appVersion semver.Version) (*MigrationPlan, error) {
+	tx, err := t.db.Begin(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to start transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	if _, err = tx.Exec(context.Background(), createMigrationsTable); err != nil {
+		return nil, fmt.Errorf("error creating migration table: %w", err)
+	}
+	dbVersion, err := getSchemaVersionOnConnection(context.Background(), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the version from database: %w", err)
+	}
+	plan, err := t.plan(dbVersion, appVersion)
+	if err != nil {
+		return nil, err
+	}
+	if err = execMigrationPlan(tx, plan); err != nil {
+		return plan, err
+	}
+	if err = tx.Rollback(context.Background()); err != nil {
+		return plan, fmt.Errorf("unable to roll back migration transaction: %w", err)
+	}
+	return plan, nil
+}
+
+// MigrationScript is a migration script and the SQL it runs.
+type MigrationScript struct {
+	Name string
+	SQL  string
+}
+
+// MigrationPlan is what migrating the database schema from a version to
+// another runs.
+type MigrationPlan struct {
+	// From is the version of the schema, zero if it isn't installed.
+	From semver.Version
+	To   semver.Version
+	// Scripts are run in order, in a single transaction.
+	Scripts []MigrationScript
+	// SetsVersion is set if the schema version is set to To after the
+	// scripts, which isn't the case when only the idempotent scripts are
+	// reapplied on a dev version.
+	SetsVersion bool
+}
+
+// WriteSQL writes the SQL run by the plan to w, as a script running it in a
+// single transaction like Migrate does.
+func (p *MigrationPlan) WriteSQL(w io.Writer) error {
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "-- Migration of the schema from version %s to %s\n", schemaVersionString(p.From), p.To)
+	if len(p.Scripts) == 0 && !p.SetsVersion {
+		out.WriteString("-- Nothing to migrate\n")
+		_, err := out.WriteTo(w)
+		return err
+	}
+	fmt.Fprintf(out, "BEGIN;\n%s;\n", createMigrationsTable)
+	for _, script := range p.Scripts {
+		fmt.Fprintf(out, "\n-- %s\n%s\n", script.Name, strings.TrimSpace(script.SQL))
+	}
+	if p.SetsVersion {
+		fmt.Fprintf(out, "\n%s;\n%s;\n", truncateMigrationsTable, strings.Replace(setVersion, "$1", "'"+p.To.String()+"'", 1))
+	}
+	out.WriteString("COMMIT;\n")
+	_, err := out.WriteTo(w)
+	return err
+}
+
+func schemaVersionString(v semver.Version) string {
+	if v.Compare(semver.Version{}) == 0 {
+		return "none"
+	}
+	return v.String()
+}
+
+// plan returns the migration scripts that bring the schema from dbVersion to
+// appVersion.
+func (t *Migrator) plan(dbVersion, appVersion semver.Version) (*MigrationPlan, error) {
+	plan := &MigrationPlan{From: dbVersion, To: appVersion}
+
 	// If already at correct version, nothing to migrate on proper release.
 	// On dev versions, idempotent files need to be reapplied.
 	if dbVersion.Compare(appVersion) == 0 {
@@ -192,56 +382,46 @@ func (t *Migrator) Migrate(appVersion semver.Version) error {
 		}
 
 		if devRelease {
-			tx, err := t.db.Begin(context.Background())
-			if err != nil {
-				return fmt.Errorf("unable to start transaction: %w", err)
-			}
-			defer func() {
-				_ = tx.Rollback(context.Background())
-			}()
-			if err = t.execMigrationDir(tx, idempotentScripts); err != nil {
-				return err
-			}
-			if err = tx.Commit(context.Background()); err != nil {
-				return fmt.Errorf("unable to commit migration transaction: %w", err)
+			if err := t.addMigrationDir(plan, idempotentScripts); err != nil {
+				return nil, err
 			}
-			return nil
 		}
-		return nil
+		return plan, nil
 	}
 
 	// Error if at a greater version.
 	if dbVersion.Compare(appVersion) > 0 {
-		return fmt.Errorf("schema version (%v) is above the application version (%v), cannot migrate", dbVersion, appVersion)
+		return nil, fmt.Errorf("schema version (%v) is above the application version (%v), cannot migrate", dbVersion, appVersion)
 	}
 
-	tx, err := t.db.Begin(context.Background())
-	if err != nil {
-		return fmt.Errorf("unable to start transaction: %w", err)
-	}
-	defer func() {
-		_ = tx.Rollback(context.Background())
-	}()
-
 	// No version in DB.
 	if dbVersion.Compare(semver.Version{}) == 0 {
-		if err = t.execMigrationDir(tx, preinstallScripts); err != nil {
-			return err
+		if err := t.addMigrationDir(plan, preinstallScripts); err != nil {
+			return nil, err
 		}
-	} else if err = t.upgradeVersion(tx, dbVersion, appVersion); err != nil {
-		return err
+	} else if err := t.addUpgradeScripts(plan, dbVersion, appVersion); err != nil {
+		return nil, err
 	}
-	if err = t.execMigrationDir(tx, idempotentScripts); err != nil {
-		return err
-	}
-	if err = setDBVersion(tx, &appVersion); err != nil {
-		return fmt.Errorf("error setting clean app version to DB: %w", err)
+	if err := t.addMigrationDir(plan, idempotentScripts); err != nil {
+		return nil, err
 	}
+	plan.SetsVersion = true
+	return plan, nil
+}
 
-	if err = tx.Commit(context.Background()); err != nil {
-		return fmt.Errorf("unable to commit migration transaction: %w", err)
+// execMigrationPlan runs the scripts of plan in tx and sets the schema
+// version if the plan does.
+func execMigrationPlan(tx pgx.Tx, plan *MigrationPlan) error {
+	for _, script := range plan.Scripts {
+		if _, err := tx.Exec(context.Background(), script.SQL); err != nil {
+			return fmt.Errorf("error executing migration script: name %s, err %w", script.Name, err)
+		}
+	}
+	if plan.SetsVersion {
+		if err := setDBVersion(tx, &plan.To); err != nil {
+			return fmt.Errorf("error setting clean app version to DB: %w", err)
+		}
 	}
-
 	return nil
 }
 
@@ -258,7 +438,26 @@ func getSchemaVersion(db *pgx.Conn) (semver.Version, error) {
 	return getSchemaVersionOnConnection(context.Background(), db)
 }
 
-func getSchemaVersionOnConnection(ctx context.Context, db *pgx.Conn) (semver.Version, error) {
+// getSchemaVersionIfInstalled returns the version of the schema, or the zero
+// version if the migrations table doesn't exist, without creating it.
+func getSchemaVersionIfInstalled(db *pgx.Conn) (semver.Version, error) {
+	var exists bool
+	if err := db.QueryRow(context.Background(), migrationsTableExists).Scan(&exists); err != nil {
+		return semver.Version{}, fmt.Errorf("Error getting DB version: %w", err)
+	}
+	if !exists {
+		return semver.Version{}, nil
+	}
+	return getSchemaVersion(db)
+}
+
+// versionQuerier is a connection or a transaction the schema version is read
+// from.
+type versionQuerier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+func getSchemaVersionOnConnection(ctx context.Context, db versionQuerier) (semver.Version, error) {
 	var version semver.Version
 	res, err := db.Query(ctx, getVersion)
 
@@ -277,25 +476,30 @@ func getSchemaVersionOnConnection(ctx context.Context, db *pgx.Conn) (semver.Ver
 	return version, nil
 }
 
-func (t *Migrator) execMigrationFile(tx pgx.Tx, fileName string) error {
+func (t *Migrator) readMigrationFile(fileName string) (MigrationScript, error) {
 	f, err := t.sqlFiles.Open(fileName)
 	if err != nil {
-		return fmt.Errorf("unable to get migration script: name %s, err %w", fileName, err)
+		return MigrationScript{}, fmt.Errorf("unable to get migration script: name %s, err %w", fileName, err)
 	}
 	contents, err := replaceSchemaNames(f)
 	if err != nil {
-		return fmt.Errorf("unable to read migration script: name %s, err %w", fileName, err)
+		return MigrationScript{}, fmt.Errorf("unable to read migration script: name %s, err %w", fileName, err)
 	}
-	_, err = tx.Exec(context.Background(), string(contents))
+	return MigrationScript{Name: fileName, SQL: contents}, nil
+}
+
+func (t *Migrator) addMigrationFile(plan *MigrationPlan, fileName string) error {
+	script, err := t.readMigrationFile(fileName)
 	if err != nil {
-		return fmt.Errorf("error executing migration script: name %s, err %w", fileName, err)
+		return err
 	}
+	plan.Scripts = append(plan.Scripts, script)
 	return nil
 }
 
-// execMigrationDir finds all the migration files in a directory, orders them
-// (either by ToC or by their numerical prefix) and executes them in a transaction.
-func (t *Migrator) execMigrationDir(tx pgx.Tx, dirName string) error {
+// addMigrationDir finds all the migration files in a directory, orders them
+// (either by ToC or by their numerical prefix) and adds them to the plan.
+func (t *Migrator) addMigrationDir(plan *MigrationPlan, dirName string) error {
 	f, err := t.sqlFiles.Open(dirName)
 	if err != nil {
 		return fmt.Errorf("unable to get migration scripts: name %s, err %w", dirName, err)
@@ -341,7 +545,7 @@ func (t *Migrator) execMigrationDir(tx pgx.Tx, dirName string) error {
 
 	for _, e := range entries {
 		fileName := filepath.Join(dirName, e)
-		err := t.execMigrationFile(tx, fileName)
+		err := t.addMigrationFile(plan, fileName)
 		if err != nil {
 			return err
 		}
@@ -395,6 +599,8 @@ func replaceSchemaNames(r io.ReadCloser) (string, error) {
 	s = strings.ReplaceAll(s, "SCHEMA_PROM", promSchema)
 	s = strings.ReplaceAll(s, "SCHEMA_SERIES", seriesViewSchema)
 	s = strings.ReplaceAll(s, "SCHEMA_METRIC", metricViewSchema)
+	s = strings.ReplaceAll(s, "SCHEMA_DATA_EXEMPLAR", dataExemplarSchema)
+	s = strings.ReplaceAll(s, "SCHEMA_DATA_ROLLUP", dataRollupSchema)
 	s = strings.ReplaceAll(s, "SCHEMA_DATA_SERIES", dataSeriesSchema)
 	s = strings.ReplaceAll(s, "SCHEMA_DATA", dataSchema)
 	s = strings.ReplaceAll(s, "SCHEMA_INFO", infoSchema)
@@ -430,9 +636,9 @@ func (t *Migrator) getMigrationFileVersion(dirName string, fileName string) (*se
 	return &migrationFileVersion, nil
 }
 
-// upgradeVersion finds all the versions between `from` and `to`, sorts them
-// using semantic version ordering and applies them sequentially in the supplied transaction.
-func (t *Migrator) upgradeVersion(tx pgx.Tx, from, to semver.Version) error {
+// addUpgradeScripts finds all the versions between `from` and `to`, sorts them
+// using semantic version ordering and adds them sequentially to the plan.
+func (t *Migrator) addUpgradeScripts(plan *MigrationPlan, from, to semver.Version) error {
 	devDirFile, err := t.sqlFiles.Open(versionScripts)
 	if err != nil {
 		return fmt.Errorf("unable to open %v directory: %w", versionScripts, err)
@@ -488,7 +694,7 @@ func (t *Migrator) upgradeVersion(tx pgx.Tx, from, to semver.Version) error {
 		//that's marked as version X is part of that version
 		if from.Compare(v) < 0 && to.Compare(v) >= 0 {
 			filename := versionMap[v.String()]
-			if err = t.execMigrationFile(tx, filename); err != nil {
+			if err = t.addMigrationFile(plan, filename); err != nil {
 				return err
 			}
 		}
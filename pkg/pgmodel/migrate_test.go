@@ -0,0 +1,156 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/timescale/promscale/pkg/pgmodel/migrations"
+	"github.com/timescale/promscale/pkg/pgmodel/test_migrations"
+	"github.com/timescale/promscale/pkg/version"
+)
+
+func TestMigrationPlan(t *testing.T) {
+	testTOC := map[string][]string{
+		"idempotent": {
+			"2-toc-run_first.sql",
+			"1-toc-run_second.sql",
+		},
+	}
+	idempotent := []string{
+		"idempotent/2-toc-run_first.sql",
+		"idempotent/1-toc-run_second.sql",
+	}
+	mig := NewMigrator(nil, test_migrations.MigrationFiles, testTOC)
+
+	testCases := []struct {
+		name        string
+		from        string
+		to          string
+		scripts     []string
+		setsVersion bool
+	}{
+		{
+			name:        "install",
+			to:          "0.1.1",
+			scripts:     append([]string{"preinstall/001-setup.sql"}, idempotent...),
+			setsVersion: true,
+		},
+		{
+			name: "same release",
+			from: "0.2.0",
+			to:   "0.2.0",
+		},
+		{
+			name:    "same dev version",
+			from:    "0.10.1-dev",
+			to:      "0.10.1-dev",
+			scripts: idempotent,
+		},
+		{
+			name: "upgrade",
+			from: "0.8.0",
+			to:   "0.10.0",
+			scripts: append([]string{
+				"versions/dev/0.9.0-dev/1-migration.sql",
+				"versions/dev/0.10.0-dev/1-migr_98_at.sql",
+				"versions/dev/0.10.0-dev/2-1_mig.sql",
+			}, idempotent...),
+			setsVersion: true,
+		},
+		{
+			name: "upgrade within a release",
+			from: "0.10.1-dev",
+			to:   "0.10.1-dev.1",
+			scripts: append([]string{
+				"versions/dev/0.10.1-dev/1-migr_98_at.sql",
+			}, idempotent...),
+			setsVersion: true,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			var from semver.Version
+			if c.from != "" {
+				from = semver.MustParse(c.from)
+			}
+			plan, err := mig.plan(from, semver.MustParse(c.to))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var scripts []string
+			for _, s := range plan.Scripts {
+				if s.SQL == "" {
+					t.Errorf("empty script %s", s.Name)
+				}
+				scripts = append(scripts, s.Name)
+			}
+			if !reflect.DeepEqual(scripts, c.scripts) {
+				t.Errorf("unexpected scripts:\ngot\n%v\nwanted\n%v", scripts, c.scripts)
+			}
+			if plan.SetsVersion != c.setsVersion {
+				t.Errorf("unexpected sets version: %v", plan.SetsVersion)
+			}
+		})
+	}
+
+	if _, err := mig.plan(semver.MustParse("0.11.0"), semver.MustParse("0.10.0")); err == nil {
+		t.Error("expected an error migrating down")
+	}
+}
+
+func TestMigrationPlanReplacesSchemaNames(t *testing.T) {
+	mig := NewMigrator(nil, migrations.MigrationFiles, tableOfContets)
+	plan, err := mig.plan(semver.Version{}, semver.MustParse(version.Version))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Scripts) == 0 {
+		t.Fatal("no scripts to install the schema")
+	}
+	for _, s := range plan.Scripts {
+		if strings.Contains(s.SQL, "SCHEMA_CATALOG") {
+			t.Errorf("schema name not replaced in %s", s.Name)
+		}
+	}
+}
+
+func TestMigrationPlanWriteSQL(t *testing.T) {
+	mig := NewMigrator(nil, test_migrations.MigrationFiles, map[string][]string{})
+	plan, err := mig.plan(semver.MustParse("0.8.0"), semver.MustParse("0.9.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out strings.Builder
+	if err = plan.WriteSQL(&out); err != nil {
+		t.Fatal(err)
+	}
+	sql := out.String()
+	for _, expected := range []string{
+		"-- Migration of the schema from version 0.8.0 to 0.9.0\nBEGIN;\n",
+		"\n-- versions/dev/0.9.0-dev/1-migration.sql\n",
+		"\nINSERT INTO prom_schema_migrations (version) VALUES ('0.9.0');\nCOMMIT;\n",
+	} {
+		if !strings.Contains(sql, expected) {
+			t.Errorf("missing %q in:\n%s", expected, sql)
+		}
+	}
+
+	plan, err = mig.plan(semver.MustParse("0.9.0"), semver.MustParse("0.9.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err = plan.WriteSQL(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "-- Migration of the schema from version 0.9.0 to 0.9.0\n-- Nothing to migrate\n" {
+		t.Errorf("unexpected SQL:\n%s", out.String())
+	}
+}
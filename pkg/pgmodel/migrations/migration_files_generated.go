@@ -28,9 +28,9 @@ var MigrationFiles = func() http.FileSystem {
 		"/idempotent/base.sql": &vfsgen۰CompressedFileInfo{
 			name:             "base.sql",
 			modTime:          time.Time{},
-			uncompressedSize: 56173,
+			uncompressedSize: 79222,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x77\x1b\x37\x92\xe8\xe7\xf0\x57\xd4\xdd\x2b\x2f\x49\x0f\xc9\x58\xce\x9d\xd9\xbd\xf2\xc8\xe7\x30\x52\xdb\xe1\x8e\x4c\x7a\x48\x2a\x8f\xcd\xcd\xe1\x42\xdd\x20\x89\xa8\xd9\xcd\x34\x40\xc9\xcc\x99\x1f\x7f\x0f\x0a\x8f\x06\xfa\xc1\x97\x25\xcf\xcc\xd9\xe5\x87\xc4\xea\x46\xe3\x51\xa8\x37\xaa\x0a\xdd\xee\x70\x34\x0d\x26\x8d\x6e\x77\xba\x64\x1c\xc2\x34\xa2\x40\x38\xdf\xac\x28\x07\xb1\x24\x02\x04\xb9\x8b\x29\x24\x44\x3e\x08\x49\x02\x69\x12\x6f\xe1\x8e\xc2\x9f\xbe\x81\x70\x49\x32\x0e\x71\x9a\x2c\x1a\x8d\xc6\xd5\x38\xe8\x4f\x03\x18\x8d\x61\x1c\x7c\xbc\xe9\x5f\x05\xf0\xee\x76\x78\x35\x1d\x8c\x86\x30\xb9\xfa\x2e\xf8\xd0\x9f\x5d\xf5\xa7\xfd\x9b\xd1\xfb\xde\x82\x8a\x59\x44\xe7\x64\x13\x8b\x59\xb8\xdc\x24\xf7\x33\x96\x08\x9a\x3d\x90\xb8\xd5\x6e\x00\x00\x8c\x83\xe9\xed\x78\x38\x81\xc1\x70\x1a\x8c\xbf\xef\xdf\x34\xfa\x13\x38\x9b\x6f\x92\xf0\x0c\x5f\x4f\x82\x9b\xe0\x6a\x0a\x0f\x24\xde\xd0\x8b\x0b\xd3\x08\xde\x8d\x47\x1f\x8a\x43\xe9\x61\xe0\x87\xef\x82\x71\x00\xf7\x74\x7b\xd9\xf4\x47\x6c\xbe\x69\xe8\x9e\x6f\xfa\xc3\xf7\xb7\xfd\xf7\x01\x4c\xfe\x7a\x03\x93\x69\xff\xdb\x9b\x00\x3e\xf6\xc7\xfd\x9b\x9b\xe0\x06\x26\xfd\x77\xc1\x9b\xc6\xfb\x71\x7f\x38\x85\xe0\xc7\xe0\xea\x56\xae\x74\x78\xd2\x0a\x61\x3a\x82\x75\x96\xae\x66\x19\x25\x11\xcd\xde\x9c\x0a\xb9\x8c\x0a\x9a\x08\x96\x26\xb3\x35\xcd\x58\x1a\x7d\x09\xd8\x15\xc7\x7c\x7e\xe8\x95\x57\xf9\x39\xf0\x63\x7c\x26\xd8\x8a\xf2\x90\xc4\x34\xba\x9b\xb1\x84\x0b\x12\xc7\xb4\x08\xbb\x6f\x47\xa3\x9b\xa0\x3f\xac\x06\x5d\x98\x6e\x12\xd1\x7a\xd9\x86\xb7\xf0\x4a\xc1\x6d\xbd\x98\xd1\x4f\x82\x26\x9c\xa5\x89\x86\x16\xfd\x24\x24\xc5\x5c\x36\x9d\xe1\x76\x02\xeb\x64\x34\x08\xd3\xd5\x3a\xa3\x5c\x8e\x3d\xe3\x54\x08\x96\x2c\x8e\x59\x8d\x46\x04\xdd\xe6\x50\x3c\x58\x51\x91\xb1\xd0\x1d\xfb\x0b\xd0\x51\xd5\x42\xcb\xc8\xd0\xed\xf6\xa3\x08\xce\x5f\x40\x3a\x87\x8c\x24\x51\xba\x4a\x28\xe7\x20\x52\x10\x4b\x0a\x86\x0c\x81\xa7\x8a\xbb\x21\x75\x72\x20\x19\x85\x24\x15\x40\x62\xb6\x48\x68\x54\xf5\x9a\x0b\xb2\x58\xd0\x8c\x46\x30\x4f\x33\x70\x66\x03\xbf\xa6\x77\xbc\x77\xe4\xf6\xd9\xde\x8a\xfc\xc1\xff\xd3\x92\x71\xbb\x71\x18\x61\x17\x3e\x7f\x09\xad\xf3\xde\xab\x3f\xb4\x5a\x0a\x14\xad\xf6\xcb\x57\xbd\x57\xe7\xed\xee\xab\xde\xab\x57\x7f\x6c\xb7\xab\x37\xed\xfb\xd1\x4d\x7f\x3a\x40\x9c\xec\x76\xaf\x48\x92\x26\x2c\x24\x31\xc4\x69\x78\x0f\x69\x16\xd1\x8c\x25\x8b\x8b\x46\xb7\xab\xb0\x80\x37\xba\xdd\x88\x08\xa2\x04\x45\xa3\xdb\x8d\xc9\x1d\x8d\xe5\x53\x4e\x33\x46\x39\xac\x49\x46\x13\xe1\xfd\x2d\x98\x24\x69\xd9\x7d\x98\x26\x5c\x64\x84\x25\x82\xcb\x2e\xbb\x30\x5d\x52\xb5\xa3\xaa\x77\x78\x60\xf4\x11\x04\xb9\xa7\x1c\x27\xc0\x81\x25\xb8\x93\x38\x91\x0b\xc8\x47\xee\x40\xb1\xff\x5e\xa3\x61\xc4\xda\x3a\x4b\x43\x1a\x6d\x32\x0a\x73\x96\x90\x98\xfd\x8e\xd2\x8d\x42\x98\x51\x22\x9b\x4a\x6c\x21\xa0\x86\xec\xe1\x1c\xe6\x2c\xe3\x02\xfb\x82\x74\x6e\x17\x9b\x7f\xb0\x24\xeb\x35\x4d\x70\x3a\x2b\x72\x4f\xf5\x74\x67\x4a\x5a\x92\x24\xc2\xee\x71\x30\xd5\x89\x69\xbf\xa4\x19\xed\x35\xba\xdd\x1f\x28\xf0\x75\xcc\x04\x14\x3b\x66\x89\xc4\xd5\xc7\x14\x3f\x43\xc4\x5d\xb1\x84\xad\xd8\xef\x14\x62\x22\x68\x12\x6e\x21\xda\xc8\x2d\x00\x96\x70\x9a\x21\x20\xbb\xdd\xd6\xe3\x92\x85\x4b\x77\x56\x72\xfc\xf2\xcc\xd6\x44\x2c\xdb\x3d\x08\xf8\x9a\x86\x8c\xc4\xf1\x56\xa2\x3d\x7d\x4c\x33\xb1\xdc\x02\x53\x22\xbf\xd1\xed\x12\x21\x48\xb8\x94\x83\xc8\x6e\x2c\x44\x0d\x19\x69\x48\xab\x2e\xdd\x95\xc1\x1d\x0d\xc9\x86\x53\x60\x02\x32\xfa\xdb\x86\x65\x54\x62\x02\x49\x80\x7e\x0a\xe3\x0d\x67\x0f\x14\xb7\xb1\x03\x6a\xbe\x8c\x03\x81\x25\x5b\x2c\xbb\x66\x6d\xe9\x9a\x66\x08\x08\xb5\x0d\xa9\x58\xd2\x0c\x48\x28\x9f\xc8\xd9\x31\xd9\x9d\xc4\x59\x9c\x4e\x94\x52\x87\x76\x39\x84\x19\x13\x0a\x57\x55\x6f\xdd\x47\xc6\x29\xdc\x6d\x04\x36\x22\x31\x4f\xb1\x65\x42\x43\xca\x39\xc9\xb6\x8d\x6e\x57\xa4\xb0\xa6\xd9\x3c\xcd\x56\x12\x68\x88\x55\x72\x95\x0a\xb6\x0a\xbd\xd4\x6e\x6e\xd4\x48\xeb\x8d\xb0\x7b\xd8\xe8\x76\x87\xa9\xa0\x17\x8a\x57\x10\x90\xc8\x4c\x7f\xdb\xd0\x24\xa4\x12\xa1\xe4\x6c\x21\xa2\x9c\x2d\x12\x03\x5a\x17\x7a\x39\x54\x25\x14\x10\xe0\x34\x52\x33\xf2\x5b\xd1\x44\x00\x99\x0b\x9a\xa9\x6d\x65\x1c\xb8\xa0\x6b\x09\x1f\x39\x27\x83\x40\x2b\xb6\x58\x0a\x5c\xde\x9d\xfc\x98\x4a\x4c\x02\x9e\xae\x24\x49\x86\x59\xca\xb9\x41\xe1\xdf\x36\xaa\xe7\x0c\x3f\x20\x8f\x64\x2b\xbb\x4a\x39\xb5\x6f\xe4\x90\x4d\x21\x79\xdc\x4a\x62\x7a\xfa\x48\x1f\xe4\x26\x68\xa4\x8e\x68\x4c\x24\xe4\x98\x44\x33\xb9\x38\x36\x67\x21\x49\x84\x1c\x6f\x9d\xc9\xad\x0a\x0d\x74\xe4\x56\x77\x35\xa5\xea\xd1\x35\xad\x4a\xc0\xce\x4a\x74\x4b\x13\x51\x26\xe3\x0a\xce\xfa\x71\x3c\xba\x0a\xae\x6f\xc7\x41\x91\xb5\x1a\xea\x36\x48\x6f\xa8\xaa\xd5\x46\x76\x29\xd9\xc0\x59\xe3\x3a\xb8\xba\xe9\x8f\x03\x64\x9b\x19\x8c\x83\xab\xd1\xf8\xfa\x0d\xfe\x85\xcd\x69\x04\x77\x69\x1a\x53\x92\xbc\x69\x7c\x1b\xbc\x1f\x0c\xf1\xd5\xbb\xd1\x18\x32\xd0\x7f\x38\x0c\xf7\xa5\x7d\x50\x25\x3b\xd5\x34\x6c\x13\x25\x42\x87\xa3\xa9\x25\x77\x14\x6b\x31\x15\x34\xb2\x8d\x46\xe3\xeb\x60\x0c\xdf\xfe\x04\x86\x67\xe3\x9b\x9b\xd1\xe8\x63\x71\xec\x1d\x9d\x0c\x86\xd3\x91\x59\xce\x01\x33\x84\x55\x61\x8e\xab\x1e\x8b\xe0\x12\xb2\x1e\x73\x3e\x1f\x8d\xe1\xf6\xe3\x75\x7f\x2a\x25\x83\x1d\xe8\x9d\x85\xda\xf4\xbb\x20\x07\x8f\xfc\x75\xbb\x19\x8d\x29\xe1\x14\xb2\xf4\x11\xe9\xde\x7b\x7d\x35\xfa\xf0\x61\x30\x7d\x53\x78\x36\x9c\x0e\x86\xb7\x41\xfe\x34\x18\x5e\xc3\xe0\x9d\x3f\xe2\xc1\x6a\x1d\xf4\x87\xd7\x27\x28\x15\xc5\x85\x7c\x0c\xc6\xef\x46\x63\x0b\xbb\x8f\xe3\xd1\x87\x1e\xa7\xfe\xe7\x69\xe2\x71\xda\x56\xd6\xc3\xff\xcf\xa4\x1e\xd8\x81\xe9\xf8\x36\x68\xef\x58\x54\xb7\x1b\xa5\x8a\xb6\xef\xe8\x3c\xcd\xa8\x14\x79\x92\xfd\xfa\x6c\xd3\x93\x06\x8f\x69\x76\xaf\xf9\x82\x6e\xec\x41\x58\xed\x54\xcd\x76\x4f\x82\x2a\xec\x81\x4b\x9c\xa7\x46\x01\x8b\x00\xde\x34\x1f\x29\x3c\xb2\x38\x86\x84\xd2\x48\x4d\x18\x27\x26\x75\xa2\x3a\xa1\x21\x95\x29\x72\x8f\x32\x21\x49\x1f\x9d\xbe\x44\x0a\xe4\x21\x65\x91\xea\x62\xb3\x5e\x64\x24\xa2\x3d\x18\x08\x87\x93\x97\x56\x1c\xa5\x09\x95\xd2\x23\xa6\x4a\x1c\xe4\xdd\x61\x2f\x92\xd1\x92\x7b\x9a\xf4\xec\x8b\x9b\xd1\xd5\x5f\x40\xe9\xa1\xa3\xe1\xcd\x4f\x45\x88\x68\x76\x33\x18\x42\xff\xea\x2a\x98\x4c\x20\xf8\xf1\xea\xe6\x76\x32\xf8\x3e\x80\x55\x1a\x51\x67\xf1\x46\x61\x95\xc2\x82\x88\xd6\xd9\x99\x8b\x23\xfd\x9b\x69\x30\xd6\xc3\x54\x8f\xd0\x9f\x4e\xfb\x57\xdf\x49\x5d\x78\x3a\x70\xd5\xc2\xeb\xfe\xb4\x3f\x9b\x04\xe3\x41\x30\xe9\xbd\x38\x3f\x1b\x20\x9d\x7d\xdf\xbf\xb9\x0d\xa4\xb2\x07\xad\x17\xaf\xcf\x6e\xda\x76\xa8\xb3\xb3\x0e\xf8\xa8\x25\xb7\xc8\x41\x2d\x97\xaa\x24\x9a\x49\xc6\xf1\xa6\x11\x0c\xaf\xdf\x34\x14\xff\x03\xab\xec\x7d\xbc\xf9\xf8\x7e\xf2\xd7\x9b\x37\x0d\xf9\x4d\x30\x9c\x4a\x55\xfc\x14\xd6\x3a\x98\x40\xf3\x9d\xd5\xab\x0a\x0a\x8d\x14\x2e\x9e\x06\xc6\x97\xe9\x26\x8e\xa4\x94\xca\x36\x09\xdc\x6d\x95\x22\x96\x26\x09\x0d\x85\xc4\xa2\x8d\x48\x57\x04\x37\x3f\xde\x36\x2b\x8c\x85\x13\x66\x68\xcd\x84\xc7\x8c\x09\x6d\x26\xe0\xac\xac\x26\xc1\x38\x84\xc8\x33\xe4\x84\x08\x88\x8c\x49\xf5\x1c\x1e\x97\x34\x01\x02\x09\x7d\x34\xcb\x92\x0d\x15\xbf\x93\x88\x8a\x5a\xad\xe0\xb0\x59\x2b\x7d\x4b\xb5\xf9\x75\xc3\x05\xd0\x24\xdd\x2c\x96\x45\x5d\x02\xb5\x3b\x26\x7a\xf0\xc1\x87\x92\x92\xa7\x39\x25\xb2\x04\x76\x2c\x87\xdc\xa5\x0f\xb4\x07\x13\x4a\x35\xf0\x56\x2b\x9a\x08\xa9\x1a\x49\x1d\x4d\xea\x19\x76\x61\x92\x30\x65\x9b\x8c\x12\x9e\x26\x92\x38\xd5\x13\xa9\x45\xa0\xfe\xa9\x14\x14\x4f\x9d\x31\xda\x13\x97\x36\xad\x90\xcc\xc7\x74\xd7\x83\x89\xda\x3d\xf4\x02\x85\x69\x22\x08\x4b\xbc\xf5\xc6\xe9\x82\x85\x4a\x8b\xe1\x9b\xf5\x3a\xcd\x84\x5e\x3f\xb7\x53\xd1\x6a\x76\x41\x3f\x70\x35\x79\x65\x42\x54\x69\xf4\x87\x5b\x59\x25\xdd\xb7\x60\x16\xeb\x2d\xc6\x67\xb9\x29\x95\xeb\x06\x38\x87\x19\x8b\xa4\x28\x75\x14\x81\x02\x13\x68\xea\x09\x79\x84\x2f\x29\xba\xf7\x62\xd0\x92\x42\x09\xa6\x83\x0f\xc1\x64\xda\xff\xf0\x71\xfa\x9f\x28\xf9\x87\xb7\x37\x37\x1d\x65\x77\xc3\xf5\xe8\x16\xcd\xe3\x71\x70\x35\x98\xc8\x35\xe4\x0d\xd4\xd2\xe5\xf8\xdf\x0e\xde\x0f\x86\x53\xfb\xaa\xdd\xec\x78\xb2\xc9\xfc\x86\xc1\x0f\x0e\x5b\x50\x0c\xa1\x66\xb2\xb7\xc3\xc1\x5f\x6f\x03\x18\x0c\xaf\x83\x1f\x95\x26\x66\x47\x43\x41\x3a\x7b\xc1\xc1\xe7\x4f\xbd\x17\x03\x68\xd9\x46\x1d\x90\xad\xda\x30\x18\x5e\xdd\xdc\x5e\x07\xd0\xc2\xd5\xec\x9a\x98\xfc\xa6\x34\xc1\xc6\xb1\xc2\xdc\x93\xcb\x46\x26\x2b\x6a\x9c\x2d\xb7\x6b\x9a\xa9\x7d\x36\xab\xf5\xe7\xdf\x2c\xcd\xa0\x03\xe8\x9e\xa9\x99\xb6\xfd\x29\x93\x1a\xe1\x62\xec\xea\xcb\xb7\xc7\x98\xf4\x47\x38\x07\xdb\xfb\xe6\xa2\x16\x6b\x3e\x67\x49\x44\x3f\x51\x7e\xf9\x76\x4e\x62\xae\xf7\xdc\xd7\x2d\xb4\xa6\x58\x31\x85\x34\x9b\xe9\xde\x0c\xa6\xb7\x9a\x33\x04\xcc\x6c\xa6\x61\xa5\xa9\x07\x81\x85\xbd\xa1\x56\x39\x99\x8e\x07\x57\x53\x4b\x1f\x6a\xd0\x6e\x57\xda\x91\x8a\xf7\x18\x1b\x50\x51\xf1\xcf\xe7\xbf\x48\xa6\xb9\x49\xd8\x6f\x1b\x0a\x04\x4d\x91\x9c\xa2\x39\x9a\x15\x0a\x21\x5b\xea\x83\x36\x9a\x15\x91\xa3\x41\x18\x86\x80\x06\xd8\x62\x43\x32\x92\x08\xa9\x7e\x2c\xe2\xf4\x0e\x6d\x58\xd5\x79\x63\xb7\x90\xae\xa3\x54\x4f\xf6\xb6\x3c\xf8\xb3\x08\xee\xd8\x82\x25\x22\x27\x4c\xef\xbd\x06\x10\x93\xfc\xba\xae\x8d\x9e\xba\xab\x3a\x2a\xd0\x91\x2c\x23\xdb\x9a\x8f\xae\xbe\x0b\xae\xfe\xd2\xca\x01\x78\x09\x52\x0b\x40\x6d\x36\x7f\x38\x98\xe4\x4c\xa1\xea\xf3\x7c\x76\x97\xf0\xe2\x9b\xb3\x52\xa3\xd1\x70\x32\x1d\xf7\x25\x6f\xd1\x94\xad\xba\x96\x5c\xe0\xc5\x37\x67\xbc\xb8\x2b\x96\xda\x59\xb4\xb7\xa7\xf5\x3d\xdd\xaa\x4e\x3e\x8e\x07\x1f\xfa\xe3\x9f\xe0\x2f\xc1\x4f\xf2\x43\xfb\x9d\xfa\x97\xd4\x66\x7c\xaa\xec\x58\xc4\xea\x68\xce\xa1\x39\x85\xe2\xdb\xf2\x19\x6a\x33\x25\xcf\x95\x56\x66\x1c\xef\xd5\xc1\x6e\xc6\x0a\x51\x51\xd6\x18\xae\xc7\xa3\x8f\x30\x1d\x0f\xde\xbf\x0f\xc6\x92\x6d\x05\x3f\x0e\x26\xd3\x49\xd9\xc5\x32\x33\xba\x43\xc5\x38\x4a\xe6\x5f\xf5\x27\x57\xfd\xeb\xe0\x8d\x11\x66\xa6\xd3\xda\xae\x94\x8c\x7a\x27\x15\xcc\xc1\x70\x12\x8c\xa7\xb5\x7d\x5b\x53\x35\x90\xaa\xe6\x78\xf4\x83\x47\x13\xb5\x9a\x53\x05\x00\xde\xa0\xf3\xac\xfa\xd7\xe8\x76\x61\x20\x79\x56\x42\x62\xab\x1a\x70\xc0\x17\x35\x5f\xc8\x4f\xc6\x54\x6c\x32\xa9\x4e\xe5\x47\x4a\x70\xb7\x61\xb1\x80\x79\x96\xae\x80\xc0\x7c\x13\xc7\x88\x04\xc8\x14\x08\xf0\xcd\x7c\xce\x3e\x49\x45\x41\xb9\xe4\x36\xd2\xee\x90\xaf\xa5\x92\x9f\x6d\x92\x10\xcd\x4e\xe3\xab\x45\xa7\x09\x7e\x01\x21\xea\x28\x73\x86\x3e\x09\xf9\x19\xf6\x81\x9f\x72\x34\x24\xa4\x05\x43\xe2\x47\xb2\x95\xf6\x16\xd0\x4f\x24\x14\xf1\x16\xfe\xf4\x5a\x1d\x69\x1d\xa3\x66\xac\x17\x8a\x67\x3e\x32\xb1\x9c\xa9\xe1\x73\x1e\x92\x2f\x48\xd0\x4f\xa2\x63\xa6\x27\xff\xf0\x95\x11\xd9\xa6\xda\xa1\xdb\xe2\x9b\x3b\x2e\x32\x69\x98\xe6\xbd\x49\x4d\xea\x4f\xaf\xbb\x2d\x39\xdb\x59\x4c\x93\x85\x58\xb6\x54\xdf\xed\x3f\x9c\xb7\xdb\xf0\xb7\xbf\x41\x73\xd6\x94\xff\xd3\x4f\x2f\x2e\x70\x84\x2a\x6f\xef\xe0\xc3\x87\xdb\xcf\xf3\xd2\x57\x81\x40\xad\x17\x17\x5a\xe5\xa3\xcf\x71\x41\xaa\xd6\x5a\x36\xa8\xa5\x29\x54\xb0\x58\xc0\x22\xbd\xff\xb8\xe7\xe8\x75\x4c\x41\x4a\x17\xa1\x31\x42\x41\xc4\xec\x33\x7c\xbb\x11\xc0\xe6\xf2\xad\xfc\x2c\x47\x99\x28\xa5\x3c\x69\x0a\x89\x14\x1d\x58\xd0\x84\x66\x44\x50\x5e\x9e\x00\x8e\x36\xb4\xb2\x4c\xa0\x57\x33\x24\x89\x76\xac\x11\x08\xd3\x38\x66\xe8\xf7\xbf\xa3\xe2\x91\x52\x34\x10\x36\x9c\x66\xf2\xc3\x88\xce\x59\x42\x23\x70\x90\x18\xff\x29\x41\x63\x11\xda\x0a\xc8\xaa\xaf\x38\xa4\x73\x50\x5b\x2a\xf1\x51\x23\xe9\x82\x8a\xfc\x73\x92\x44\xf2\xab\x30\x4d\x1e\x68\xc6\x69\xbc\xed\x00\xd1\xcb\xe4\x85\x91\xa4\xc0\xb4\x9d\xf5\x10\xf2\x3f\xe0\xb8\x40\x60\x45\x3e\xa9\xc9\xe9\x06\xe9\x5c\x0e\x28\xd7\xf9\xa7\x6f\xec\x14\x15\xa9\x5a\xe7\xb4\xd2\x18\xa4\xd0\x96\x5d\x29\x09\x26\xb6\x6b\x05\xba\x08\xfe\x4b\x71\x0f\xf9\xc7\x7f\xf5\xe4\x48\xca\x4b\x90\x02\x4d\xb8\x34\xfd\x34\x48\x19\x37\x64\x2c\x7b\x31\x9a\x01\x87\x47\x1a\xc7\x1d\x49\xcf\x4b\xf2\x40\xe5\x67\x19\xe5\x34\x7b\x90\x93\xe5\x6b\x12\x52\x6b\x41\x6c\x92\x88\x66\x3c\x4c\x33\x7a\x0a\xa9\xaa\x01\x2b\xa8\x74\x46\xb2\xc5\xe9\x94\x7a\xd5\x9f\x04\xae\x17\x6d\x08\x2e\x79\x7a\x83\xb4\xe1\xcf\x12\xd6\x25\x3f\x93\xd7\x48\xd3\xac\x79\x17\xdc\x38\xdd\xe3\xb0\x47\x30\xa2\xca\x01\xcc\x2a\xbd\x56\x6d\xd7\x47\xf5\xcc\x0c\x43\x6f\xc4\x1e\x5e\x71\x65\x70\x0e\x49\x55\x21\x24\xba\x9b\x60\xc1\x1e\x68\x62\x8c\x6e\x43\xbc\xc8\x29\x36\x9c\xa2\x51\xce\x53\xc9\xf2\xb5\x4f\x9e\x4b\xd4\xe2\x8e\xfd\x7a\x47\xb5\xd1\xdf\xe8\x76\x07\xc8\x33\x74\xf7\x78\xae\x20\x29\x61\x4b\x05\xd0\x4f\x8c\x0b\xd5\x33\x75\x1c\x06\xda\xa8\x55\xc7\x35\xb9\xed\x1f\x12\x41\xe2\x74\xa1\x2d\x59\x89\xdf\xfa\xa8\x03\xe9\x89\xd7\x1c\xcb\x18\x9d\x41\xa4\x30\x67\x99\xf7\x1d\x09\xc5\x06\x95\x5c\x43\x7b\x76\x9a\xb2\x11\x9e\x89\x19\xe7\x7a\xa7\xdc\xf3\xcf\x87\x98\xd5\xbf\x1c\x41\x44\xda\x66\xf0\x94\x85\x46\x41\x1f\x2e\xd0\xd2\xe8\x76\x0a\x4a\x45\x56\xff\xce\x95\x3d\x50\xa6\x45\x95\xf9\x9d\xd0\x47\xad\x57\x1b\xe3\x5b\x3f\xb9\x84\x84\x7e\x12\xd2\x5a\x5a\x2f\x66\x68\x77\xd1\x8c\x91\x78\x66\x76\xd9\xda\x7d\xbe\x66\xd4\xec\x34\x59\xd4\x6c\xb7\x2f\x2e\xb0\x4b\xeb\x4e\xd7\x0a\x95\xb2\x6c\x2a\xd5\xb5\x96\x54\x45\x9d\x95\x75\x9c\x05\xb4\x8b\x2e\x79\x3d\xef\xb2\x19\x57\x00\x4d\xb9\xc1\x6e\x1a\x29\x7e\xae\xc7\xb9\xb8\xc8\x39\xd4\x68\x28\x15\xf1\x77\x37\xd2\x38\xbb\x1e\x49\xd3\xe0\xbb\xc1\xf0\xbd\xc3\xbc\x06\xc3\xf7\xd5\x4b\x44\xf3\xbc\xfa\x4d\xbe\xd4\xdc\x00\x44\x07\x80\x7d\x6e\xec\x3f\xc5\x94\xf1\x30\x4f\x8a\xa6\x70\x93\x65\x78\xa0\xa7\x8e\xdd\xd1\x03\xb6\x22\x78\xdc\x08\x99\x16\xfe\xc9\x56\x2c\x59\xb2\x40\x96\x2f\xb2\xad\x64\xf3\x34\xa6\xa1\x40\xc9\x19\xa7\xe9\xda\x74\xbd\x14\x62\xcd\x2f\xbe\xfe\x9a\x0b\x12\xde\xa7\x0f\x34\x9b\xc7\xe9\x63\x2f\x4c\x57\x5f\x93\xaf\xcf\xff\xf8\x7f\xff\xf8\xea\x9b\xd7\xff\x47\x6b\xba\x83\xa9\xe2\xbd\xef\x46\xb7\xc3\x6b\xdf\x08\x5e\xe1\x3a\x57\x07\xac\x49\xa9\xd1\xfb\x4e\x4b\xf4\x49\x49\xbe\x33\x70\x59\xdc\x66\x3d\x81\xd2\xb4\x3c\xc7\xec\x5e\x53\x06\x8e\xe0\xad\x55\xf4\xe9\xb3\x56\xc7\x07\xea\xb3\x56\x65\x78\xdd\xd3\x2d\x1e\xd7\xb8\x2c\xf6\x9e\x6e\x9f\x93\xb5\x1e\xcd\x7d\xec\x4c\x73\xd6\x23\xe9\x41\x4e\x7d\x1a\xfc\x38\xb5\x2c\x67\x30\xd4\xff\x46\x07\xd5\x2c\x4c\xe3\xcd\x2a\x51\x5b\x35\xec\x7f\x08\x4c\xbb\xd2\x8b\xc6\x73\xf3\x24\xbb\x80\x13\xd8\x52\xbe\x4d\xc8\x99\xee\xe9\xb6\x53\x5e\x5f\xa7\xb0\xac\xc3\x19\x95\x06\xe4\xb1\x0c\xca\x7c\xe6\x33\xa6\x13\x7b\x51\x06\x0c\x8b\x9a\x1d\xeb\xba\x7c\xc1\xd5\xdf\xaa\xfb\xf6\xe9\x2c\xcf\x82\xaf\x8a\xeb\xe5\x2f\x2b\x20\xba\xa3\x23\xb7\xa1\xcf\x54\xf6\xee\xcc\x3f\x0f\xff\x8c\xef\x11\x64\xf1\x7d\x15\x70\xf0\xe5\x67\x80\xa1\x96\xe5\xe6\xe8\x1e\xdf\x3b\x6c\x57\x3e\xb8\x34\xc8\xfa\x34\x6c\xf6\x78\x2e\x9b\xf3\x21\xc9\x76\x2a\x59\xec\x7b\xb4\xdc\xb0\x21\x18\xd6\xca\xe6\x90\x26\xb9\x49\x7a\x12\x27\xac\x72\xe1\x7a\x0c\xf1\xc9\x98\x61\xdb\x37\x77\x34\x32\x1c\xbc\xa9\x87\xec\xa9\xda\xd2\xf8\xbe\xa7\x76\xb5\x66\x6d\xf2\xad\x6c\x7d\x3b\x94\xf0\xe8\xdf\xdc\x34\x0a\x61\x18\x55\x43\x95\x00\xb4\xa3\x73\x64\x2a\x37\x83\x0f\x83\x29\x9c\xef\x89\x8c\x3b\x2a\x84\xb1\x6a\x9f\x14\xc2\x88\xb4\x84\x30\xa0\x30\xc6\x0a\x64\x6d\x65\xaf\x53\xce\xec\x81\x9e\x83\x50\x3d\x78\x27\x1f\x24\x5b\x63\x03\xc8\x2e\x1e\x29\x3c\x92\x44\xb9\xc4\xcc\x87\xe8\x38\xb9\x43\x3b\x3b\x4c\x57\x6b\x12\x62\xc4\xd4\x3a\xe5\x9c\xdd\xc5\x34\x77\xb2\xa0\x7c\x47\xe1\xbe\xce\xa8\x10\x5b\x58\x52\xf2\xb0\xd5\xc1\x77\x5c\xf9\x5e\xf8\x9a\x64\x2c\x59\xc4\xa8\x15\x18\x1b\xc4\xae\x6d\x66\x86\xec\xec\x0c\xcf\x83\x16\x4b\x54\x78\x9f\x71\x2f\xb4\x3b\x47\x12\x80\x24\xff\x75\xca\x67\xf3\x34\xf3\x91\xdf\x55\xca\x94\x11\x22\xe7\x65\xff\xf4\x4d\x7a\x96\x88\x4a\x71\x0f\x39\xd0\x51\x38\x2b\xe9\xf8\x49\xcc\xca\x8f\x3d\x63\x4e\x12\x8d\x1b\x3a\xd4\xed\x4a\x98\x45\xe9\x06\x5d\x29\x4b\x1a\xde\x23\xc8\x58\xb2\xc0\x48\x32\xdd\x66\xce\xb8\x80\x74\x2d\xd8\x8a\x71\x21\x0d\x49\xd9\xf0\xc2\xe1\xbf\x76\x71\xeb\x94\x5b\x6e\xd9\xa8\x91\xab\xe5\xcd\x80\xf8\x7e\x9d\xf3\x4f\xfb\x5d\x7c\xbf\xee\xf9\x2a\x6c\x05\x60\xdd\x16\xf6\x4b\x3c\x8c\xb8\x5f\x3b\x34\x5b\xfc\xca\xc0\x3c\x17\x05\x66\x32\x9a\x61\x0f\xde\x29\x4e\xed\x7b\x42\xb4\xa7\x3f\x6f\x0b\x35\xa7\x5a\x07\x28\xec\x3e\xf9\x79\xce\x75\xf9\x5d\x6b\xcf\x62\x9d\x63\x2f\xf7\x5b\x23\xb3\x31\xe2\x84\xa8\x93\x6d\x37\x00\xc4\x78\xcf\x1e\x29\x7a\xe0\x58\x02\x74\x3e\x97\x82\x39\x5c\x92\x64\x61\x22\x64\x78\xb8\xa4\x2b\xe2\xe2\x00\x46\x28\xae\x30\xd8\x55\xfb\xcb\x68\x01\xe3\xee\x68\x2c\x05\x88\xa4\xe1\x2c\x93\x3d\xb2\x04\x04\xcd\x56\xe8\x36\x74\xd4\x86\xaa\xb3\xb0\xa6\x13\x09\x53\x38\xdb\x1d\x0c\x61\xf2\x5d\x7f\x1c\x98\xa8\xa1\x3c\x06\xe6\xc3\xe8\x3a\x68\x76\xbc\xd5\xb7\xcd\xf2\x39\x0d\xd3\x24\xd2\x28\xad\x22\x91\x6c\x08\xd2\x3f\x03\xce\xee\x44\xda\x27\x45\xd8\xc1\xbb\x9c\x01\x5d\x42\x7e\xce\xea\xf5\xe3\xef\xf4\xc5\x25\x9c\xbf\x91\xca\xdb\x79\x57\x1d\xf3\x46\x4a\x12\xf0\x0e\x98\xcf\x11\xf5\x30\x4e\x99\xc6\x74\x45\x13\xa1\x06\x76\x1d\x85\x85\x6d\x40\x5e\x45\x3e\xb5\xd6\x29\x6f\xc3\x1f\xe0\xdc\xbe\xf0\xf6\xe5\xb8\xbd\x29\xef\xcf\x49\x7b\xa4\xe0\xed\xc1\xc0\x0f\xfa\xf3\xc1\x33\x98\xe0\xc1\x67\xd9\x87\x5a\x82\xe2\x6b\x84\xa2\x86\x10\x9c\x1b\xa7\xb2\x8a\xe3\x37\xa0\x74\xbd\x9e\xb8\x6d\xa5\x2d\x2c\xc4\x00\xee\x93\xef\x66\xbb\xdb\x06\x03\x0e\x30\xe8\xec\xb4\xed\x6c\x74\x18\x58\xcb\x73\x3f\x99\xae\x3b\xfe\x5a\x4b\x26\x91\xed\xa5\xce\x34\x72\xa9\xb3\x0e\xdd\x87\xa3\x69\x25\xca\xf7\x07\x93\x00\x9a\x57\x68\xf1\x4b\x9b\x64\xce\xd4\x69\x07\x7d\xb4\x9d\x34\x0f\x87\xa2\x06\x9f\x3e\x3d\x96\x4a\x81\xbb\x64\xcd\x70\x76\x7f\xab\xdb\x57\x7c\xdb\xa8\xa4\xd1\x27\xb6\x08\xaa\xd4\x91\x2a\xc7\xb6\xa3\xe9\x55\xfa\x4b\x34\x1f\x25\x9a\xab\xea\x13\x13\x7d\xbc\xa9\xb4\x3e\x63\x37\xa0\xcd\x70\x82\xc6\x64\xe3\x3d\x3c\x9d\xc8\xa8\xf3\xce\x83\xdc\x70\x70\x6d\x00\xa5\xd8\x54\x79\x2a\x76\x32\xf6\x56\xee\xa8\x50\x98\xaa\x70\xdb\x7e\x63\x67\xd3\xc9\xe7\xf1\x99\x56\xbe\x09\x5e\xd6\x56\x68\x9d\x95\x58\x25\xaf\x8a\xdf\xee\x36\x4f\x21\xae\x90\x52\x4a\xc6\x58\x18\xf7\x87\xd7\xf6\x95\x8a\xfe\xba\x74\x20\xfe\xc5\x2d\xd8\x12\x32\xb8\xc8\x5a\x61\x96\x3c\x66\x64\xbd\x96\x88\x99\xa5\x9b\x24\x82\x5f\x79\x9a\xdc\xcd\x28\x09\x97\x33\xf9\x89\xfc\x62\xc1\xf0\xa4\xee\x8e\x0a\x89\xc0\x59\xfa\x38\xa3\x5c\xb0\x15\x11\xb4\xd1\xed\x4a\x5e\xab\x23\x61\x5a\xe7\xaf\x90\x63\x9c\xbf\x7a\xd5\x3e\x02\x7b\xd5\x44\x0b\xe3\xb6\x7e\xe5\x6a\x2a\x0a\x59\x25\xc8\x73\xd4\x55\x50\xd6\xfa\xbe\x51\xf6\x27\xc1\x74\xf4\x0e\x32\x1a\xa6\x59\xd4\x00\xd7\xba\x6b\xd4\x9d\x6c\x99\x88\xa7\xf1\xe8\x87\x09\x9c\xbf\xb2\xa4\x20\xf9\xc8\x99\x3d\xa7\x2f\xcf\xac\xdd\xee\xbd\x74\x5a\x1e\xb1\x39\x75\x6b\x4d\x93\xbb\x7c\x73\x9c\x23\xb2\xc2\xe6\x6c\x92\x84\xf2\x7c\x4f\xf2\x1d\x01\xb3\x23\x9f\xb7\x09\xaa\xff\x96\x1b\xc6\x44\x92\x2d\xfe\xa3\x04\x69\x92\x6c\xad\x72\xf2\x74\xd0\x2e\xcf\xa0\xfd\x39\x90\xd6\xdd\xd9\x45\x94\x61\x5c\x1b\xd9\xb2\xe3\x57\xf5\x0d\x7c\xdc\xdc\xc5\x2c\x84\xfe\xc7\x01\x87\x03\xbf\xd9\x3b\xce\x91\x32\xa0\x64\x05\xcd\xd8\x7c\x86\xc2\x84\xd7\x5b\xd0\xbe\xc9\xac\xf6\xad\x65\x4e\xf5\x76\x9c\xe8\xf9\x1e\xa3\xbc\x61\x7e\xba\xbd\xef\x9c\xc5\x24\xa4\x94\xb5\xc9\x1d\x0b\xf1\xb4\xff\x67\xca\x4c\xdd\x05\x47\x9f\x8f\xba\x91\x2f\x1a\x01\xec\x41\xb2\xa4\x52\xaa\xc4\x3b\x2e\x2d\x75\x4f\x4b\xca\xe7\xdc\xd6\x4f\x83\x31\x4c\x4a\xf7\x71\xcf\x9f\xd5\x77\x6c\x0e\x4c\x7c\xe6\x59\xcb\x3e\xd3\x79\x87\xb3\x65\xcf\x89\xaf\x7a\xa8\x5d\x4f\x5b\x29\x86\x4c\x92\xf2\xe1\x98\xd3\x01\x8c\x79\xfd\x3c\x04\xda\xb1\xbc\xa2\xf9\x58\xe9\x74\xec\x80\xc8\x36\xb5\x73\xa8\x3a\x8a\x3b\x62\xd4\xe7\xf7\x46\x96\xf7\xb4\x56\xfc\xaf\xeb\xb1\x76\xb7\x7f\xf2\xf3\x5d\xda\x52\xa5\xde\xe3\xd9\xab\xe0\x50\x83\xe1\x14\x71\xe9\x4c\xfb\x2a\x50\xcb\xa6\x9f\x68\xb8\x31\x31\x14\xab\x34\xa3\x40\x3f\xad\x69\x82\xf9\x55\x46\x97\xca\x23\x3c\xe6\xb5\x3a\x77\x85\xc2\xf8\x05\x1c\x1c\x35\xb0\x39\xd0\x39\x57\xf7\xb5\x76\xaa\xfb\x08\x5e\x5c\xdd\x01\xc6\xce\x81\x33\xec\xec\x9b\x8c\x4e\x77\x34\x78\xff\x6c\x1e\x78\x44\xab\x3d\x4a\xef\xc9\x58\xaf\x19\xb0\x12\x06\x0d\x74\x00\xcf\x37\x31\xb0\x04\x42\xa2\x03\xff\xb8\x76\xd1\xa7\xb0\xc8\xd2\xcd\x5a\xe5\x1d\x61\x5a\xf6\x9c\x85\x47\xd1\x8f\x13\x53\xee\xe2\xd6\xe7\xd2\xcc\x97\x45\xf0\xf2\xa7\x07\xe0\x75\xc5\x47\x06\x9d\xeb\x10\xe8\x44\xb9\x5f\x07\xe3\x2a\x04\x72\xa4\xfd\x7b\xea\x18\xee\x33\x16\x69\x3c\xc9\x4d\x61\x58\x13\x86\x31\xa0\x49\xaa\x82\x25\xb5\xc4\xa7\x4e\x0a\x17\xb2\x2e\xc2\x0d\xd6\x60\xf6\xa5\x8a\x46\xdd\x70\xac\x03\x10\x71\x88\x58\x46\x43\x11\x7f\x2e\xbb\x65\x91\x77\x80\xb8\xc3\x3b\xb0\x9b\xdb\x2a\xaf\xa4\x3e\x8d\x46\xb8\xd0\x07\x9a\x08\x1b\x2f\xa2\xc2\x7c\xef\xa8\x9c\xfe\x86\xd3\x08\x36\xe6\xac\x5a\xaa\xe2\x2a\x3b\x9f\xc5\xdb\x2a\x3c\xdc\x67\x8b\x7f\xae\x25\x7e\x32\x33\x2c\xb9\x55\x5c\x98\x7d\x11\xae\xb6\xdf\x8a\x47\xcd\xd1\x8d\x7e\xce\x0f\x16\x08\x37\x1e\x66\xb5\x39\x12\x6d\xd1\xe2\x6c\x74\xbb\xaf\x38\x64\x74\x9d\x51\x2e\xf7\xf0\x9e\x6e\x75\x31\x03\x53\x79\x81\x53\x01\xad\x47\x0a\x51\x2a\x45\xe4\x86\x53\x74\xcd\x36\xba\x5d\xce\xe4\x5e\xb3\x44\xa8\x7e\xad\x3e\x6a\x33\x03\x45\xdb\xc6\x15\x31\xfb\x8a\x66\xa6\x24\x03\x91\x9f\xdb\x54\x60\xd5\x9b\xae\x01\xc1\xb8\xa2\x0b\xc4\x9e\x34\x71\xc3\x24\xc2\x98\x61\xc9\x84\x24\x52\x69\x9d\xe1\x12\x53\x95\x75\xd1\x86\x31\x25\x91\xad\x74\x10\x92\xc4\x06\x93\xd3\xdf\x1c\x92\xcb\x54\xe5\x09\x1d\x4f\x6d\x61\xa1\x02\x34\x93\x08\xe8\x6f\x1b\x12\x33\xf1\xb9\xf4\x86\x70\xb1\x4e\x8c\xbc\xc8\x4b\x5d\xb6\x50\x4e\x63\x3f\x0c\xa6\xdf\x01\x8b\x3e\xcd\x1e\x48\x2c\x1f\xb7\x76\xb9\xfc\xbb\x5d\x9d\xb0\x49\xe2\x58\x07\xce\x9b\xa4\x0d\x91\x1a\x23\x42\xaa\xe1\x92\x52\xec\x81\x71\xb1\x0b\x8c\x2a\x41\xb9\x24\x39\x8e\x52\x8f\xb6\x7a\xd3\x51\xd2\x41\x4b\x72\x2f\x77\xef\x54\xd6\x29\x6f\x7b\x5d\x85\x29\x89\x29\x0f\x69\x4b\x32\xf2\x75\xca\x8b\x41\x42\x47\xc8\xef\x5f\x79\xf7\xed\x5b\x37\x6d\x8d\xa2\x0a\xd1\x96\x90\xe9\xd4\x0c\xda\x2b\x47\x3d\x1d\x46\x5b\xd8\xb7\x1c\x42\x39\x31\xdb\x92\xbc\xbd\x03\x14\xa8\xf3\xdb\xb4\x81\xfa\x23\xde\x04\xef\xa6\xf0\x1f\xa3\x41\xb5\x3f\x02\xe2\xc2\xfc\x24\x23\x68\xc5\x5a\xe8\xe1\x34\x94\x20\xec\x19\xf6\x65\xe6\xd4\x38\x7c\x90\xfa\xc3\x1c\x3b\x66\xf1\x49\x39\x9e\xbc\x4a\x92\x17\xf6\xc4\x63\xb7\xfe\x77\xce\x7a\x8a\x2d\xf2\x95\x74\xbb\x52\x2e\x22\xa2\xaa\xea\x2c\x77\x5b\xa5\xbe\xe4\x52\x25\xa2\x24\xd2\xc5\x81\xe6\x95\xea\x25\x8b\x6c\xde\x36\xd6\x49\x50\x15\x8a\xec\x42\x4d\xc1\x8d\xd8\xce\xa4\xed\xf0\x7d\xe8\x8f\xc7\xfd\x9f\x8a\xf4\x95\x23\x94\x26\x42\xb9\x03\x1d\x78\xd5\xae\x3f\x52\x33\x7c\x57\x1f\x73\x54\x41\x13\xe0\xbc\x3a\x03\xb4\x65\x62\x58\xc9\x27\x39\x60\x5b\xe1\x9b\x1e\xda\xdf\xf6\x36\x2c\x6a\xd0\xc0\xb0\x0b\x89\x4d\x66\xd6\x2c\xfa\x24\x0d\x04\xd5\x45\xfb\xe2\xa2\x86\xf3\xec\x10\x59\x4e\xf1\x80\x53\x58\x1f\xf2\xbd\xc1\x04\x9a\x2a\x9f\x45\x48\xa9\x64\x79\x2d\x71\x63\x60\xaa\x8a\x01\x9c\x3a\x62\xf9\x3c\xe6\x29\x18\xb9\x4b\x08\x2a\xf6\xca\x92\x1a\x47\xa1\xfc\xf3\x2f\xe6\x11\xd2\xab\x79\xf8\x3f\x8c\x5f\x2d\xe0\x70\xc6\x5f\xbb\x07\xbe\xad\x79\xff\xf0\x8c\xf2\x40\x75\x8e\x83\xd4\x4a\x04\xf4\x62\xcb\x7f\xb5\x3c\x97\xb5\x44\x88\x76\x07\x6e\x87\xc3\x60\x32\x6d\xb9\x18\xd1\x6e\xcb\x4d\xbd\x7f\x28\x1d\x97\x95\xc9\xf9\x78\xd1\xa1\x66\x5c\x90\x1d\x76\xfa\xff\x08\xc2\xe3\xa0\x7d\xdd\x2b\x52\xd4\x3a\xeb\x65\x8a\xe5\xf8\x4e\xc3\xff\x61\xf9\x5f\x88\xe5\xe7\x26\x8a\xe4\x86\x86\x01\x16\x24\x80\x93\xd4\xd5\xd1\x56\x49\x3a\x47\xd3\xa3\xa3\xf2\x2a\xcd\x23\xc3\x47\x9f\x45\x56\x28\x1e\x5e\x98\x6a\xd5\x51\xbe\xce\x67\xe5\x92\x41\x76\x94\x85\x24\x67\xa9\x27\xe7\x38\x87\x34\x68\xbb\xdd\xbc\x88\xa1\x0d\xeb\xbe\x53\x76\x08\xc7\xdc\x51\xae\x1b\xb0\x05\xd6\x10\xb4\x45\x15\xc1\xaf\x5b\x63\x75\xa3\x3b\xaa\x83\x07\x7f\xd7\x4e\x04\x87\x1b\x1f\xe5\x43\xe2\x33\x96\xcc\xd3\xd6\x60\x38\xba\x9d\x9a\x23\xdb\xc1\x70\x2a\x01\xa0\x8f\x58\x73\x51\xa6\x4f\x59\x73\x31\x56\xeb\x3d\xc2\x65\xcf\xc8\x62\x81\xfc\xb6\xdd\xf1\x1e\x48\x16\xed\x3f\x71\x18\x92\x43\x53\xe5\xd3\x47\xde\xb6\xae\x15\xdd\x66\x30\x1c\x06\xe3\x5d\xfc\x51\x33\x44\xcc\xda\x30\xdf\xb6\x0f\x74\x13\xed\xc0\xfb\x0a\x00\x4e\xcb\x78\x9d\xe4\x88\x9b\x0b\x54\x4c\x20\xcc\xa8\xf6\x29\xf2\x0b\x0c\x0e\x97\x5b\x88\xc8\x64\xfe\xb0\x48\x45\x12\x55\x14\x51\x3e\x54\x08\x76\x08\xb2\xd7\xcc\xef\x94\x6a\xba\xd8\x95\x14\x00\xca\xbd\xa1\x48\x65\x77\x99\x8b\x53\x70\x47\x53\x3b\xb6\x71\xbd\x3d\xa5\x95\x68\x4c\x78\xa2\x3d\x2c\x2e\xac\x66\x45\x25\x8e\x65\x4b\x94\x60\x4e\xb4\x2a\xe1\x59\xdc\xd0\xa7\xd8\xc3\x43\xe7\x57\x95\x4a\x6b\xb8\x54\xee\xcf\x50\xac\x49\x87\x66\x9b\x3c\x74\x74\x45\xbb\xec\xea\x40\x9c\xc0\x2e\xf7\x60\x42\xae\xea\xaa\x09\xd4\x72\x0c\x65\x45\xa7\x77\xbf\xd2\x50\xb4\x72\x54\x28\x31\x85\xfd\x48\xf9\x54\x98\x71\xd8\xf2\xf6\xa0\x05\x81\xff\x98\x8c\x86\xdf\x82\x5a\xd8\xc1\xbb\xae\xc6\x3e\x75\xaf\x5d\x4b\x42\x1d\x26\x93\xbc\xc0\xd4\x71\xd2\xa1\x55\x2c\x4c\x75\x8c\xed\x52\x0c\xb2\xca\xed\xf0\x5d\xa7\x0a\x6a\xc4\xdc\xaf\xab\x2a\x13\xe6\xf3\x7f\x4a\xde\x5d\xb1\x3c\xb9\xa1\x73\x2a\xc2\x25\xe5\xfe\x6e\x9a\x6a\x04\x0a\xa2\x9a\x86\x58\x74\x24\x37\x2e\x8f\x58\xb5\x9b\xd7\xaa\x72\x2d\xda\x71\xba\x42\x24\x06\x08\xaa\x58\x72\xbf\xb0\x6c\xf9\xd8\xff\xf8\x0c\xcb\xa2\xbe\xea\x55\x3a\xea\x14\x1f\x3b\xe7\xfb\x98\x4a\xe4\xef\xb0\x46\x83\x3a\xd1\x90\x6b\xe5\xb7\xd3\x52\xd9\xb3\xea\x44\x4c\xcc\xfb\xca\x9b\xaa\x1a\x4d\x79\xca\x85\xff\x36\x4f\xce\x2c\x66\x61\xda\x36\xcd\xb6\x93\x7a\xe9\x47\xcd\x83\x5b\x42\xaa\x22\x88\xd7\xab\x20\x35\x70\x73\xc5\x31\x57\x4e\x33\x20\x8b\xd9\x4a\xb2\x9d\x9d\x77\xe0\xec\x75\x07\xce\xbe\xc9\x17\x5f\x1f\xe4\x08\x5e\xa0\xa3\xd6\x74\xce\xce\x3a\x65\xe8\x3b\xe9\x0a\x39\x79\xc8\x47\xb7\x13\xf9\xa9\x07\x97\xf2\x3c\xd5\x7e\x94\x22\x11\x73\x48\x6a\x17\x7d\xb2\x89\x63\xdb\xaa\xae\xda\x96\x0d\xdd\xf0\xed\xe1\x4a\xa8\xd9\x26\x3a\x0f\x4c\x11\xd9\x25\x9c\x9d\x9f\xbc\xd4\x13\x16\xf4\xdc\xc9\x82\x9a\xa4\x30\x28\xc6\x4b\x28\xad\x67\xe7\xae\x85\x31\xc5\x4a\x34\x2a\x66\x38\x69\xea\x62\x34\xa6\xbe\x0a\x16\x4c\x21\x20\x19\x86\x72\xf8\x3c\xd0\x8c\xeb\xba\xe6\x58\x6f\x05\xab\xab\x34\xe3\x18\x36\x9c\x1a\xeb\x83\xfe\x66\xcf\x39\x60\x45\x24\x83\xcb\x7c\xff\x10\x9e\x74\x58\xbe\xc6\x21\x66\xf7\xea\xfc\xa5\x07\xdf\xa9\xa2\xd0\x1d\xdd\x57\xa6\x12\x5d\x4c\x36\x19\x9e\xa9\x84\x84\x9b\x3b\x44\x74\xb1\x58\x9a\x77\x66\x0f\x2c\x4b\xb6\x0a\x76\x48\x04\x3c\x52\x5d\xd2\xda\x1c\x93\x73\x8a\x71\x4d\x8f\x79\x55\x19\x7d\x8c\xd4\x01\x96\xd8\x22\x1b\x9c\x02\x91\x7d\x94\x41\xa1\x7a\xc3\x53\x53\x73\x18\x3f\xdf\x88\x4d\x75\x0d\x99\x03\x8d\x45\x8b\x4a\x4a\x2d\x28\x1e\xe3\xe8\xd2\x8d\x4a\x00\xe6\xec\xab\xcc\xb9\xa0\x18\x22\x25\x1f\x79\x3c\xd7\x49\x28\xeb\x76\x27\x94\x42\xcd\x44\x54\x3c\xc6\xc3\x2c\x17\x51\x49\x8a\x47\x7d\x77\xe9\x46\x98\xbc\xb3\x86\x53\x14\x41\x24\xaa\x2c\x82\x48\x9c\xc2\x08\x27\xe5\x52\x21\x08\x3c\xdf\x7f\x5b\x76\xdb\x28\x64\x50\x15\xcb\x47\x34\x0e\xae\xd1\xcb\x12\x53\xa3\x57\x25\x2b\xe5\xf5\x79\x8b\x7c\xe8\xb7\x0d\xcd\xb6\x8e\xbf\xf4\x6a\x1a\x54\xf9\x4a\x0f\xf7\x04\x9c\x9d\xb7\xcb\x5e\xa2\x8a\xa3\xe8\x52\x41\x41\xc2\xa1\xa4\xbf\x58\x06\x67\x5c\x3c\x2f\x55\x1f\xa1\xd0\x6c\xad\xea\xf8\x79\x37\x57\x79\xf1\xfa\xec\xa6\x03\x2f\xce\xe5\x7f\x2b\x7a\xf5\x8f\x9f\x25\x4f\x55\x10\x72\x77\xc2\x11\x08\xd8\xdc\x61\xa4\x8d\x12\xab\xf5\x6a\xf2\x39\x4f\x91\x75\xee\x64\x9b\x47\xfb\x8e\x72\x1a\x73\x0e\x0b\x32\x47\xbb\xcd\x99\x0a\x32\x0e\x53\xcd\x4d\xb1\x34\x9e\x6b\xdc\xda\xe6\x3e\xc8\xd4\x3a\x6c\x2a\x4f\x77\x8a\x50\x4d\xbf\x27\x1f\x28\x94\xc2\x2e\xf3\x0c\xef\xc3\x34\xac\x7a\xde\x63\x98\xef\x8a\xdc\xd3\x3c\x4b\xb1\x94\xdc\xab\x43\x9f\x9e\x85\xd1\xb8\x41\x92\x87\x72\x98\x6e\x17\xef\x2e\xb0\xf9\xc8\xba\x46\xe0\x9d\x2a\xac\x4e\x23\x73\xa9\x46\x1e\xc2\x65\x4b\x33\xab\xd4\xcc\x24\x82\xd5\x86\x0b\xe7\x0b\x53\xa9\xbd\x7c\x59\x43\x18\xa6\x59\x84\xaa\x79\xea\x5f\x9b\xb2\x87\x57\x41\x3d\x2b\x54\x99\x9a\x92\xa3\xe4\x95\xca\x15\x17\xfc\x30\xba\x56\xa1\x2e\x15\x34\xdd\xfe\x62\xec\xd1\x28\xb4\xff\x4d\xd9\xa4\x77\xea\x94\xd3\xa8\x4f\x9c\xbb\xd9\xe8\xb3\xa4\xf8\xec\xe5\x30\x87\xb9\xb8\x55\xcd\xcf\x8f\x24\x23\x2b\x2a\x50\x5d\x4c\xd8\x7a\x13\xab\xa2\x80\x56\x67\x6c\x1c\x97\x8b\x80\x37\x28\x78\x05\x90\x4b\x97\x28\x94\xf9\x1f\xd6\x7f\xa9\xbf\x1d\xe9\x21\x65\x51\x29\x57\x0d\xf2\x54\xc6\xc2\x14\x0e\x2d\x36\xad\xd2\x1d\x83\x1f\xaf\x82\x8f\xb8\x92\xa6\xae\x08\xc9\xa9\xbe\x23\x0a\x2b\x62\xe7\xd7\x4b\x3d\x32\xb1\x94\xfa\x96\xd3\x39\xd8\xce\x0b\x79\x91\xa0\x73\xa9\x85\xf3\x39\x5e\x6f\x40\x22\xe4\x21\x4f\x74\x97\x95\x1e\xe7\x88\x1b\xad\x5c\x03\x2f\xdf\x2b\xaf\x10\xb6\x53\x44\xb1\xa6\xe8\x76\xab\x94\xab\xff\xd9\x2c\xbf\xdd\xbe\xb8\xc8\xe8\x22\x8c\x09\x77\x8f\x90\x2b\x7a\xac\x2d\xc9\xed\x62\x51\xbb\x7d\x28\xe5\x1d\xea\x29\xe3\xf5\x15\xbe\xf7\x5e\xef\xe5\xde\xd6\x76\xd6\xa8\x71\x3a\x14\xae\x66\x73\xcf\x8a\x75\x5a\x65\xab\x78\xcf\x61\xa7\x70\x35\x58\x5d\xe5\x38\xd9\x57\x1b\xae\x47\xf6\xfe\x90\x60\x6a\x83\x24\xb1\x2a\xc0\x75\x70\xad\x8e\x67\x76\x56\x18\x3f\x8e\xb6\x8b\x93\x6b\x97\x04\x46\x45\x99\x63\x25\x24\xaa\xe1\x5c\xa8\x13\x91\xc9\xd9\x9e\x74\x9c\xb9\x6f\x3f\xf3\x0d\x94\x5a\x29\xd7\xe1\xbe\x8a\x25\xd8\x5d\x9e\x7b\x15\x5a\x38\xb4\x50\x39\x92\xb4\x2d\xb5\x8a\x84\x3e\xb6\x2d\xc3\xc0\x9b\xb2\xd6\x31\x0b\x99\x80\xf4\x81\x66\x19\x8b\x68\xf3\x38\xcc\x33\xd7\x45\xf8\x13\x2d\x73\xd2\xa3\x50\x31\xaf\x76\xaa\xaa\x99\xec\xa1\x57\xb7\x02\x86\xb1\xdf\xef\x28\xa8\x5a\x97\x29\xb2\xcd\xaf\x95\xfa\xf4\x35\x42\x46\xdd\x8d\x21\x2d\xbc\x05\xe5\xe6\xca\x22\x27\xf2\x0b\x6f\x0a\x51\xea\xd6\x66\x1d\x11\x41\x25\x57\xc3\x84\x46\x54\x33\xfd\x77\xbd\x1d\x78\xb9\x8f\xcf\xd4\x02\xb0\x57\x91\x53\xbe\xf7\x8a\x9d\x6a\xa4\x81\xcb\x3c\x77\x29\xf7\x68\x0f\x86\x56\x23\x61\x51\x2d\x8f\xdc\x95\x73\x76\xd8\xdc\xdb\xcf\x49\xb7\x95\x74\xb7\x33\x75\xe9\x10\xda\xab\xc6\x68\x85\xc5\x65\x02\x24\x95\xe4\x97\xa7\x56\x98\xb2\x9e\xe8\x17\x33\x34\xc6\xf5\xd5\x66\xb8\x5f\xed\x23\x28\x2e\xa3\x87\xd3\xdc\x3e\xd2\x3a\x1d\x9f\x4c\x1a\x9a\x7b\x40\xf2\x99\xd8\xf4\x6c\x38\x53\x77\xcc\xb3\xf3\x5e\x8c\xa7\x47\xac\x5d\x1b\xa7\x36\x4b\xf9\x19\xf0\x7e\x9f\x3a\xa6\x5e\xc2\x2a\x2c\x4e\x6e\x0a\xfc\xe8\xd5\x1c\x86\x4d\x15\xfb\x52\xba\xfc\xb6\x1e\xa1\xbc\x5b\x41\xfd\xb2\xd4\xa3\xfe\x4d\x30\xb9\x0a\x5a\xab\x5e\xb1\xbf\x52\x49\xc3\xdd\x37\xef\xee\x93\xca\x5e\x59\xd4\x27\xe1\x68\x3b\x60\xe1\xf3\xb4\x83\xcd\xc1\x03\x6e\x50\x7e\xe2\xcc\xa1\x43\xf6\xd5\xaf\x1e\x78\x6c\x28\x06\xdf\xb5\xa6\xe2\x83\xe7\x54\x39\x4b\xd7\x43\x77\xa0\xf8\xe8\x29\xd4\xce\x67\xd2\xec\x4a\xa0\xab\xd6\xed\x6c\x33\xd0\x00\xfd\xbb\x68\x77\x7b\x59\x83\xb2\x94\x8f\xdc\xfd\xff\x86\x5a\xde\x4e\xbe\x72\xa8\x9e\x57\x02\xf3\x65\x25\xf4\x9f\x51\xe1\xdb\xcd\x1e\x9f\x55\x2d\xab\xe4\x66\xd5\x8a\x59\x35\xed\x7c\x11\xd5\xec\x08\x59\x7a\xa2\x72\x56\x81\x04\x98\x06\xf7\xac\x6a\xd9\x73\x2a\x45\xd5\x62\xaa\xa8\x16\x1d\xb8\xa7\x4f\xaa\x18\x55\x5d\xdb\x7a\xd8\x7e\xfa\xf5\x3d\x43\x92\xd8\xbe\xf2\x4b\x7f\xe5\x9b\x8c\xf2\x4d\x2c\xfc\x67\x65\xfe\x27\x65\x99\x5b\xe9\x53\xef\x84\x25\x4f\x4c\x7f\x7d\xa9\xf2\x29\xd7\x8b\xd9\x3a\x4b\x43\x78\xc4\xd3\xf2\x75\x96\x9a\x62\x80\x66\x02\x4a\x09\x6d\x96\x6f\x5a\x73\x67\xe9\x17\x66\xf3\xe6\x5f\x55\x92\xf0\x5d\xff\x66\x12\x1c\x52\x40\x13\x4a\xa3\x96\x56\x5b\xab\xfd\x1d\xe3\xbb\xf3\xc7\xd7\x77\x68\xb5\xa0\x78\xf0\x80\xc3\x78\xae\x59\x7d\xaf\x46\x2f\xbf\xe7\x0f\x96\x50\x08\x1c\x59\xf6\x54\x49\x4d\x5b\x67\xd1\x71\x46\x36\xf3\xc6\xfd\xe1\x35\x2c\xdd\x33\xa8\xcb\x9a\xc5\xe6\x8d\x0d\x90\x69\xe4\x5c\x34\x88\x97\x43\x3a\x77\xb0\xe5\x20\x54\xd8\xa3\xe0\xae\x77\xc2\x3c\xaa\x3b\x20\x7d\x3a\xed\xb2\x8a\x38\x9e\x4e\xc1\xac\xea\xbd\xe2\x59\x5e\xd0\xe5\x48\x2a\xd4\xcd\xde\xf8\xae\xfb\xaa\x11\x2e\x77\x1a\x90\xfb\x2f\x76\xd6\x1b\x33\x1d\xdf\xee\xa0\x90\xbf\x0f\x29\x4b\xa4\xab\x5a\xf2\xee\x33\x89\x2b\x75\x26\x41\x13\xa4\x0e\xa3\x8e\x3a\xfd\x74\x60\x4e\x89\xd8\xe8\xf3\x81\x79\xba\x49\xaa\xce\x22\x3e\xc7\x02\x28\xa3\x60\xb3\x53\xb5\x92\x27\xf3\x3e\x9f\x72\x33\xb7\x7b\x96\x57\x31\xb7\x53\x9c\xcf\x79\x2f\x1e\xd1\x2b\x91\xfc\x59\x31\x11\x07\x11\xa0\x25\x36\xcf\x09\xed\x1c\xe8\x18\x04\xfa\xfb\x78\xa2\x0f\x90\xd6\xca\x5c\x39\x9a\x91\x94\x4b\x76\xd7\x8b\xf4\xfd\xe2\xbb\xdb\x65\x73\x20\xb1\x64\x8f\x5b\xb4\x5e\x44\x8a\xd7\x23\x67\x54\xa7\xf2\x74\x24\xe1\x2c\xf5\xc1\x7e\x94\x1a\xfa\x3d\x59\x51\x69\x6b\x43\x61\x3f\xad\xff\x63\xf0\x2a\x04\x90\x8b\x57\x8c\xc3\x8a\x71\x8e\x97\x50\x84\x1e\xfb\x61\x62\x27\x77\x3b\x6c\xd5\xcf\xc0\xe1\xfe\x19\xad\xdb\x2f\xa3\xa6\xed\xa6\xd8\x2a\xbb\xf8\x14\xe6\x5b\x1a\xb7\x96\xf2\x0f\xb1\xbe\x35\x94\xa6\x55\x9c\xb8\xe2\x98\x65\xb7\xce\xe7\x57\x0a\x76\x78\xf7\xde\x83\xe1\x63\x0e\x31\x6a\xb4\xb3\x0e\x94\x98\x38\xa9\x67\xe1\xcf\x65\x32\x1f\xbd\x7b\xe6\x2c\x71\x07\xe3\x06\x2f\x38\x03\x72\xbe\x0d\xfb\x75\x3c\x8f\x1d\xd4\x07\x9b\xa3\xa2\xee\xd4\xdf\xf7\x83\x10\x10\x41\xca\xa9\xb7\x8e\x75\x61\xf5\xfb\x72\x26\x6e\x55\xab\x19\xa7\x8b\x15\x4d\xc4\xdd\x56\x32\xd2\x3c\x8b\xe0\xc0\xaf\x31\x18\x4c\x7d\x2b\xdf\x6b\x25\xaa\xe9\x7d\xdc\x7e\x53\x13\xf6\xfe\xc6\x36\xb3\xb1\x1c\x8a\xb5\x63\x44\x77\x12\xe9\x40\x14\xcb\x4e\x92\xf4\xb1\xd5\xee\x9e\xc3\x32\xdd\x64\xaa\x76\xd4\x5d\xae\x1a\xd0\x3c\x3a\xdf\xd0\x32\x89\xa2\x99\x2f\x1a\xf8\x6c\x9d\xc6\x2c\xdc\xd6\xdf\xab\x5e\x9e\x65\xee\xfe\x81\xa6\x1a\xbb\x69\x6e\x27\x77\xab\xd3\x9b\x41\x33\xba\x4a\x1f\xe8\x13\x8c\xeb\x80\xc7\xf4\x1d\x51\xbf\xdb\x16\x6b\x2b\xc9\xc8\x97\xe9\xa3\x1e\xe9\xa8\x21\x80\xbd\xf9\x92\x28\xe9\xb1\xb1\x03\xd1\xc3\x08\xbb\xa7\xe3\x5e\xbb\x98\xc2\x41\xec\xab\xc6\x2b\x24\x3b\x90\xfc\xe9\xc4\xf3\xd6\x93\xfd\x40\x65\xc5\xf1\x60\xef\xce\x97\x91\xc6\xfb\xd6\x79\xac\x9f\x7a\x8f\xa4\x2c\x1c\x20\x1f\x24\x28\x9f\x48\xfc\x1f\x6b\xc5\xb7\xeb\x4c\xac\xcf\x46\xf4\xbd\xb8\x55\x7d\x2e\x7c\xb0\x90\xae\xf3\x81\x76\xbb\x51\x96\xae\x4d\xfc\x1e\x32\x27\xa3\xdb\x22\xa0\x54\xce\x70\x44\x63\xaa\x6b\xfd\x92\xf5\x3a\x4b\xd7\x19\x43\x25\x12\xc5\xcf\x31\x85\x7d\xe5\x60\xde\xc1\x37\xaf\x30\xc7\xd2\x38\xa2\xd9\x4c\x2c\x49\x02\xd3\xc1\x87\x60\x32\xed\x7f\xf8\x38\xfd\x4f\xbf\xea\x9f\x21\x28\xe4\x75\x7b\x89\x4a\xdf\x8b\x24\x1f\x63\xea\x22\xba\xf7\xdc\xce\xd5\x3b\x8c\x2f\x8c\xd8\x8a\x26\x08\x7a\x75\xff\x80\x7a\xe5\x66\x13\xb2\x44\xfc\xfc\xcb\x4e\xea\xdc\x49\x9c\xcf\xe0\xcc\x74\x20\xf6\x87\xb2\xf8\xcb\x67\x93\x2f\x3e\xf7\x00\x9d\x18\x96\x9a\x17\x98\x44\x58\x5a\xa8\x81\xae\x34\x59\x7e\x53\x74\xb4\x46\x3d\x27\x43\xcf\x05\x56\x69\x1b\x6c\xab\xc3\x7c\xb3\x4e\xa7\xb6\x20\x43\xe5\x47\xf9\xa4\x23\x2c\xcd\x10\xf5\x7c\x0f\xeb\x25\x2c\x7b\x3a\xd4\x1a\xe0\x40\x57\xef\x1e\x0f\xaf\xed\xcb\xd6\x81\x91\x60\x80\xfe\xe4\xca\xbe\xd1\x41\x00\xce\x0d\x34\xe6\x2a\x3f\x81\x69\x64\xce\x66\xb7\xec\x65\x49\xed\x2a\x93\xf1\x3e\x49\x1f\xf1\x3a\xfa\xbc\x23\xcc\x50\x81\x70\x23\xba\xe9\x7c\x6e\xd5\x37\x96\x2c\xb8\xd5\xd0\x24\x8d\xae\x1d\xf5\x4c\x6f\x57\x01\x2d\x04\xcd\x12\x12\xf7\x44\xaa\x9e\x0b\xb2\x5a\xb7\x32\x92\x2c\xe8\x8c\x26\x0e\xc8\x70\x5f\xf3\x19\x1f\xb0\x93\x2a\xd6\x25\x3c\x78\x13\x55\xfc\x4c\x98\x26\x5c\x64\x84\x25\x02\xc2\x10\x37\x33\x54\x75\x36\xc2\x50\xb7\x60\xde\xac\x0e\x44\x8c\x19\x8f\x59\x48\x21\xe2\x0a\x3f\xb8\xed\xb3\xd0\xc2\xeb\xbd\xdb\xb5\x80\x90\xda\x30\xfd\x14\xc6\x1b\xac\xf3\x8c\xe1\xd0\xaa\xde\x24\x7d\xa0\x99\xba\x42\x13\xfe\xec\xed\xa8\x4a\x26\x64\x1c\xf0\x42\x2e\xfb\x6d\x11\x09\x23\xde\xf3\x18\xd5\x65\x05\xf3\x92\xa8\x18\xf1\x5e\x3e\x99\x3f\x5f\xd6\xef\xe2\x26\x61\x9f\x66\x2b\x16\x66\xa9\xba\x5a\x8b\xb7\xf2\x59\x55\x54\x2f\xca\x3b\xbd\x0e\xaa\x70\x17\x2a\xae\xa1\x72\x96\x59\x79\x85\x92\x16\xab\xa8\x72\x56\x7a\x4e\xc2\x25\xc1\x0b\x7f\x49\x7e\x9f\x3f\x55\xd6\x85\xba\xb9\x06\x65\x9b\x14\x67\xeb\x54\x22\x02\x22\xb4\xba\xcf\x1f\x6b\x6e\x73\x01\x9c\xad\x58\x4c\x32\xdd\x9f\x4e\x9d\x11\x29\x3c\xca\xde\x18\x37\xb8\x8f\xd7\x9a\xaa\x42\xb2\x73\x16\x0b\x55\xf9\x8f\xc4\xb1\x49\xb6\xc1\xc1\xb1\xe7\x3b\x4a\x13\x8f\x62\xba\xdd\xbb\x8d\xb0\x35\x4a\x93\xa6\xba\x26\x4d\xfe\xa9\xfa\x53\xd3\x4d\x30\x27\x35\xf1\x33\x99\xb6\xde\x17\x2a\x63\x88\x53\x7d\xb3\x96\xaf\xed\xe3\xb3\x62\xfa\x0e\xe6\xd3\xac\x53\x3c\x13\x25\x71\xbc\x9d\xa1\xb4\xd5\x53\xf6\x72\x6c\x5c\x2e\x8c\x4e\xd8\x50\x14\x52\x99\x3d\x3a\x2d\xe6\x01\x79\x2d\xb4\xd6\x26\x59\xfd\x9f\xe1\xc5\xeb\xb3\x1b\xef\xad\xf2\xa0\x3d\xf7\xc0\x6f\x2f\x71\x64\xc4\x78\x33\x93\x6f\x9c\x99\xb4\x3b\x10\xa6\xc9\x9c\x65\x2b\x1a\x1d\x04\x95\x1d\x73\xaa\x01\x70\xc5\xd4\x86\xa3\xfc\x98\xb1\x64\x6f\x99\x91\xce\xcb\x6f\x70\x98\x72\xf2\x15\xe2\xc3\x2c\x4f\x6e\xf3\x7f\x9a\x2d\xe4\x4d\x7a\x6e\x19\x82\x9a\x49\x3b\x6d\x2c\xe8\x24\x2c\xbf\x29\xec\x22\xfe\x94\xe7\x52\xb1\x67\xb2\x5e\x4b\xda\xff\x83\xaa\x64\x1f\xb3\x7b\x1a\x6f\xf3\xb4\xed\x74\x45\x15\x6b\xe3\x82\x64\xca\xc5\x2f\x80\x92\x2c\x66\x78\x37\x0c\x5b\xd1\x72\xef\x96\xb3\xe0\x24\x5c\x79\x68\x7f\x4e\x72\x94\xfd\xb5\xdd\x3d\x56\x6a\x6a\x54\xb3\xb9\xd7\xc1\x4d\x20\x29\x48\xea\xb8\x35\xb9\x60\x8d\x22\x3c\xfd\x58\x99\x1c\x5a\x2a\x6d\xab\x0a\xa5\xdc\xea\x68\x6e\xe5\x84\x4e\xb1\xf4\x67\xa9\x32\x83\x2a\xfc\xa6\xff\xb8\x1e\x4c\xa6\x83\x61\xe1\xba\x19\xde\x06\xc2\x8b\x55\xa7\x34\xbe\xf8\x6b\x6f\xbb\x0c\xc2\x37\xd6\x5d\xfd\xba\xe3\x68\x84\x6d\x25\xab\xcb\xd5\x0b\x1c\x3f\x32\x26\xfe\x73\xba\x26\x99\x54\xff\xb1\x77\x75\x7a\x93\x4a\xf5\xe4\x6a\x1a\x38\x85\x92\xf3\xfa\x60\xff\xc2\x29\xfd\x17\xdd\x95\x93\xb1\x98\xa5\x8f\xdc\x4c\x1b\xc8\x5d\xfa\x80\xa5\xc7\xf4\x83\x9e\x6e\x3f\x4c\x05\xbd\x50\x89\xf7\x0f\x34\x33\x86\x88\x36\x6a\x50\xbf\xc2\x7c\x54\x33\xac\xa9\x06\xa0\x6e\x30\xcb\x95\x02\xee\xe6\x21\x65\x12\x59\xb1\x6e\x73\xca\x29\x56\x17\xc0\xfa\x26\xfa\x7e\xcc\x5e\x15\xbf\x2d\xa5\x2d\x16\xf6\x5e\xe7\x0b\xd6\xb1\x93\xd2\x96\xd9\x6d\xd3\xdb\x7b\x76\x9e\x6f\x2d\x6f\xe5\xb5\xc1\xca\x14\xfe\x64\x4c\xc5\xcb\x81\xd4\x98\xbd\x9b\xb9\x78\x8d\x74\x09\x19\xf8\xd7\x7f\x55\x88\xfb\xb3\xfa\xbb\x67\xe6\xfe\xcb\xd1\xf4\x6b\xff\xa5\x09\x75\x77\x99\x75\xa8\xa1\xd1\x97\x95\xb4\x69\xc8\x47\x72\xb9\x7b\xba\x85\xff\xe5\xde\x84\xf9\xa6\x9e\x58\xda\x75\x35\x3d\x4e\x37\x9c\xac\x73\x50\xce\x4b\xdb\xc0\xb9\xb9\x70\xf9\xd6\xa7\x55\xc7\xd4\xb8\x7c\xeb\x9b\x1a\x2e\x21\x5f\xbe\xf5\x88\x9a\xf0\x90\x44\x74\x26\xd2\xd9\x8a\x08\x9a\x31\x12\xb3\xdf\x31\x61\x92\x5f\xbe\xc5\xb8\x1c\xe5\xc8\xf0\x1c\xa2\x25\x7f\x62\xd5\x3e\x18\x1f\xa2\x2f\xf4\x6f\x4a\x1e\x41\x77\x6e\x7a\xac\xfc\x98\xff\x04\xf7\x49\xa3\xdb\x1d\x99\x0a\xcc\x2a\x1d\x51\x55\x47\xe7\xca\xe4\x5b\x91\x0c\xcb\x11\x2d\xd8\x03\xe5\xb0\xe1\xaa\x90\xa1\x2a\x71\xc8\xb0\xe2\x94\x20\x42\x5d\xc2\x89\xee\x0c\x36\x9f\xd3\x8c\x26\xa2\xd1\xed\xda\xfc\x68\xac\xf1\x60\xdf\xe4\x5f\xf0\x93\xe2\xc4\xb8\x5c\xba\x98\x49\x1e\x38\xcb\x77\xba\xe5\x54\xac\xc2\x2b\xca\xaa\xfd\x65\x2a\x4e\x25\xf7\x2f\xc0\x67\x27\x91\xba\x3f\x75\xe3\x43\x46\x49\x0c\x7c\x99\x66\x22\xdc\x08\x25\xba\x17\x52\x0f\x4d\x37\x02\xd8\x5c\x31\x5a\x53\xe5\x51\x2a\xc4\xe6\xe2\xff\xb8\x54\xe5\x15\x7f\x7a\x4f\xff\x7a\x1b\x8c\x7f\xaa\x6c\x60\x52\xf1\x7b\x2f\x2b\x5f\xef\x0d\xed\x2f\xfe\x5c\x4b\x24\x4a\x57\x2d\xc7\xfd\x5e\x9e\x97\xe3\x35\x77\x2d\x8a\xda\x89\x57\x4c\xf6\xa0\x09\x2a\xb2\xa8\x64\xcf\x86\x33\x97\x0b\xb5\xba\x87\x01\xb9\xff\xe1\xf2\xad\x39\x18\x78\x31\x50\xe7\x01\x05\xe2\x5f\xf5\xdc\x43\x8f\xca\xc5\xe3\xcf\x65\x12\xc3\xd1\x0f\xad\x36\x74\x8f\xca\xfb\xf0\xc3\x79\x0b\x56\xae\x24\x44\x45\x66\x68\xae\xba\xd7\x6d\x08\x92\x3d\x10\xef\x46\xd9\x8a\x2d\xdb\x1d\x92\x76\x52\x10\x5a\x1d\xe5\x1d\x12\x82\xf6\x71\x3c\xba\x0a\xae\x6f\xc7\x25\x47\xb6\xbe\xbd\x69\x86\xfa\xb5\x03\x23\x75\x4a\xd4\xae\x88\x2c\xcb\x60\x1c\x5c\x8d\xc6\xd7\x7e\x90\x47\x94\x62\x41\xc6\x38\x4d\xd7\x9a\x81\xdd\xb3\xb5\x29\xea\x60\xad\x42\xd9\x04\x4d\xc6\x3b\x55\x5a\xbe\x1e\xac\xef\x46\x63\xc8\xc0\x61\x13\x46\x0a\xee\xc4\xdb\x03\xd8\x14\x60\x39\x08\x5d\x96\x0c\xf2\x1b\xc7\xf5\x3c\x78\x7e\xe1\xb7\x70\x25\x99\x54\xab\x90\xf7\xfa\xce\x2e\x23\xf3\x4e\x24\xa8\x95\x72\xbb\x64\xae\xc3\x50\xae\x7c\x38\x82\xbf\x04\x3f\xd9\xb3\x87\xbf\x0c\x3e\x62\x09\x8b\xe0\xda\xa1\xef\xab\xd1\x70\x3a\x18\xde\x06\xaa\xfe\x96\xbd\x52\xd8\x69\x51\x73\xb7\x6f\x85\xab\x3a\xf3\x2b\x7c\x9f\x40\x4d\x59\x31\x38\x3e\x9f\xe6\x87\x0f\x83\x69\xee\xf5\x50\xd5\xc0\xbe\xf0\x1e\xff\x03\x43\x22\x90\x5b\x76\x76\x06\x45\x4e\xe1\x9d\xac\x9c\x4c\xbe\x83\x09\x34\xe5\x2a\xb9\xe4\x96\x2a\x9a\xc6\x2b\xa4\x62\xeb\xb7\x38\x11\xe7\xf8\x6d\x4f\xd5\x44\x5e\x67\x69\x48\xa3\x8d\x2d\x92\x26\x0d\xa5\x6c\x93\x40\x46\x17\x9b\x98\x64\xf1\x56\x69\x21\x61\xa6\x0a\x1c\x34\xdd\x1b\xbe\xf2\x6f\x95\x81\xa5\xef\x62\xbe\xdb\x62\xf3\x46\xb7\x9b\xa1\xd7\x37\x49\x1f\xe1\xd7\x0d\xd7\x37\x2b\x16\x26\x73\xb7\x11\xca\x18\x32\x57\xec\xb0\xd0\xd6\x5e\xe8\x76\x1f\x29\x5e\x36\x43\xa2\x08\xb8\xd8\xcc\xe7\x10\x4b\xa5\xd0\x46\xdb\x49\xc4\x90\xeb\x5c\xd3\x74\xad\xe2\x8b\xd0\x14\x42\x73\x8a\x65\x6a\xd2\x3c\xcc\xd8\xba\x52\x15\x2a\xc1\x1c\x8f\xb3\x0c\xc0\x57\xd2\xf8\xa2\x09\x49\x42\x6a\xb9\x64\xce\x0f\xaf\xfa\x37\x37\x47\x6e\xd5\xa9\x98\xb0\x63\x56\x72\xf3\x03\xf5\x06\x9c\x37\x20\x08\xbf\xd7\x85\xe7\xd0\xc1\x27\x61\x54\x46\x8d\xa7\xc3\x88\x03\x4f\xa1\x19\x9f\x49\x25\x4f\xae\x22\xbb\xa7\x59\x4b\xc5\xa8\x46\xe9\x46\x2a\xc2\xeb\x8c\x86\x8c\xe3\x8d\xec\xbb\x53\x4a\x34\x0f\x99\xc7\x29\x11\xff\xce\x69\x12\xb5\x74\x38\xed\x25\x34\xff\xdf\xa7\x7f\x9b\xcf\x5f\x39\xbf\xd7\xcd\xca\xdc\x8e\x9a\x5b\x74\xf7\x1f\x76\x16\x97\x50\x9e\xbc\x57\x67\x2b\xdb\x50\x73\x23\xa2\x5a\x2c\xe3\x40\xe0\x63\x86\x6e\x26\x2a\xb5\x7d\xd9\x19\xa8\xce\x0e\x2e\x81\xba\x77\x12\x27\xc7\xac\x33\x3e\x4b\xa4\xee\x16\xcf\x12\x92\x3c\xd7\xfe\xfc\xbb\xb3\x3f\xe7\x4f\xbf\x3f\xce\x02\x4e\xda\x9d\x21\x19\x1e\xb3\x13\xbb\x86\x3b\x79\x1f\xbc\x22\x31\xf6\x36\x1e\xac\x3b\x06\xee\x85\xc2\xc1\x8f\x3b\xee\x1e\xc4\x35\x35\xea\xa4\x6c\xdd\xb5\x6f\x5e\x89\xf7\x27\xaa\x12\xac\x6b\x7e\x94\x8b\xd0\xa9\xba\x61\x0a\xf8\xe8\xdd\x24\xb6\xc2\xfb\xc1\x7b\x60\x3a\x3f\x05\xd8\xae\xb8\xcf\xaf\xe8\x08\xd3\x78\xb3\x4a\x94\x8f\x65\x9e\x66\xb3\x07\x46\x1f\xf3\x2b\x48\xf4\xcd\x7e\x2c\xca\x83\x77\x50\x6b\xd6\xcb\x1a\xf6\x3f\x04\x95\xc1\x32\x8c\xcf\x32\xca\x69\xf6\x20\x45\xa4\x09\xb1\x36\x12\xc5\xf3\xb6\xa9\xeb\x38\xfa\xc3\x9f\x5a\xca\x3f\x85\x01\x6f\xd2\x8c\x52\x21\x6f\x1d\x2f\x7a\x0e\x9a\xba\x3e\xdf\x2f\x72\x1e\xee\x61\xb4\x33\x20\xea\x63\x83\x77\xde\x1c\xac\x95\x9d\x0f\x7a\x71\xa9\x7b\x9b\x35\xe1\x6f\x7f\xcb\x5f\x48\x95\xc6\x31\x3f\x65\x47\xce\xf7\xda\x0e\x6d\x1d\x50\xf7\xec\x9e\x6e\x73\x40\xb6\xdb\x3d\x16\xb9\xc0\xc6\x61\x8c\x63\xe7\x33\x7a\x55\xc5\xcc\x8b\x1d\x1f\x15\xdd\x75\x94\xf5\xb6\x07\x73\x14\xbe\xd8\xc0\xce\xcf\xa8\x7e\xe8\x17\x25\xc3\xce\x2d\xdd\xba\xc1\x28\xe5\xab\x26\x77\x46\x71\x39\xd5\xd6\xe4\x0a\xb8\x8e\xe1\x92\x2c\x84\xd1\x47\x37\xac\xab\x58\xd3\xba\x1c\x4a\x62\xe7\xd3\xec\x20\x0e\x71\x91\xb1\x64\x81\x17\x54\x78\x86\xbe\xa9\x13\xdd\x2c\x92\xb2\x3e\xde\x51\x48\xfd\xf3\x0b\xfe\x0b\x5e\xed\xf3\x62\xd0\xec\xac\x53\x7e\x71\x81\x85\x80\x8f\xdf\x03\x4c\xbb\xc1\xe3\x59\xc7\x0f\xd0\x01\x49\x3e\xb9\x71\xbf\x4e\x79\x39\xa0\xbf\x08\x9c\xdd\x0c\x75\xc7\x35\xae\x15\xf7\xf6\x94\xf7\xd3\x6b\x20\xad\xea\x0a\x97\xaf\x2b\x65\x5d\x57\x4a\x07\xad\xcd\xd2\x02\xec\x1e\x3a\x35\xf8\x6a\x17\x51\x51\x93\xa2\x78\x05\xf8\xee\x49\x17\x4a\x5c\x4b\xa3\xac\x3f\x75\x43\x3c\xcb\xd8\xfe\xfd\x20\xf8\xc1\xcc\xc3\xf5\xec\xf7\x27\x05\xbb\xd1\x43\x20\x0c\x3d\xc9\xcf\xb5\x7c\x37\x52\xe1\xc0\x4a\xfe\x5e\xbc\x3e\xe3\x9e\xd9\xe9\xfb\xb8\x6a\x4e\x17\xec\x10\xca\xd1\x7e\xd6\x71\xc1\x59\x44\x8d\xd3\x63\xec\x4e\xab\x8a\x9d\xb3\x97\xa7\xe0\x2a\x7a\x13\xbf\x00\x57\x29\x45\x8b\x3e\x03\x5b\x29\xb1\x91\x27\xe3\x22\x72\x5f\xff\x01\x99\x88\xb3\x7d\xcf\xc0\x44\x2a\x2b\x79\x3e\x01\x17\xa9\x99\xf5\x67\x72\x91\x0f\x81\x9c\xf5\x21\x5c\x44\x5a\xc5\x3d\x3c\x0c\x22\x1c\x0f\x85\x3a\xe5\xd7\x4a\x3d\x25\x5c\x67\xb3\x95\x1b\x38\xa1\x08\xb5\x1c\xc9\xc3\xc7\xd3\x18\x93\xe5\x48\x72\x50\xaf\x51\xe9\xda\xb9\x7a\x3e\x86\xd1\x60\x7a\x32\xa8\xea\xfb\x2b\x68\x5b\x3e\xe7\xee\xf8\xdf\x8f\xd1\xb9\x4c\xa9\x86\xd1\x35\xba\xfb\x7e\xf0\x3d\xa3\x8f\x1c\xf6\x35\x3b\x28\x9c\xde\x47\x71\x35\xb1\xdc\x22\x46\xeb\xa8\xa5\xef\xfb\xa8\xb8\x41\xbe\x50\xaf\xbe\x5c\xe9\x07\x6c\xa9\xac\x1d\xe7\x22\x85\x42\x66\xf6\x8b\xaa\x32\xd7\x9c\xfd\x6e\x06\x77\x63\xb3\x33\x22\x58\x0a\xc9\x66\x45\x33\x16\xee\x18\x4a\xa4\x82\xc4\xc6\x43\xae\xea\xee\x77\x9c\x44\x18\xff\x4d\xbb\xb2\x86\x2c\x3c\xe9\x11\xe0\x81\xa7\x75\xb5\x2b\x5a\x55\x5c\x5c\xe9\xbe\x75\x9d\xc3\x3b\x9a\x39\xd4\x51\xdb\xea\xe4\xa3\x2a\xc9\x6b\x4a\x55\xc7\x6a\x87\x19\xde\xde\xdc\xa0\x44\x53\xd8\x40\x78\x01\x3f\xea\xbf\x2c\xdc\xd7\x58\xb9\x06\x7b\xa9\xd8\xce\x66\x47\x8a\xb2\xba\x5f\x9d\x88\xf3\xc0\xb3\xb3\x87\xfc\x8e\x4a\xba\x6d\xbb\x25\xa5\x6b\xbf\x5a\x2f\x66\x92\x46\x66\xeb\x8c\x0a\xb1\x6d\xad\x17\x33\x85\xf3\x19\x55\x85\x9a\xf1\xed\x8e\x54\x27\xf7\x2c\x33\xaf\xb0\xdb\xc6\x4d\x94\x9f\xd6\x8f\xfc\xaa\xf7\x0a\x77\xab\x48\x96\xfb\xb6\x5a\x11\x1b\x0a\x2c\x87\x3a\x0f\xff\xaa\x44\xbe\x47\x1f\x6b\x3f\xfd\x81\xb5\xd7\x61\x99\x46\x77\xd0\x65\x3d\x2d\x3e\x23\xfd\x95\x89\x66\x5f\xa8\xb9\xad\x92\x68\xab\x3d\xc6\x34\x59\x88\x65\xbb\xd4\x53\x7d\x7c\xb9\x13\xf4\x5f\xfa\x4a\x07\x51\x56\xa6\x01\x94\x1a\xd7\x47\xf0\x9b\x5f\x65\xc0\xd3\x3e\xde\x52\xc3\x4f\x76\xf0\x90\xcf\xe0\x1b\xa7\xf1\x8a\xc3\xf8\xc3\x92\xf5\x14\x69\xa1\xf4\xac\x24\xe4\xd6\x79\xef\x15\x74\xa1\x65\xd8\xc7\xdd\x56\x50\xde\x0a\x97\xdc\xad\x7a\xa3\x3a\xc1\x57\xed\x8b\x0b\x2d\x6d\xe1\x6b\x28\x7f\xb4\x49\xf6\x7d\xd6\x6e\xc3\x4b\x38\x7f\x75\x08\xcb\x90\x1d\xd6\xb3\x06\xf9\x36\xd9\xac\xee\x68\x36\x2b\x0b\xf2\x9d\xec\xe1\xa0\x73\xef\x5c\x17\xf5\x49\x41\x71\x50\x96\x26\x5e\xc2\x0b\xab\xb8\xf3\x58\xc1\x57\xee\x01\xa2\xb1\x8a\x23\xab\xce\x56\x61\x85\x74\x95\x5e\xf1\xaa\xab\xc3\x66\x54\x5d\xa8\x88\x0b\x22\x24\xc2\xf3\xda\x39\x4a\x50\x3a\x1f\xe8\x59\x1c\x13\xfd\xe2\x48\x8c\x8a\xf9\xee\xaf\xe5\x54\x0b\xbd\xa3\x33\x7d\x7a\x5e\x3e\xec\x2e\x6d\xfe\xe8\x08\x17\x4f\x57\x3e\xc4\x4d\xef\xda\x72\x83\xe1\xbb\x91\xc1\x32\x65\xcb\xb9\x12\xe3\xe5\x1e\x1b\x54\x0f\x7a\xd8\x28\xea\xe8\x01\x07\x71\xc6\x88\xef\xf1\xc2\x72\xf3\xef\x92\x73\xd9\xbe\xf1\x9d\xd9\xea\xb1\x17\x19\xad\x0c\xc9\x5a\x9e\x07\xb1\x61\x6a\xfa\x42\x6e\x35\x72\xce\xb3\xbc\x0b\xc7\xfb\x13\xb0\xf7\x63\xec\x64\xa3\x10\xdf\x2b\x2b\x09\x43\x9b\xf5\x99\x2e\x5e\x93\x9c\xc4\x5b\x95\x41\x85\xe7\xbb\x2c\xe1\x2c\x52\x09\x27\x22\x23\x09\x27\x78\x3d\x45\x0f\x06\xa2\xc9\x81\xad\xd6\x69\x26\x30\x39\x4b\xbe\xff\x94\x00\x4d\x22\xae\x93\x57\x42\x12\xc7\x2a\x41\xd3\xb9\x64\x59\x0e\x98\x42\x46\x63\x4a\xb8\xba\xfc\xe5\x98\x70\xc4\x88\xc6\x64\xeb\x65\x93\xfe\x9a\xde\xb5\x96\x42\x27\x45\xe6\x25\x30\x30\x4e\x1f\x6c\x22\x97\xf8\xbd\x6d\xbd\x53\xdf\x8f\x06\xd7\xa5\xf0\xa6\xbb\xc5\xa3\xec\xaa\xc2\xd1\x04\xb9\xa8\xd2\x0d\xd0\x01\x92\x7f\xd0\xf8\xea\xab\x0a\xd9\x9c\x26\x73\xb6\xe8\xc9\x56\xea\x14\xbd\x98\x8f\x0f\xeb\xc6\x57\x5f\xed\xcb\xda\x2a\xd0\xb4\xa4\x5f\x95\xfc\xe0\xcb\xf3\x76\xe3\xab\xaf\x0e\xc9\xe4\x23\x49\x54\xa4\x6f\x03\x3a\x2f\x37\x8f\x60\x82\xd0\xaf\xe9\x1d\x06\xeb\x46\x9b\x98\x9a\xcb\xfd\x74\x80\x54\xbc\xcd\xa3\xa5\xc2\x6d\x97\x93\x39\x85\x96\x77\x3d\x07\xe7\x1b\x0a\xff\xfb\xf5\xf9\x9f\xfe\x98\x33\x30\x13\x8c\xb3\x5e\xcc\x48\xf4\xc0\x78\x9a\x6d\x67\x9f\x48\x28\x66\x12\x0b\x5a\xe7\xaf\xbf\xf9\xb7\x7f\xeb\x38\x70\x95\xd4\xf9\xd5\x57\xb6\x94\x82\x9c\x13\xbe\x31\x73\x6a\xe5\x4d\x3b\x78\x2b\xa5\xda\xf4\xcb\xb7\xef\x11\x9d\x26\xd3\x96\x45\x84\xfc\x7e\x9a\xbc\x9d\xa2\x8e\x3a\xd6\xaf\x37\x4d\xf1\x7a\x05\x5b\xbd\xfb\x97\xee\x14\xdb\xf5\x11\x7f\xea\xa6\x51\xea\x25\x34\x6b\x22\x23\xf7\x14\xd6\x31\x09\xa9\x0a\x99\xc8\x23\x2b\x9c\x8c\xc7\x28\x4d\x9a\xfa\xba\x3e\x15\x32\xb7\xa4\x71\x04\x24\xcc\x52\xce\x1b\xdd\x6e\xb1\x10\x03\x12\x5b\x9e\x35\x40\x44\x7e\xab\xb9\x1c\x8e\x63\x0a\x0b\x2c\x29\x79\x60\x34\xd3\x3d\xea\x74\x33\x9a\x44\x55\x09\xcf\xb5\xa1\x47\xc5\xa1\xf9\x0c\xc9\xbd\x55\x4a\x4f\xee\xc0\x8a\x25\xa5\xc4\xe4\xaa\xd0\x42\xa5\x3d\x66\xe9\x23\x64\x34\x4c\xb3\x48\x19\x0b\x79\xea\x5f\xf1\x8d\x83\xfd\xc5\x57\x66\x48\xab\x65\x97\x2e\x34\xb5\xe4\xbc\xec\xbd\xf4\x9c\x99\x85\x5e\x8f\x48\xcd\xdd\x93\xe7\x8f\x82\x75\x07\x61\xfa\x6e\xd7\xa8\x30\x2d\x1f\x0c\x07\x99\x01\x26\xaf\xb7\xa8\xf4\x7b\x0b\x94\xac\xc4\xca\x10\x16\x15\xb2\x74\x77\x1b\x2e\x12\xc0\xc6\x7a\x49\x48\xdc\x32\x50\x6f\xfb\x39\xe2\xc5\xbd\x70\xe2\xff\xf2\x2d\x2f\xf3\xd9\xb0\x18\x54\x79\x60\x4a\x6b\xee\x57\x3a\x2d\xc3\xb6\x32\x0b\x16\x2e\x01\xb3\x64\x8f\xeb\x1c\x4c\x87\x26\x55\x17\x2e\x21\x74\x7b\xd1\x86\x99\x9f\xf1\xea\x6d\xb5\xdb\x5a\x5f\x32\xaa\x72\x54\x91\x7d\xa9\x9c\x51\x96\xe8\x6c\x5a\xdb\x52\xa2\x5a\x99\x06\xde\x5e\xe6\x79\xb3\xf8\xb9\xd7\x3e\xec\x15\x95\xfb\x42\x35\x4e\xdb\x3a\xb7\x10\xcb\xbd\x61\xe0\xa5\x33\x63\x8c\xae\xcd\x6b\xfd\xdb\xc4\xdf\x5e\xee\x09\xf0\x13\x34\x9a\x78\x5b\x1b\xaa\xc7\x6f\xec\x15\x3b\x12\x10\x0e\xe9\xb8\x8f\x6b\x0a\xf9\xe8\x70\x1b\x95\x30\xc5\xa4\x96\xc2\x05\x8b\x63\xc7\x80\xe9\x95\x44\xd2\x61\x18\x57\x4c\xe4\x56\x7b\x68\xd2\xb2\xed\xbc\x74\xee\xe1\x5e\xa8\x3a\x32\x77\xf0\x4e\x05\xf1\x96\x1d\x9c\xaa\x6c\xdb\x70\x34\x1d\x5c\x05\xd0\xfc\x98\xa5\x2b\x9c\x15\xa6\xf6\xe6\xc2\x25\x59\xa8\xf1\x2f\xe0\x45\xef\xc5\x71\xb0\xf3\x9d\x36\xb5\x85\x7e\x8a\xd6\xcb\x31\xbb\xe3\x58\x33\x3b\xdc\x40\xc7\x86\xc9\xfe\xff\x00\x00\x00\xff\xff\x1d\x14\x58\x9c\x6d\xdb\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\x7b\x77\xe3\xc6\xb1\x38\xf8\xb7\xf9\x29\x6a\x7f\xab\xb9\x24\x1c\x92\x19\xd9\x49\xee\x5d\x29\x9a\x73\x68\x89\x1a\xf3\x46\x43\x4e\x24\xca\x8f\xf5\xfa\xf0\xb6\x88\x26\x09\x0b\x04\x68\x34\x28\x8d\x72\xf2\xe1\xf7\x54\xbf\x1b\x68\x80\x20\x25\x8d\x9d\x73\x33\x7f\x24\x16\x01\xf4\xa3\xba\xde\x55\x5d\xd5\xeb\x8d\x27\xd3\xe1\x4d\xab\xd7\x9b\xae\x22\x06\xf3\x34\xa4\x40\x18\xdb\xae\x29\x83\x7c\x45\x72\xc8\xc9\x5d\x4c\x21\x21\xf8\xc3\x9c\x24\x90\x26\xf1\x13\xdc\x51\xf8\xcb\xd7\x30\x5f\x91\x8c\x41\x9c\x26\xcb\x56\xab\x75\x7e\x3d\x1c\x4c\x87\x30\xb9\x86\xeb\xe1\xc7\xab\xc1\xf9\x10\x2e\x6f\xc7\xe7\xd3\xd1\x64\x0c\x37\xe7\xdf\x0e\x3f\x0c\x66\xe7\x83\xe9\xe0\x6a\xf2\xbe\xbf\xa4\xf9\x2c\xa4\x0b\xb2\x8d\xf3\xd9\x7c\xb5\x4d\xee\x67\x51\x92\xd3\xec\x81\xc4\x9d\xa0\x05\x00\x70\x3d\x9c\xde\x5e\x8f\x6f\x60\x34\x9e\x0e\xaf\xbf\x1b\x5c\xb5\x06\x37\x70\xb4\xd8\x26\xf3\x23\xfe\xf8\x66\x78\x35\x3c\x9f\xc2\x03\x89\xb7\xf4\xe4\x44\xbd\x04\x97\xd7\x93\x0f\xc5\xa9\xe4\x34\xf0\xfd\xb7\xc3\xeb\x21\xdc\xd3\xa7\xb3\xb6\x3b\x63\xfb\xb4\x25\x47\xbe\x1a\x8c\xdf\xdf\x0e\xde\x0f\xe1\xe6\xef\x57\x70\x33\x1d\x7c\x73\x35\x84\x8f\x83\xeb\xc1\xd5\xd5\xf0\x0a\x6e\x06\x97\xc3\xd3\xd6\xfb\xeb\xc1\x78\x0a\xc3\x1f\x86\xe7\xb7\xb8\xd3\xf1\x41\x3b\x84\xe9\x04\x36\x59\xba\x9e\x65\x94\x84\x34\x3b\x3d\x14\x72\x19\xcd\x69\x92\x47\x69\x32\xdb\xd0\x2c\x4a\xc3\xcf\x01\xbb\xe2\x9c\xaf\x0f\xbd\xf2\x2e\x5f\x0a\x7e\xe1\x76\x13\x47\x73\x92\xd3\xd9\x26\x8d\xa3\xf9\x53\x01\x7e\xd3\xe1\x0f\x53\x3f\xec\xce\x27\x83\xab\xe1\xcd\xf9\xb0\xd3\xb1\x81\xd9\x14\x84\xc5\x69\xdb\x41\x17\xda\xd1\x32\x49\x33\xda\x0e\x5e\x1d\x9c\xe5\x4d\x3f\x07\x9c\x11\x9b\xe5\xd1\x9a\xb2\x39\x89\x69\x78\x37\x8b\x12\x96\x93\x38\xa6\x45\x54\xfc\x66\x32\xb9\x1a\x0e\xc6\x7e\x68\xce\xd3\x6d\x92\x77\xbe\x0c\xe0\x1d\xbc\x15\x30\xdc\x2c\x67\xf4\x53\x4e\x13\x16\xa5\x89\x84\x1c\xfd\x94\x23\x03\x3a\x6b\x5b\xd3\xd5\xe2\xde\xc1\x58\x31\x4f\xd7\x9b\x8c\x32\x9c\x7b\xc6\x68\x9e\x47\xc9\x72\x9f\xdd\x48\xba\x92\xef\x34\xc5\x89\x35\xcd\xb3\x68\x6e\xcf\xfd\x19\xd8\x92\x6f\xa3\x65\x64\xe8\xf5\x06\x61\x08\xc7\x6f\x20\x5d\x40\x46\x92\x30\x5d\x27\x94\x31\xc8\x53\xc8\x57\x14\x14\x57\x03\x96\x0a\x61\xc1\x99\x1d\x03\x92\x51\x48\xd2\x1c\x48\x1c\x2d\x13\x1a\xfa\x1e\xb3\x9c\x2c\x97\x34\xa3\x21\x2c\xd2\x0c\xac\xd5\xc0\x2f\xe9\x1d\xeb\xef\x79\x7c\x7a\xb4\x22\xbb\x75\xff\xd4\x5c\x31\x68\x35\xe3\x93\x85\xcf\xbf\x84\xce\x71\xff\xed\x1f\x3a\x1d\x01\x8a\x4e\xf0\xe5\xdb\xfe\xdb\xe3\xa0\xf7\xb6\xff\xf6\xed\x9f\x83\x0a\xf2\xfd\x6e\x72\x35\x98\x8e\x38\x4e\xf6\x7a\xe7\x24\x49\x93\x68\x4e\x62\x88\xd3\xf9\x3d\xa4\x59\x48\xb3\x28\x59\x9e\xb4\x7a\x3d\x81\x05\xac\xd5\xeb\x85\x24\x27\x42\xee\xb6\x7a\xbd\x98\xdc\xd1\x18\x7f\x65\x34\x8b\x28\x83\x0d\xc9\x68\x92\x3b\x7f\xe7\x11\x72\x48\x1c\x7e\x9e\x26\x2c\xcf\x48\x94\xe4\x0c\x87\xec\xc1\x74\x45\xc5\x89\x8a\xd1\xe1\x21\xa2\x8f\x90\x93\x7b\xca\xf8\x02\x18\x44\x09\x3f\x49\xbe\x90\x13\x30\x33\x77\xa1\x38\x7e\xbf\xd5\x52\x5a\xc2\x26\x4b\xe7\x34\xdc\x66\x14\x16\x51\x42\xe2\xe8\x1f\x5c\x59\xa0\x30\xcf\x28\xc1\x57\x11\x5b\x08\x88\x29\xfb\x7c\x0d\x8b\x28\x63\x39\x1f\x0b\xd2\x85\xde\xac\xf9\x60\x45\x36\x1b\x9a\xf0\xe5\xac\xc9\x3d\x95\xcb\x9d\xf1\xa5\x00\x49\x42\x3e\x3c\x9f\x4c\x0c\xa2\xde\x5f\xd1\x8c\xf6\x5b\xbd\xde\xf7\x14\xd8\x26\x8e\x72\x28\x0e\x1c\x25\x88\xab\x8f\x29\xff\x8c\x23\xee\x3a\x4a\xa2\x75\xf4\x0f\x0a\x31\xc9\x69\x32\x7f\x82\x70\x8b\x47\x00\x51\xc2\x68\xc6\x01\xd9\xeb\x75\x1e\x57\xd1\x7c\x65\xaf\x0a\xe7\x2f\xaf\x6c\x43\xf2\x55\xd0\x87\x21\xdb\xd0\x79\x44\xe2\xf8\x09\xd1\x9e\x3e\xa6\x59\xbe\x7a\x82\x48\x68\x50\xad\x5e\x8f\xe4\x39\x99\xaf\x70\x12\x1c\x46\x43\x54\x91\x91\x84\xb4\x18\xd2\xde\x19\xdc\xd1\x39\xd9\x32\x0a\x51\x0e\x19\xfd\x75\x1b\x65\x14\x31\x81\x24\x40\x3f\xcd\xe3\x2d\x8b\x1e\x28\x3f\xc6\x2e\x88\xf5\x46\x0c\x08\xac\xa2\xe5\xaa\xa7\xf6\x96\x6e\x68\xc6\x01\x21\x8e\x21\xcd\x57\x34\x03\x32\xc7\x5f\x70\x75\x11\x0e\x87\x38\x8b\x3f\x40\x98\x52\x8b\x76\x19\xcc\xb3\x28\x17\xb8\x2a\x46\xeb\x3d\x46\x8c\xc2\xdd\x36\xe7\x2f\x91\x98\xa5\xfc\xcd\x84\xce\x29\x63\x24\x7b\x6a\xf5\x7a\x79\x0a\x1b\x9a\x2d\xd2\x6c\x8d\x40\xe3\x58\x85\xbb\x14\xb0\x15\xe8\x25\x4e\x73\x2b\x66\xda\x6c\x73\x7d\x86\xad\x5e\x6f\x9c\xe6\xf4\x44\xf0\x0a\x02\x88\xcc\xf4\xd7\x2d\x4d\xe6\x14\x11\x0a\x57\x0b\x21\x65\xd1\x32\x51\xa0\xb5\xa1\x67\xa0\x8a\x50\xe0\x00\xa7\xa1\x58\x91\xfb\x16\x4d\x72\x20\x8b\x9c\x66\xe2\x58\x23\x06\x2c\xa7\x1b\x84\x0f\xae\x49\x21\xd0\x3a\x5a\xae\x72\xbe\xbd\x3b\xfc\x98\x22\x26\x01\x4b\xd7\x48\x92\xf3\x2c\x65\x4c\xa1\xf0\xaf\x5b\x31\x72\xc6\x3f\x20\x8f\xe4\x09\x87\x4a\x19\xd5\x4f\x70\xca\x76\x8e\x3c\x6e\x8d\x98\x9e\x3e\xd2\x07\x9a\x49\x30\x50\x08\x69\x4c\x10\x72\x11\xa2\x19\x6e\x2e\x5a\x44\x73\x92\xe4\x38\xdf\x26\xc3\xa3\x9a\x2b\xe8\xe0\x51\xf7\x24\xa5\xca\xd9\x25\xad\x22\x60\x67\x25\xba\xa5\x49\x5e\x26\x63\x0f\x67\xfd\x78\x3d\x39\x1f\x5e\xdc\x5e\x0f\x8b\xac\x55\x51\xb7\x42\x7a\x45\x55\x9d\x80\xb3\x4b\x64\x03\x47\xad\x8b\xe1\xf9\xd5\xe0\x7a\xc8\xd9\x66\x06\xd7\xc3\xf3\xc9\xf5\xc5\x29\xff\x8b\xbf\x4e\x43\xb8\x4b\xd3\x98\x92\xe4\xb4\xf5\xcd\xf0\xfd\x68\xcc\x1f\x5d\x4e\xae\x21\x03\xf9\x87\xc5\x70\xbf\xd4\x3f\xf8\x64\xa7\x58\x86\x7e\x45\x88\xd0\xf1\x64\xaa\xc9\x9d\x8b\xb5\x98\xe6\x34\xd4\x2f\x4d\xae\x2f\x86\xd7\xf0\xcd\x8f\xa0\x78\x36\x7f\x72\x35\x99\x7c\x2c\xce\x5d\x33\xc8\x68\x3c\x9d\xa8\xed\x34\x58\x21\xac\x0b\x6b\x5c\xf7\xa3\x10\xce\x20\xeb\x47\xd6\xe7\x93\x6b\xb8\xfd\x78\x31\x98\xa2\x64\xd0\x13\x5d\x6a\xa8\x4d\xbf\x1d\x1a\xf0\xe0\xbf\x5e\x2f\xa3\x31\x25\x8c\x42\x96\x3e\x72\xba\x77\x1e\x9f\x4f\x3e\x7c\x18\x4d\x4f\x0b\xbf\x8d\xa7\xa3\xf1\xed\xd0\xfc\x3a\x1c\x5f\xc0\xe8\xd2\x9d\xb1\xb1\x5a\x07\x83\xf1\xc5\x01\x4a\x45\x71\x23\x1f\x87\xd7\x97\x93\x6b\x0d\xbb\x8f\xd7\x93\x0f\x7d\x46\xdd\xcf\xd3\xc4\xe1\xb4\x9d\xac\xcf\xff\x7f\x86\x7a\x60\x17\xa6\xd7\xb7\xc3\xe0\xa5\x36\xb5\x6b\x7d\x6a\x08\x71\x30\x6a\x59\x59\x1a\xc7\xdb\x0d\xeb\xe0\x99\xd6\x2d\xa5\xd7\x0b\x53\xc1\x66\xee\xe8\x22\xcd\x28\x4a\x5f\x94\x04\x2e\x07\x77\x04\xd3\x63\x9a\xdd\x4b\x16\x25\x5f\x76\x0e\x5b\x20\x4d\x05\xe6\xdd\x0c\x7d\x88\x0c\x67\x1c\x64\x12\x1b\x35\x2e\x3a\xcb\x7c\xa4\xf0\x18\xc5\x31\x24\x94\x86\x62\xc1\x7c\x61\xa8\x9e\x55\xc9\x2f\xd4\xeb\xc8\x3d\x17\x4f\x49\xfa\x68\x8d\x95\xa7\x40\x1e\xd2\x28\x14\x43\x6c\x37\xcb\x8c\x84\xb4\x0f\xa3\xdc\x12\x2a\xa5\x1d\x87\x69\x42\x51\x90\xc5\x54\x48\x26\x33\x1c\x1f\x05\x79\x3e\xb9\xa7\x49\x5f\x3f\xb8\x9a\x9c\xff\x0d\x84\x4a\x3c\x19\x5f\xfd\x58\x84\x88\xe4\x7c\xa3\x31\x0c\xce\xcf\x87\x37\x37\x30\xfc\xe1\xfc\xea\xf6\x66\xf4\xdd\x10\xd6\x69\x48\xad\xcd\x2b\xdd\x19\xe5\x16\xc9\x3b\x47\x47\x36\x3a\x0c\xae\xa6\xc3\x6b\x39\x8d\x7f\x86\xc1\x74\x3a\x38\xff\x16\xd5\xf2\xe9\xc8\xd6\x50\x2f\x06\xd3\xc1\xec\x66\x78\x3d\x1a\xde\xf4\xdf\x1c\x1f\x8d\x38\xc9\x7f\x37\xb8\xba\x1d\xa2\xde\x09\x9d\x37\x5f\x1d\x5d\x05\x7a\xaa\xa3\xa3\x2e\xb8\x58\x5e\x40\x2d\x9b\xc0\x11\xcd\x90\x87\x9d\xb6\x86\xe3\x8b\xd3\x96\x60\xc5\xa0\xf5\xce\x8f\x57\x1f\xdf\xdf\xfc\xfd\xea\xb4\x85\xdf\x0c\xc7\x53\xb4\x0a\x0e\xe1\xf2\xa3\x1b\x68\x5f\x6a\x15\xaf\xa0\x5b\xf5\xa1\xa0\x0c\xb2\x55\xba\x8d\x43\xb8\xa3\x90\x6d\x13\xb8\x7b\x12\x3a\x61\x9a\x24\x74\x9e\x23\x16\x6d\xf3\x74\x4d\xf8\xe1\xc7\x4f\x6d\x8f\xdd\x72\xc0\x0a\xb5\xc5\xf2\x98\x45\xb9\xb4\x58\xf8\xaa\xb4\x52\x83\x4e\x2d\x4e\xe9\xb8\x20\x02\x79\x16\xa1\xa5\x00\x8f\x2b\x9a\x00\x81\x84\x3e\xaa\x6d\xe1\x8b\x82\xf5\x22\xa2\x72\x05\x3b\x67\xb0\xdd\x08\xd5\x4f\xbc\xf3\xcb\x96\xe5\x40\x93\x74\xbb\x5c\x15\xd5\x1a\xae\x68\x46\x79\x1f\x3e\xb8\x50\x12\xa2\xdd\x50\x62\x94\x40\xcd\x76\xc8\x5d\xfa\x40\xfb\x70\x43\xa9\x04\xde\x7a\x4d\x93\x1c\xb5\xb4\x34\x11\x2a\x8f\xde\x18\x12\x26\xbe\x93\x51\xc2\xd2\x04\x89\x53\xfc\x12\x31\xa9\x0a\x0b\x5d\xc9\xd1\xac\x94\x22\xc7\xd0\xbc\xce\x91\xf9\xa8\xe1\xfa\x70\x23\x4e\x8f\xfb\xf7\xe6\x69\x92\x93\x28\x71\xf6\x1b\xa7\xcb\x68\x2e\x14\x2a\xb6\xdd\x6c\xd2\x2c\x97\xfb\x67\x7a\x29\x52\xe3\x2f\xa8\x2a\xb6\x51\x21\xac\x19\x9f\x71\xd1\xdc\xe0\x2b\xa9\xe1\x05\x0b\x5d\x1e\x31\xff\xcd\x58\x75\x46\x4d\xe1\x6b\x98\x45\x21\x4a\x75\x4b\x27\x29\x30\x81\xb6\x5c\x90\x43\xf8\x48\xd1\xfd\x37\xa3\x0e\x8a\x12\x98\x8e\x3e\x0c\x6f\xa6\x83\x0f\x1f\xa7\xff\x2f\x57\x42\xc6\xb7\x57\x57\x5d\xe9\x0d\xba\x98\xdc\x72\x4b\xfd\x7a\x78\x3e\xba\xc1\x3d\x98\x17\xc4\xd6\x71\xfe\x6f\x46\xef\x47\xe3\xa9\x7e\x14\xb4\xbb\x8e\x18\x52\xff\xc6\xc3\xef\x2d\xb6\x10\x9c\xd6\x2c\xf6\x76\x3c\xfa\xfb\xed\x10\x46\xe3\x8b\xe1\x0f\x42\x29\xd4\xb3\x71\xf1\x37\x7b\xc3\xc0\xe5\x4f\xfd\x37\x23\xe8\xe8\x97\xba\x80\x6f\x05\x30\x1a\x9f\x5f\xdd\x5e\x0c\xa1\xc3\x77\x53\xb7\x30\xfc\xa6\xb4\xc0\xd6\xb3\x44\xb0\x12\xbf\x52\xde\xae\x9e\x36\x34\x13\xe7\xac\x76\xeb\xae\xbf\x5d\x5a\x41\x17\xb8\xa7\xa8\x62\xd9\xfa\x9f\xb0\xee\x39\x5c\x94\x89\x7f\xf6\x6e\x1f\xef\xc2\x1e\x6e\xdf\x60\xd7\x5a\xc4\x66\xd5\xe7\x51\x12\xd2\x4f\x94\x9d\xbd\x5b\x90\x98\xd1\xc0\x30\x7d\xad\x5b\x48\xa5\xd5\xb3\x84\x34\x9b\xc9\xd1\x14\xa6\x77\xda\x33\x0e\x98\xd9\x4c\xc2\x4a\x52\x0f\x07\x56\x4b\x2b\xb8\x37\xd3\xeb\xd1\xf9\x54\xd3\x87\x98\xb4\xd7\x43\x93\x56\xf0\x1e\x65\x8e\x0a\x2a\xfe\xe9\xf8\x67\x88\x18\x6c\x93\xe8\xd7\x2d\x05\xc2\xad\x22\x43\xd1\x8c\x5b\x38\x02\x21\x3b\xe2\x83\x80\x5b\x38\xa1\xa5\x41\x28\x86\xc0\x6d\xc1\xe5\x96\x64\x24\xc9\x29\x0d\x61\x19\xa7\x77\xdc\x9c\x16\x83\xb7\xea\x85\x74\x15\xa5\x3a\xb2\xb7\xe3\xc0\x3f\x0a\xe1\x2e\x5a\x46\x49\x6e\x08\xd3\x79\x2e\x01\x14\x85\x50\xfd\x8e\x5c\xba\xad\xc5\x0a\xd0\x91\x2c\x23\x4f\x15\x1f\x9d\x7f\x3b\x3c\xff\x5b\xc7\x00\xf0\x0c\x50\x0b\xe0\x8a\xb5\xf9\x71\x74\x63\x98\x82\xef\x73\xb3\xba\x33\x78\xf3\xf5\x51\xe9\xa5\xc9\xf8\x66\x7a\x3d\x40\xde\x22\x29\x5b\x0c\x8d\x5c\xe0\xcd\xd7\x47\xac\x78\x2a\x9a\xda\xa3\x70\xe7\x48\x9b\x7b\xfa\x24\x06\xf9\x78\x3d\xfa\x30\xb8\xfe\x11\xfe\x36\xfc\x11\x3f\xd4\xdf\x05\x2d\xa5\xcd\xb8\x54\xd9\xd5\x88\xd5\x95\x9c\x43\x72\x0a\xc1\xb7\xf1\x37\xae\xcd\x94\x9c\x68\x52\x99\xb1\x1c\x69\x8d\x3d\x9e\x1e\x51\x51\xd6\x18\x2e\xae\x27\x1f\x61\x7a\x3d\x7a\xff\x7e\x78\x8d\x6c\x6b\xf8\xc3\xe8\x66\x7a\x53\xf6\xf6\xcc\x94\xee\xe0\x99\x87\xbf\x06\xe7\x83\x9b\xf3\xc1\xc5\xf0\x54\x09\x33\x35\x68\xe5\x50\x42\x46\x5d\xa2\x82\x39\x1a\xdf\x0c\xaf\xa7\x95\x63\x6b\xab\x79\x88\xaa\xe6\xf5\xe4\x7b\x87\x26\x2a\x35\x27\x0f\x00\x4e\xb9\x1f\xcf\xff\xaf\xd5\xeb\xc1\x08\x79\x56\x42\x62\xad\x1a\x30\xe0\x0f\x2a\xbe\xc0\x4f\xae\x69\xbe\xcd\x12\x20\x56\xb0\x10\xee\xb6\x51\x9c\xc3\x22\x4b\xd7\x40\x60\xb1\x8d\x63\x8e\x04\x9c\x29\x10\x60\xdb\xc5\x22\xfa\x84\x8a\x82\xf0\x0e\x6e\xd1\xee\xc0\xc7\xa8\xe4\x67\xdb\x64\xce\x2d\x60\xe5\x36\xe6\xfe\x1b\xfe\x05\x86\x0b\xe2\x10\x16\x11\x77\x8f\xe0\x67\x7c\x0c\xfe\x29\x8b\xfe\x21\x2d\x18\x12\x3f\x92\x27\xb4\xb7\x80\x7e\x22\xf3\x3c\x7e\x82\xbf\x7c\x25\x82\x95\xfb\xa8\x19\x9b\xa5\xe0\x99\x8f\x51\xbe\x9a\x89\xe9\x0d\x0f\x31\x1b\xca\xe9\x27\xf4\xb2\x88\xe5\xe1\x1f\xae\x32\x82\xef\xf8\x7d\xcb\x1d\xb6\xbd\x63\x39\xba\x1e\x3b\x66\x34\xd4\xa4\xfe\xf2\x55\xaf\x83\xab\x9d\xc5\x34\x59\xe6\xab\x8e\x18\x3b\xf8\xc3\x71\x10\xc0\x3f\xff\x09\xed\x59\x1b\xff\x4f\xfe\x7a\x72\xc2\x67\xf0\x39\x9e\x47\x1f\x3e\xdc\x3e\x2f\x60\xe0\x03\x81\xd8\x2f\xdf\xa8\x2f\x5c\x60\x70\x01\x55\x6b\x29\x1b\xc4\xd6\x04\x2a\x68\x2c\x88\x42\x79\xfe\xfc\xcc\xb9\x03\x34\x05\x94\x2e\xb9\xc4\x08\x01\x11\x75\xce\xf0\xcd\x36\x87\x08\xdd\x80\xe8\x82\xb3\x50\x06\xbd\x96\xe8\x5f\x5b\x44\x79\x17\x96\x34\x41\x87\x27\x65\xe5\x05\xf0\xd9\xc6\x5a\x96\xe5\xdc\xc1\x3a\x27\x89\xf4\xf1\xa1\xbf\x31\x8e\x23\x1e\x82\xb8\xa3\xf9\x23\xa5\xdc\x40\xd8\x32\x9a\xe1\x87\x21\x5d\x44\x09\x0d\xc1\x42\x62\xfe\x9f\x08\x1a\x8d\xd0\x5a\x40\xfa\xbe\x62\x90\x2e\x40\x1c\x29\xe2\xa3\x44\xd2\x25\xcd\xcd\xe7\x24\x41\x8f\x25\x6a\xdf\x0f\x34\x63\x34\x7e\xea\x02\x91\xdb\x64\x85\x99\x50\x60\xea\xc1\xfa\x1c\xf2\xdf\xf3\x79\x81\xc0\x9a\x7c\x12\x8b\x93\x2f\xa4\x0b\x9c\x10\xf7\xf9\x97\xaf\xf5\x12\x05\xa9\x6a\x3f\x39\xff\x0f\x04\x1b\x1a\x2c\x20\x24\x58\xfe\xb4\x11\xa0\x0b\xe1\x7f\x04\xf7\xc0\x3f\xfe\xa7\x8f\x33\x09\x2f\x41\x0a\x34\x61\xdb\x4c\x83\x34\x62\x8a\x8c\x71\x14\xa5\x19\x30\x78\xa4\x71\xdc\x45\x7a\x5e\x91\x07\x8a\x9f\x65\x94\xd1\xec\x01\x17\xcb\x36\x64\x4e\xb5\x05\xb1\x4d\x42\x9a\xb1\x79\x9a\xd1\x43\x48\x55\x4c\xe8\xa1\xd2\x19\xc9\x96\x87\x53\xea\xf9\xe0\x66\x68\x3b\xf4\xc6\x60\x93\xa7\x33\x49\x00\x7f\x45\x58\x97\x5c\x4a\xce\x4b\x92\x66\xd5\xb3\xe1\x95\x35\x3c\x9f\x76\x0f\x46\xe4\x9d\x40\xed\xd2\x79\x2b\xb0\x7d\x54\xaf\xcc\x30\xe4\x41\xec\xe0\x15\xe7\x19\xb5\x48\x55\x20\x24\x77\x37\xc1\x32\x7a\xa0\x89\x32\xba\x15\xf1\x72\x4e\xb1\x65\x94\x1b\xe5\xe8\x8a\x07\x15\x1e\x60\x88\x5a\xcc\xb2\x5f\xef\xa8\x34\xfa\x5b\xbd\xde\x88\xf3\x0c\x39\x3c\x0f\x71\x20\x25\x3c\xd1\x1c\xe8\xa7\x88\xe5\x62\x64\x6a\x39\x0c\xa4\x51\x2b\x22\x47\xc6\xf6\x9f\x93\x9c\xc4\xe9\x52\x5a\xb2\x88\xdf\x32\xea\xc2\xe9\x89\x55\x44\x88\x94\xce\x90\xa7\x18\x03\x73\xbe\x23\xf3\x7c\xcb\x95\x5c\x45\x7b\x7a\x99\xf8\x12\x0f\xcf\x29\x3f\x7f\xb7\x3c\xf2\x4f\x4d\xcc\xea\x9f\xf7\x20\x22\xd7\xbd\x29\x94\x85\x56\x41\x1f\x2e\xd0\xd2\xe4\x76\x0a\x42\x45\x16\xff\x6d\x94\x3d\x10\xa6\x85\xcf\xfc\x4e\xe8\xa3\xd4\xab\x95\xf1\x2d\x7f\x39\x83\x84\x7e\xca\xd1\x5a\xda\x2c\x67\xdc\xee\xa2\x59\x44\xe2\x99\x3a\xe5\x4e\xbb\xb0\x62\xb1\xa8\x76\xb7\x1d\x85\xed\x20\x38\x39\xe1\x43\x6a\xcf\xbe\x54\xa8\x84\x65\xe3\xfb\x10\xf5\xdd\xae\xbd\xb3\xae\xb5\x81\xa0\x18\x1d\x90\xeb\x2e\x9b\x71\x05\xd0\x94\x5f\xa8\xa7\x91\xe2\xe7\x72\x9e\x93\x13\xc3\xa1\x26\x63\x54\xc4\x2f\xaf\xd0\x38\xbb\x98\xa0\x69\xf0\xed\x68\xfc\xde\x62\x5e\xa3\xf1\x7b\xff\x16\xb9\x79\xee\x7f\x62\xb6\x6a\x0c\x40\x7c\xdb\xfc\xae\xec\x3f\xc1\x94\x79\x5c\x11\x45\xd3\x7c\x9b\x65\x3c\xb6\x28\x32\x00\x90\x58\x60\x4d\x78\xe4\x13\x32\x29\xfc\x93\xa7\x1c\xdd\xc5\x9c\xe5\xe7\xd9\x13\x10\x60\x34\xa6\xf3\x9c\x4b\xce\x38\x4d\x37\x6a\xe8\x55\x9e\x6f\xd8\xc9\x1f\xff\xc8\x72\x32\xbf\x4f\x1f\x68\xb6\x88\xd3\xc7\xfe\x3c\x5d\xff\x91\xfc\xf1\xf8\xcf\xff\xcf\x9f\xdf\x7e\xfd\xd5\x9f\xa4\xa6\x3b\x9a\x0a\xde\x7b\x39\xb9\x45\x97\xa7\xcd\xa0\xd7\x7c\x9f\xeb\x06\x7b\x6a\x35\x0a\xdc\xc8\xa0\x8d\x39\x19\x38\x2b\x1e\xf3\x69\xcb\xbf\x2c\xc7\x31\xbb\xd3\x94\x81\x3d\x78\xab\x8f\x3e\x5d\xd6\x6a\xf9\x40\x5d\xd6\x2a\x0c\xaf\x7b\xfa\xc4\x23\x47\x36\x8b\xbd\xa7\x4f\xaf\xc9\x5a\xf7\xe6\x3e\x7a\xa5\x86\xf5\x20\x3d\xe0\xd2\x31\x23\x4b\xb3\x9c\xd1\x58\xfe\x37\x77\x50\xcd\xe6\x69\xbc\x5d\x27\xe2\xa8\xc6\x83\x0f\x43\xf5\x5e\xe9\x41\xeb\xb5\x79\x92\xde\xc0\x01\x6c\x49\x7f\x2b\x38\xd3\x3d\x7d\xea\x96\xf7\xd7\x2d\x6c\xab\x39\xa3\x92\x80\xdc\x97\x41\xa9\xcf\x5c\xc6\x74\xe0\x28\xc2\x80\x89\xc2\x76\x57\xbb\x2e\xdf\x30\xf1\xb7\x18\x3e\x38\x9c\xe5\x69\xf0\xf9\xb8\x9e\x79\xe8\x81\x68\xcd\x40\xf6\x8b\x2e\x53\xd9\x79\x32\xff\x3a\xfc\x33\xbe\xe7\x20\x8b\xef\x7d\xc0\xe1\x0f\x9f\x01\x86\x4a\x96\x6b\xd0\x3d\xbe\xb7\xd8\x2e\xfe\x70\xa6\x90\xf5\x65\xd8\xec\xfe\x5c\xd6\xf0\x21\x64\x3b\x5e\x16\xfb\x9e\x5b\x6e\xfc\x45\x50\xac\x35\x5a\x40\x9a\x18\x93\xf4\x20\x4e\xe8\x73\xe1\x3a\x0c\xf1\xc5\x98\x61\xe0\x9a\x3b\x12\x19\x1a\x1f\x6a\x93\x33\x15\x47\x1a\xdf\xf7\xc5\xa9\x56\xec\x0d\x9f\xe2\xdb\xb7\x63\x84\xc7\xe0\xea\xaa\x55\xc8\x08\xf1\x4d\x55\x02\x50\xcd\xe0\x9c\xa9\x5c\x8d\x3e\x8c\xa6\x70\xbc\x23\x49\x6f\xaf\x6c\x4a\xdf\x39\x09\x84\xc9\xd3\x12\xc2\x80\xc0\x18\x2d\x90\xa5\x95\xbd\x49\x59\xa4\x03\x7a\x16\x42\xf5\xe1\x12\x7f\x48\x9e\x94\x0d\x80\x43\x60\x90\x9e\x24\xc2\x25\xa6\x3e\xe4\x8e\x93\x3b\x6e\x67\x63\x98\x91\xcc\x79\xf2\xd6\x26\x65\x2c\xba\x8b\xa9\x71\xb2\x70\xf9\xce\x85\xfb\x26\xa3\x79\xfe\x04\x2b\x4a\x1e\x9e\x64\x1e\x20\x13\xbe\x17\xb6\x21\xe8\x91\x8a\xb9\x56\xa0\x6c\x10\xbd\xb7\x99\x9a\xb2\x5b\x9b\x29\x08\x9d\x28\x11\x99\x86\xca\xbd\x10\x74\xf7\x24\x00\x24\xff\x4d\xca\x66\x8b\x34\x73\x91\xdf\x56\xca\x84\x11\x82\xeb\xd2\x7f\xba\x26\x7d\x94\xe4\x5e\x71\x0f\x06\xe8\x5c\x38\x0b\xe9\xf8\x29\x9f\x95\x7f\x76\x8c\x39\x24\x1a\x3b\x8b\xa9\xd7\x43\x98\x85\xe9\x16\x1f\xce\x57\x74\x7e\xcf\x41\x86\xb1\x58\xf4\x2e\xc9\x77\x16\x11\xcb\x21\xdd\xe4\xd1\x3a\x62\x79\x34\x17\x2f\x9e\x58\xfc\x57\x6f\x6e\x93\x32\xcd\x2d\x5b\x15\x72\xb5\x7c\x18\x10\xdf\x6f\x0c\xff\xd4\xdf\xc5\xf7\x9b\xbe\xab\xc2\x7a\x00\x6b\xbf\xa1\xbf\xe4\xc1\x88\xfb\x8d\x45\xb3\xc5\xaf\x14\xcc\x8d\x28\x50\x8b\x91\x0c\x7b\x74\x29\x38\xb5\xeb\x09\x11\xe7\x62\xbd\x5b\x15\xd5\x6a\xa0\xb0\xbb\xe4\xe7\x38\xd7\xf1\xbb\xce\x8e\xcd\x5a\x61\x2f\xfb\x5b\x25\xb3\xf1\x18\x91\x8a\x90\x26\xed\x04\x10\xe5\x3d\x7b\xa4\xdc\x03\x17\x25\x40\x17\x0b\x14\xcc\xf3\x15\x49\x96\x2a\x43\x86\xcd\x57\x74\x4d\x6c\x1c\xe0\xc9\x92\x6b\x9e\x77\x2b\xfd\x65\xb4\x80\x71\x77\x34\x46\x01\x82\x34\x9c\x65\x38\x62\x94\x40\x4e\xb3\x35\x77\x1b\x5a\x6a\x83\x2f\x16\xd6\xb6\x32\x61\x0a\xb1\xdd\xd1\x18\x6e\xbe\x1d\x5c\x0f\x55\xd6\x90\xc9\x81\xf9\x30\xb9\x18\xb6\xbb\xce\xee\x03\xb5\x7d\x46\xe7\x69\x12\x4a\x94\x16\x99\x48\x3a\x05\xe9\x5f\x01\x67\x6b\x91\xf6\x45\x11\x76\x74\x69\x18\xd0\x19\x98\x38\xab\x33\x8e\x7b\xd2\x27\x67\x70\x7c\x8a\xca\xdb\x71\x4f\x84\x79\x43\x21\x09\x58\x17\xd4\xe7\x1c\xf5\x78\xca\x34\x8d\x29\x66\x80\xb4\x4a\x8e\xc2\xc2\x31\xe0\xbf\x35\xf9\xd4\xd9\xa4\x2c\x80\x3f\xc0\xb1\x93\xa5\x58\xe7\x5d\xac\x39\x9b\xf2\xf9\x1c\x74\x46\x02\xde\x0e\x0c\xdc\x54\x3d\xe7\x11\x0f\x7e\xde\x5e\x5d\x95\x7d\xa8\x25\x28\x7e\xc5\xa1\x28\x21\x04\xc7\xca\xa9\x2c\xae\x14\x28\x50\x96\x32\xf3\x4a\x47\x58\x91\xee\x57\x25\xdf\xd5\x71\x07\xa7\xad\xc6\x06\x9d\x5e\xb6\x5e\x8d\x4c\x03\xeb\x38\xee\x27\x35\x74\xd7\xdd\x6b\xc9\x24\xd2\xa3\x54\x99\x46\x36\x75\x56\xa1\x3b\x46\x98\x7d\x28\x3f\x18\xdd\x0c\xa1\x7d\xce\x2d\x7e\xb4\x49\x16\x91\x88\x76\xd0\x47\x3d\x48\xbb\x39\x14\x25\xf8\x64\xf4\x18\x95\x02\x7b\xcb\xc1\x69\x83\x6f\xe5\xfb\x9e\x6f\x5b\x5e\x1a\x7d\x61\x8b\xc0\xa7\x8e\xf8\x1c\xdb\x96\xa6\xe7\xf5\x97\x48\x3e\x4a\x24\x57\x95\x11\x13\xfe\x3f\x32\xa3\x42\xdb\x0d\xdc\x66\x38\x40\x63\xd2\xf9\x1e\x8e\x4e\xa4\xd4\x79\xeb\x07\x63\x38\xd8\x36\x80\x50\x6c\x7c\x9e\x8a\x5a\xc6\xde\x31\x8e\x8a\xa0\x65\x70\x5b\x7f\xa3\x57\xd3\x35\xeb\x78\xa6\x95\xaf\xf2\xa8\xa5\x15\x5a\x65\x25\xfa\xe4\x55\xf1\xdb\x7a\xf3\x14\x62\x8f\x94\x12\x32\x46\xc3\x78\x30\xbe\xd0\x8f\xf8\x0e\xe1\xcc\x82\xf8\x67\xb7\x60\x4b\xc8\x60\x23\xab\xc7\x2c\x79\xcc\xf0\xc6\x49\x06\x24\x4b\xb7\x49\x08\xbf\xb0\x34\xb9\x9b\x51\x32\x5f\xcd\xf0\x13\xfc\x02\x5d\x85\x40\xe0\x8e\xe6\x88\xc0\x59\xfa\x38\xa3\x2c\x8f\xd6\x24\xc7\x40\x05\xf2\x5a\x99\x09\xd3\x39\x7e\xcb\x39\xc6\xf1\xdb\xb7\xc1\x1e\xd8\x2b\x16\x5a\x98\xb7\xf3\x0b\x13\x4b\x11\xc8\x8a\x20\x37\xa8\x2b\xa0\x2c\xf5\x7d\xa5\xec\xdf\x0c\xa7\x93\x4b\xc8\xe8\x3c\xcd\xc2\x16\xd8\xd6\x5d\xab\x2a\xb2\xa5\x32\x9e\xae\x27\xdf\xdf\xc0\xf1\x5b\x4d\x0a\xc8\x47\x8e\x74\x9c\xbe\xbc\xb2\x20\xe8\x7f\x69\xbd\xb9\xc7\xe1\x54\xed\x35\x4d\xee\xcc\xe1\x58\x21\xb2\xc2\xe1\x6c\x93\x84\x32\x73\x26\xe6\x44\x40\x9d\xc8\xf3\x0e\x41\x8c\xdf\xb1\xd3\x98\x48\xf2\xc4\xff\xa3\x04\x69\x92\x3c\x69\xe5\xe4\xe5\xa0\x5d\x5e\x41\xf0\x1c\x48\xcb\xe1\xf4\x26\xca\x30\xae\xcc\x6c\xa9\xf9\xe7\xfb\x06\x3e\x6e\xef\xe2\x68\x0e\x83\x8f\x23\x06\xe2\xa7\x9d\xdf\xec\xfa\xb7\xef\x2d\xd6\x92\x15\x34\x8b\x16\x33\x2e\x4c\x58\xb5\x05\x5d\xb8\xf7\xcc\xcf\xad\xa3\xa2\x7a\x35\x11\x3d\xd7\x63\x64\x5e\x34\xd1\xed\x5d\x71\x16\x75\x37\xa6\xac\x4d\xd6\x6c\xc4\x7e\xfb\xb5\x2e\xc9\xd6\xc1\xd1\xe5\xa3\x16\xa9\x2a\x04\x50\xc8\xca\xa9\x94\x0a\xf1\xce\xb7\x96\xda\xd1\x92\x72\x9c\x5b\xfb\x69\x78\x0e\x93\xd0\x7d\xec\xf8\xb3\xf8\x2e\x5a\x40\x94\x3f\x33\xd6\xb2\xcb\x74\xae\x71\xb6\xec\x88\xf8\x8a\x1f\xa5\xeb\xe9\x09\xc5\x90\xba\x2f\xdd\x1c\x73\xba\xc0\x73\x5e\x9f\x87\x40\x35\xdb\x2b\x9a\x8f\x5e\xa7\x63\x17\xf2\x6c\x4b\x77\xb8\x1e\x9d\x50\xdc\x1e\xb3\xbe\xbe\x37\xb2\x7c\xa6\x95\xe2\x7f\x53\x8d\xb5\xf5\xfe\xc9\xe7\xbb\xb4\x51\xa5\xde\xe1\xd9\xf3\x70\xa8\xd1\x58\x14\x66\x38\x92\xbe\x0a\xae\x65\xd3\x4f\x74\xbe\x55\x39\x14\xeb\x34\xa3\x40\x3f\xe1\x35\x5a\x94\x92\x4a\x97\xd2\x5b\x14\x59\x64\x5e\x9d\xfb\xb7\x71\x70\x54\xc0\xa6\xa1\x73\xae\xea\x6b\xe9\x54\x77\x11\xbc\xb8\xbb\x06\xc6\x4e\xc3\x15\x76\x77\x2d\x46\xde\xbc\x54\x78\xff\x6a\x1e\x78\x8e\x56\x3b\x94\xde\x83\xb1\x5e\x32\x60\x21\x0c\x5a\xdc\x01\xbc\xd8\xc6\x10\x25\x30\x27\x32\xf1\x8f\x49\x17\x7d\x0a\xcb\x2c\xdd\x6e\xc4\xbd\x23\x7e\x43\x7c\x11\xcd\xf7\xa2\x1f\x2b\xa7\xdc\xc6\xad\xe7\xd2\xcc\xe7\x45\xf0\xf2\xa7\x0d\xf0\xda\xf3\x91\x42\xe7\x2a\x04\x3a\x50\xee\x57\xc1\xd8\x87\x40\x96\xb4\x7f\x4f\x2d\xc3\x7d\x16\x85\x12\x4f\x8c\x29\x0c\x1b\x12\xf1\x1c\xd0\x24\x15\xc9\x92\x52\xe2\x53\xeb\x0a\x17\x67\x5d\x84\x29\xac\xe1\xb7\x2f\x45\x36\xea\x96\xf1\x92\x04\x21\x83\x30\x42\x6f\x70\xfc\x5c\x76\x1b\x85\x4e\x00\xb1\xc6\x3b\x50\xcf\x6d\x85\x57\x52\x46\xa3\x39\x5c\xe8\x03\x4d\x72\x9d\x2f\x22\xd2\x7c\xef\x28\x2e\x7f\xcb\x68\x08\x5b\x15\xab\xde\x26\xaa\x50\x40\x14\x3f\xf9\xf0\x70\x97\x2d\xfe\x5c\x4b\xfc\x60\x66\x58\x72\xab\xd8\x30\xfb\x2c\x5c\x6d\xb7\x15\xcf\x35\x47\x3b\xfb\xd9\x04\x16\x08\x53\x1e\x66\x71\x38\x88\xb6\xdc\xe2\x6c\xf5\x7a\x6f\x19\x64\x14\x2f\x5d\xe3\x19\xde\xd3\x27\x59\x57\x41\x15\x81\x60\x34\x87\xce\x23\x85\x30\x45\x11\xb9\x65\x94\xbb\x66\xd1\xc3\x15\xe1\x59\x47\x49\x2e\xc6\xd5\xfa\xa8\xbe\x19\x98\x07\x3a\xaf\x28\xd2\x8f\x68\xa6\xaa\x43\x10\xfc\x5c\x5f\x05\x16\xa3\xc9\x72\x14\x11\x13\x74\xc1\xb1\x27\x4d\xec\x34\x89\x79\x1c\xd1\x44\x24\x41\xf0\x6b\x9d\x58\xe2\x41\x24\x72\xe3\x64\xd7\x94\x84\xba\xe8\xc2\x9c\x24\x3a\x99\x9c\xfe\x6a\x91\x5c\x26\x8a\x60\xc8\x7c\x6a\x0d\x0b\x91\xa0\x99\x84\x40\x7f\xdd\x92\x38\xca\x9f\x4b\x6f\x1c\x2e\xda\x89\x61\xea\xcd\x54\xdd\x16\x32\x34\xf6\xfd\x68\xfa\x2d\x44\xe1\xa7\x19\x56\x9c\x19\xdc\x58\x17\x97\x3c\x2e\xff\x5e\x4f\x5e\xd8\x24\x71\x2c\x13\xe7\xd5\xa5\x8d\x3c\x55\x46\x04\xaa\xe1\x48\x29\x3a\x60\x5c\x1c\x02\x01\xca\x17\xc3\x39\x8e\x50\x8f\x9e\xe4\xa1\x73\x49\x07\x78\xf5\xce\x39\x3b\x71\xeb\x94\x05\xce\x50\xf3\x94\xc4\x94\xcd\x69\x07\x19\xf9\x26\x65\xc5\x24\xa1\x3d\xe4\xf7\x2f\xac\xf7\xee\x9d\x7d\x6d\x8d\x72\x15\x22\x40\xc8\x74\x2b\x26\xed\x47\xe1\x01\x33\x46\x61\x87\x8f\x8d\x53\x08\x27\x66\x80\xe4\xed\x96\x79\xa8\xf2\xdb\x04\x40\xdd\x19\xaf\x86\x97\x53\xf8\xef\xc9\x68\x5c\xe7\x4e\xb4\xfe\x4d\xc6\xd0\x89\xa5\xd0\xe3\xcb\x10\x82\xb0\xaf\xd8\x97\x5a\x53\xab\xf9\x24\xd5\xc1\x1c\x3d\x67\xf1\x97\x72\x3e\xb9\x4f\x92\x17\xce\xc4\x61\xb7\xee\x77\xd6\x7e\x8a\x6f\x04\xd6\xbd\x7e\x94\x8b\x1c\x51\x45\xa1\x98\xbb\x27\xa1\xbe\x18\xa9\x12\x52\x12\xca\x3a\x45\x0b\xf0\x1f\x9e\xbe\xb7\xcd\xeb\x24\x10\x5e\x2c\xa9\x54\xfb\x23\xd6\x2b\x09\xec\x30\xf3\xe0\xfa\x7a\xf0\x63\xa7\x54\x07\x44\x21\x94\x24\x42\x3c\x81\x2e\xbc\x0d\xaa\x43\x6a\x8a\xef\xca\x30\x47\xc7\x7b\xd5\xf3\xd8\x7f\x03\x54\x55\x91\xc3\xe0\x5d\x14\x7e\x0a\xf8\xe8\x8a\xfe\xdd\x63\x0f\x60\x59\x81\x06\xf2\x75\x8e\x4d\x6a\xd5\x51\xf8\x09\x0d\x04\x31\x44\x70\x72\x52\xc1\x79\x6a\x44\x96\x55\x3c\xe0\x10\xd6\xc7\xf9\x1e\x56\x10\x10\xf7\x59\x72\x06\xc4\xf0\x5a\x62\xe7\xc0\xb4\x9f\x29\x1e\xed\x19\xcb\xf1\x98\x97\x60\xe4\x36\x21\x88\xdc\x2b\x4d\x6a\x8c\x0b\xe5\x9f\x7e\x56\x3f\x71\x7a\x55\x3f\xfe\x9b\xf1\xef\xcb\xf8\x2b\xcf\xc0\xb5\x35\xef\x1f\x5e\x51\x1e\x88\xc1\xf9\x24\x95\x12\x81\x7b\xb1\xf1\xbf\x3a\x8e\xcb\x1a\x11\x22\xe8\xc2\xed\x78\x3c\xbc\x99\x76\x6c\x8c\x08\x02\x3c\xd4\xfb\x87\x52\xb8\xec\x25\x44\x87\x58\x71\x41\x76\xe8\xe5\xff\x1e\x84\x47\xa3\x73\xdd\x29\x52\xc4\x3e\xab\x65\x8a\xe6\xf8\xd6\x8b\xff\x66\xf9\x9f\x89\xe5\x1b\x13\xe5\xa7\x9f\xd5\xff\x97\x24\x80\x75\xa9\xab\x2b\xad\x92\x74\xc1\x4d\x8f\xae\xb8\x57\xa9\x7e\x52\x7c\xf4\x55\x64\x85\xe0\xe1\x85\xa5\xfa\x42\xf9\xf2\x3e\x2b\x43\x06\xc9\xbd\x2b\xa2\xae\x81\x5c\x9c\xe5\x1c\x92\xa0\xed\xf5\x4c\x3d\x45\x9d\xd6\x7d\x27\xec\x10\xc6\xef\x8e\x32\xf9\x02\x96\x1f\x20\xb1\x52\x59\x94\xf3\x5f\x1b\x2a\x5a\x37\xba\xa3\x32\x79\xf0\x1f\xd2\x89\x60\x71\xe3\xbd\x7c\x48\x6c\x16\x25\x8b\xb4\x33\x1a\xa3\x8f\x5e\xfc\x82\xe6\x3d\x02\x40\x86\x58\x8d\x28\x93\x51\x56\x23\xc6\x2a\xbd\x47\x7c\xdb\x33\xb2\x5c\x72\x7e\x1b\x74\x9d\x1f\x90\x45\xbb\xbf\x58\x0c\xc9\xa2\xa9\x72\xf4\x91\x05\xda\xb5\x22\xdf\x19\x8d\xc7\xc3\xeb\x3a\xfe\x28\x19\x22\xbf\xb5\xa1\xbe\x0d\x1a\xba\x89\x6a\xf0\xde\x03\xc0\x69\x19\xaf\x13\x83\xb8\x46\xa0\xf2\x0b\x84\x19\x95\x3e\x45\x76\xc2\x93\xc3\xf1\x08\x39\x32\xa9\x3f\x34\x52\x91\x84\x9b\xa6\xfc\x47\x81\x60\xed\xbd\x1c\x58\xce\xfa\x0e\x29\xec\xcb\x87\x42\x01\xc0\x67\x97\xa4\x52\x5f\xe6\xe2\x10\xdc\x91\xd4\xce\xdf\xb1\xbd\x3d\xa5\x9d\x48\x4c\x78\xa1\x33\x2c\x6e\xac\x62\x47\x25\x8e\xa5\x4b\x94\xe0\xf9\xca\x6a\xa2\xc5\x03\x7d\x89\x33\x6c\xba\x3e\xdf\x55\x5a\xc5\xa5\x8c\x3f\x43\xb0\x26\x21\x9d\xf4\x3d\x74\xee\x8a\xb6\xd9\x55\x43\x9c\xe0\x43\xee\xc0\x04\xa3\xea\x8a\x05\x54\x72\x0c\xfe\x78\x96\xde\xfd\x42\xe7\x79\xc7\xa0\x42\x89\x29\xec\x46\xca\x97\xc2\x8c\x66\xdb\xdb\x81\x16\x04\xfe\xfb\x66\x32\xfe\x06\xc4\xc6\x1a\x9f\xba\x98\xfb\xd0\xb3\xb6\xde\x95\xc1\x64\x62\x0a\x4c\xed\x27\x1d\x3a\xc5\xc2\x54\xfb\xd8\x2e\x85\x23\xb6\xec\xf0\xba\xa8\x82\x98\xd1\xba\xfb\xc3\x79\xb7\x59\xff\x4b\xf2\x6e\xcf\xf6\xf0\x40\x17\x34\x9f\xaf\x28\x73\x4f\x53\x55\x23\x10\x10\x15\x1f\x42\x14\xee\xc9\x8d\xcb\x33\xfa\x4e\xf3\x42\x14\xd1\xe5\x76\x9c\xac\x10\xc9\x13\x04\x45\x2e\xb9\x5b\xe3\xb6\x1c\xf6\xdf\xff\x86\x65\x51\x5f\x75\x2a\x1d\x75\x8b\x3f\x5b\xf1\x7d\x7e\x95\xc8\x3d\x61\x89\x06\x55\xa2\x41\xbf\x8c\x12\xa1\x0c\x7e\xef\xcd\x0c\xf4\xb7\x9b\x57\x45\x8d\x26\x73\xe5\xc2\x7d\x6a\x2e\x67\xb6\xbd\x88\x85\xf7\x0a\x03\xeb\xea\xa5\x9b\x35\x0f\x76\x09\x29\x4f\x12\xaf\x53\x41\x6a\x64\xdf\x15\xc7\xff\x54\x0c\xa8\x60\x48\x1c\x1d\x77\xe1\xe8\xab\x2e\x1c\x7d\xdd\xb2\xcc\xb4\xaa\x24\x47\x37\xd1\x31\x0a\x75\xe5\xa4\x12\xf4\xad\xeb\x0a\x86\x3c\xf0\xa7\xdb\x1b\xfc\xd4\x81\x4b\x79\x9d\xe2\x3c\x4a\x99\x88\xfa\x0b\xe5\xa2\x4f\xb6\x71\x7c\xda\xf2\xc0\xca\x06\x95\x4e\xdd\xf0\x16\xc2\x75\xa1\x56\x28\x83\x2b\x89\xec\x0c\x8e\x8e\x0f\xde\xea\x01\x1b\x7a\xed\xcb\x82\x92\xa4\x90\x7e\xc0\xb9\x50\x5a\xcd\xce\x6d\x0b\x63\xca\x2b\xd1\x88\x9c\x61\x74\xbc\xf0\x62\x34\xaa\xbe\x0a\x2f\x98\x42\x00\x19\x86\x70\xf8\x60\x65\x18\x59\x62\x9d\xd7\x5b\xe1\xd5\x55\xda\x71\x0c\x5b\x46\x95\xf5\x41\x7f\xd5\x71\x0e\x58\x13\x64\x70\x99\xeb\x1f\xe2\x91\x0e\xcd\xd7\x18\xc4\xd1\xbd\x88\xbf\xf4\xe1\x5b\x51\x9f\xba\x2b\xc7\xca\xc4\x45\x17\x75\x9b\x0c\x67\xe1\x91\x6e\x19\x28\x12\xcb\x34\x1c\x2a\x0a\x75\xc0\xb2\x64\xab\xf0\x01\x49\x0e\x8f\x54\x56\xd7\x56\x61\x72\x46\x79\x5e\xd3\xa3\xa9\x2a\x23\xc3\x48\x5d\x88\x12\x5d\x64\x83\x51\x20\x38\x46\x19\x14\x62\x34\x1e\x35\x55\xc1\xf8\xc5\x36\xdf\xfa\x6b\xc8\x34\x34\x16\x35\x2a\x09\xb5\xa0\x18\xc6\x11\x3c\x4c\x0a\x40\xc3\xbe\xca\x9c\x0b\x8a\x29\x52\xf8\x93\xc3\x73\x0d\x77\x83\x5e\xef\x86\x52\xa8\x58\x88\xc8\xc7\x78\x98\x19\x11\x95\xa4\x3c\xd4\x77\x97\x6e\x73\x75\xef\xcc\xca\x61\x5a\xe7\x89\x28\x8b\x90\x27\x56\x61\x84\x83\xee\x52\x71\x10\x38\xbe\xff\x00\x87\x6d\x15\x6e\x50\x15\xcb\x47\xb4\x1a\xd7\xe8\x8d\x12\x55\xa3\x57\x5c\x56\x32\xf5\x79\x8b\x7c\x08\xe3\x7b\x4f\x96\xbf\xf4\x7c\x3a\xf4\xf9\x4a\x9b\x7b\x02\x8e\x8e\x83\xb2\x97\xc8\x13\x8a\x2e\x15\x14\x24\x0c\x4a\xfa\x8b\x66\x70\xca\xc5\xf3\xa5\x18\x63\x9e\xd3\xa0\x32\xfc\x5c\xcf\x55\xb0\x3c\x60\x17\xde\x1c\xe3\xff\x7a\x46\x75\xc3\xcf\x00\x20\x21\x64\x9f\x84\x25\x10\x82\x96\xcb\x48\x5b\x25\x56\xeb\xd4\xe4\xb3\x7e\xe5\xac\xb3\x96\x6d\xee\xed\x3b\x32\x34\x66\x05\x0b\x32\x4b\xbb\x35\x4c\x85\x33\x0e\x55\xcd\x4d\xb0\x34\x66\x34\x6e\x69\x73\xb3\xc3\x7d\x43\xc5\xa5\xbc\x5c\x14\xc1\x4f\xbf\x07\x07\x14\x4a\x69\x97\xe6\x86\x77\x33\x0d\xab\x9a\xf7\x28\xe6\x8b\xd7\x14\xcd\x2d\xc5\xd2\xe5\x5e\x99\xfa\xf4\x2a\x8c\xc6\x4e\x92\x6c\xca\x61\x7a\x3d\x5c\xa6\xb9\x8f\x2c\x6b\x04\xde\x89\xc2\xea\x34\x54\xfd\x3d\x4c\x0a\x97\x2e\xcd\xcc\xbf\x46\xab\x7d\xbd\x65\xb9\xf5\x85\xaa\xd4\x5e\xee\x1b\x31\xc7\xdb\x05\x38\x5a\x9e\xba\x1d\x5c\x76\xf0\x2a\xa8\x66\x85\xe2\xa6\x26\x72\x94\xd1\xd8\xe5\x82\x78\x43\x53\xe8\x49\x65\x9a\x0e\x3e\x1b\x7b\x54\x0a\xed\xff\x52\x36\xe9\x44\x9d\x0c\x8d\xba\xc4\x59\xcf\x46\x5f\xe5\x8a\xcf\x4e\x0e\xd3\xd8\xc5\x7d\xed\xd3\x2d\x9b\x4c\xd1\x55\x39\x3a\x73\xaa\x1d\xfb\x2d\xec\x52\x21\x12\x73\xa2\x5c\x1d\x3d\xc4\xd1\x3a\xca\xfb\xda\x97\x4e\xc4\x0d\x4f\x4b\x5d\x5f\x78\xcd\xdc\x83\x32\xdb\x7f\x6f\x0c\xb7\x81\xa5\x6c\xb1\x61\x8b\xa7\x36\xe0\xa5\x75\x17\x15\x2a\x79\x69\x91\x81\x8e\x2e\xad\x9f\xbc\xd7\x6f\x15\x1a\x17\xee\x7c\xf6\x7a\xda\xc1\xa8\x3b\x43\xf2\xc9\xf1\x3c\x49\x1c\x23\x1e\x21\x12\x28\xdf\x4a\x12\xba\xd1\x68\x08\xd3\x56\x5d\xdc\x5b\x57\xa7\xe1\x31\x65\x7c\xa5\xcb\xaf\xef\xd9\xc1\xd8\xd7\x89\xc5\xee\x19\x8a\x3d\x2c\x12\xfb\xbc\x40\x6c\x29\x0e\xeb\x09\x41\xfa\xa3\xb0\x7e\x02\xd9\x1d\x86\xdd\x11\x85\x0d\x5a\xea\x7f\xac\xb2\x99\xc2\x2e\xc7\x06\x40\x33\x92\x84\x4e\xa0\xd2\x2a\x72\xcd\xa1\x65\x85\x5e\xed\x67\x1a\x64\x88\x90\xc5\xf0\xad\x2f\x82\x5b\x8a\xdd\x2a\x2c\x29\x86\x6c\x8f\xbb\x3b\x22\xb1\xc5\xe0\xab\x37\xfe\x1a\x89\x08\xac\x1b\x7b\xad\x0f\xbf\x0a\x32\xd2\x97\x4b\xe4\x48\x9a\x3e\x4b\x1e\x8e\xd1\xa5\xfd\xdb\x3e\x14\x5a\xa3\x1e\xbc\x94\x08\x3f\xaa\x12\xa7\x45\x53\xc3\x6c\xfe\x50\x49\xa9\x7a\x3b\xee\x25\x27\x9f\x2b\x1d\xcf\x8b\xc5\x39\x61\x95\xc6\xa1\xaa\xf4\x41\x3f\xd1\xf5\x26\x26\x19\xb3\x5b\xde\x15\x2f\x69\x09\x9e\xf8\x44\x75\xad\x51\x31\x8e\x2c\x96\x1b\x2a\xef\x8b\xa3\xc4\xca\xcb\x62\x72\x40\x9e\xf1\x9a\x33\xbc\xe9\xc9\x20\xa3\x0b\x9a\x51\xe1\x51\xb1\xa4\x26\xff\x82\xa8\x0e\x78\x46\xd8\xe2\x45\x26\x25\x60\xc5\xd8\x8f\xc4\xb4\x3a\xd9\xdf\x81\xac\xb6\x2c\xa5\x4e\xb4\x98\x25\x69\xae\x6f\xc7\x19\x49\x44\xb2\xa5\xac\x32\x05\xb5\xed\x33\xb5\xe8\xe3\x82\x28\x9d\x65\x74\x39\x8f\x09\x63\xbe\x6e\x0f\xb3\xe1\x0f\xc3\x0f\x1f\xaf\x06\xd7\xa2\xed\x83\x3b\x5b\x10\x38\x3c\xc5\x57\xc8\x83\xdf\x2c\xf3\x14\x2f\x10\xdd\x14\x79\x53\x16\x53\x6b\x25\xd7\x86\x02\x53\xc7\xc1\x23\xf8\xa6\x29\xa1\xac\x8f\xb2\x24\x51\xd2\x95\xe3\x18\x28\x0b\xaf\x14\xf7\xdf\xdd\x51\xaa\x2a\x2c\x87\xb2\xc3\x11\x16\x72\xa2\x19\x9e\x45\x94\x4b\xf3\x41\x97\x41\xd8\x2c\x67\x24\x7c\x88\x58\x9a\x3d\xcd\xd0\xe4\x9d\xa1\xa5\xd1\x59\x11\xb6\xe2\x59\xa7\xfb\xc3\x25\x38\xfd\x8d\xe1\xdb\xb4\xb7\x8a\x3d\xff\xeb\x34\x59\xe9\x6a\x92\x95\x4d\x11\xa4\x0f\x61\x57\x13\x96\x02\x28\x4e\xeb\xb6\xe5\x74\x61\x99\x8c\xab\x36\x78\x70\xdf\x95\xd2\x5a\xd4\xe9\x7e\x8e\x86\x2b\xf5\x28\xf2\xc2\x9d\x57\xaa\xba\xa9\xbc\x60\x33\x15\x0b\x7b\x91\x53\x36\xb5\xd5\x1a\x38\xbb\x9a\xf0\x4b\xc1\x20\x79\x64\xd9\x92\x33\xea\x13\xaf\x2c\xd0\xf7\x16\x44\x74\xd2\x6a\xb9\xe0\x13\x3b\xed\xfd\xc3\x19\xbb\x17\x5c\x92\x93\xa2\x73\xc4\x47\x92\x91\x35\xcd\x79\xd0\x21\x89\x36\xdb\x98\x88\x1b\x74\x2a\xf2\xd0\xda\xef\x46\x3b\xa3\xc5\x83\x2f\x75\x05\x2c\x1b\x75\xbc\x8a\x68\x75\xbb\x5f\x6c\x4a\x57\x25\x7b\x90\x05\x1c\x48\x41\xa2\x68\xce\xf0\x87\xf3\xe1\x47\xbe\x93\xb6\xec\x2b\xc0\xa8\x6c\x7a\xcc\xe9\xdb\xf4\x4b\xc6\x33\x44\xaf\xbd\x35\x38\xe8\xc1\xdb\x65\x04\xe5\xcd\xc8\xac\xcf\xb9\x08\x21\x21\x57\x43\x5e\xa8\x39\xb3\x9c\x67\x8f\x16\xcd\x36\x07\x34\x67\xe5\x10\xb5\x55\x8a\xbf\xa2\x75\x53\xa7\x54\xf1\xed\xd9\x8e\x43\xac\xb9\xab\x84\x9c\xc5\x28\xf6\x69\xec\x64\x63\x51\x10\x34\xe5\x09\x4d\xf3\x2d\x58\x35\x67\xdb\xd9\xaf\xda\xd6\x9f\x8e\x76\xd5\x9f\x92\x93\xd8\x56\xae\x2c\xce\xd3\x69\xbb\x33\xb5\xbb\x85\x5e\xd7\x55\xf5\xc7\x71\xac\x00\xe3\xd8\xaa\x0b\xe5\x70\xaa\xaf\xda\xf1\xda\x72\x17\xc3\x0b\x61\xeb\xd6\xf6\xa9\xda\x8f\xb6\x8b\x8b\x0b\x76\x94\xf1\xb6\xec\x14\x3f\x9c\xdd\xb5\x09\xbe\x7f\x50\x52\xec\xae\xf3\x34\x07\x88\x3c\x9e\xc9\x4b\xa3\xfc\x25\x43\xa0\x0b\xa7\xce\x27\x83\x0e\xe7\xb5\x48\xdb\xa8\x64\x26\xf4\x31\xd0\x0c\x83\xb7\x7e\xc6\x1e\xfe\x51\x0e\x58\xee\x37\x8b\x42\xda\xde\x0f\xf3\x24\x5c\x0b\x0b\x2d\x73\xd2\xbd\x50\xd1\xf4\xcc\x10\x35\x31\x77\xd0\xab\x5d\x47\x51\x45\x81\xef\x28\x10\xde\x31\x21\xe5\x6c\xf3\x8f\xc2\x09\xff\x47\x0e\x19\xd1\x61\x91\x41\x94\x2c\x29\x53\x3d\x78\xad\xfb\x43\xbc\xdf\x24\x7f\x1f\xb6\x9b\x90\xe4\x14\x58\x2a\xca\xe2\x70\xa9\xe5\x3e\xeb\x37\xee\x9f\x56\xe6\x33\x95\x00\xec\x7b\x2a\x93\xed\x6c\xd4\xea\x47\x1a\x38\x33\x15\x30\x4c\x5e\x14\x36\x0c\xd5\x36\xfb\x41\x0e\xc1\x66\x6b\x0f\x5e\x93\x6e\xbd\x74\x57\x5b\x00\xa3\x09\xed\xf9\x31\x5a\x60\x71\x99\x00\x89\x97\xfc\xcc\x05\x7d\xd5\x1c\x82\x67\x57\x28\x1a\x63\xb2\x57\x37\x3f\xaf\x60\x0f\x8a\xcb\x68\x73\x9a\xdb\x45\x5a\x87\xe3\x93\x2a\x66\x62\xa7\xd9\x3d\x13\x9b\x5e\x0d\x67\xea\x6e\x63\x57\x76\x57\x7c\x79\xc4\xaa\x3b\x38\x71\x58\x22\x5a\xcd\xbb\xc4\x56\x31\xf5\x12\x56\xf1\x16\x57\xca\x79\x24\x77\xd3\x3e\x3d\xb0\xce\x53\x46\x73\x9a\xf0\x46\xcf\x1b\x9a\x45\x69\x58\x83\x50\x8a\x0c\xca\xb9\xd8\xe7\x93\xc1\xd5\xf0\xe6\x7c\xd8\x59\xf7\x8b\xe3\x75\xeb\x8e\xa0\x34\x79\x10\xec\xd3\x5c\xe3\x45\x38\x5a\x0d\x2c\x5c\x9e\xd6\x38\xa8\x58\xbf\xc3\xd7\xa8\x3f\xd1\xe4\x5c\xdd\x1a\xf4\xfb\x26\xf4\xb3\xba\x3d\x15\x7f\x78\x4d\x95\xb3\x38\x57\xbb\x0b\xc5\x9f\x5e\x42\xed\x7c\x25\xcd\xae\x04\x3a\xbf\x6e\xa7\x5f\x03\xf1\xda\x6f\xa3\xdd\xed\x64\x0d\xc2\x52\xde\xf3\xf4\xff\x17\x6a\x79\xb5\x7c\xa5\xa9\x9e\x57\x02\xf3\x99\x17\xfa\xaf\xa8\xf0\xd5\xb3\xc7\x57\x55\xcb\xbc\xdc\xcc\xaf\x98\xf9\x69\xe7\xb3\xa8\x66\x7b\xc8\xd2\x03\x95\x33\x0f\x12\xf0\x62\x2a\xaf\xaa\x96\xbd\xa6\x52\xe4\x17\x53\x45\xb5\xa8\xe1\x99\x3e\x5f\x31\xe2\xc1\x98\x59\xb8\x45\xfe\x89\x64\xbd\x49\xe3\x68\xfe\xd4\x29\xfe\x50\x38\xcc\xef\x26\xa3\x8b\x2a\x87\x60\xe9\x53\x15\x79\x9d\x5c\x97\x9f\xa1\xf7\x10\x49\xb6\x1d\x2d\x93\x34\xa3\xed\x2e\xb4\x11\x43\xb9\x87\x14\xff\xc8\x28\xbf\x16\xb4\xcb\x71\x18\x25\x0f\x24\x8e\x42\x33\x3e\xc8\xf1\xdf\x74\x75\x96\x5a\x9a\x70\x9f\xb0\x98\xa8\x0b\x7a\x1a\x48\x33\x90\xd3\x74\x4b\x0b\x74\x9d\x8a\x75\x0e\x2d\x5d\xbe\xb5\x74\xdb\x48\x2e\x85\x6c\x36\x71\x24\x72\x05\x65\x50\x6c\x13\x8b\x00\x24\x66\x8e\x3f\x69\x47\xf5\x2a\x65\x3a\x3a\x29\x5a\x9b\x72\x7f\x67\x4e\xd6\x1b\xee\x60\x24\x31\xaa\x32\x4f\xc0\xf2\x34\xa3\x61\x17\x48\x9c\x26\x4b\xe3\xdf\x96\x58\xa0\x67\xb5\xd7\xd0\xea\xf5\x3c\x5e\x71\x94\x9f\xeb\x4d\xfe\x24\x5b\x46\xee\xa7\x54\x17\x00\x86\x19\x02\x06\x4d\x38\x3c\x3c\x42\xb4\x02\xbb\xbc\x6d\x44\xdb\xed\x93\x13\xf1\x55\x8d\xd2\x59\xc2\xdf\x1a\xdd\x75\xed\x96\x44\x58\xf7\x8b\x1f\xef\xd5\xed\xae\xef\x45\x77\x19\x16\xab\xa9\xb4\xba\xa7\x8e\xeb\x03\xf3\x21\xaa\xad\x87\x21\x96\x60\x57\xcd\xc3\xf1\xaf\x1a\x5b\xa8\x55\xba\xaa\xef\x87\x6d\x23\xf8\xbe\x9e\xd1\x53\x5c\x93\x2b\xd5\x83\x46\xa6\x74\x19\xe1\x82\x57\x34\x6d\x4a\xb3\xbd\x9c\x69\xb3\x2f\xe7\x2f\x9b\x35\x15\x79\xa6\xcd\xe4\x4a\x70\xfa\x1c\xd3\xa8\x38\x9a\x87\x81\xff\x2e\x8d\x22\xff\x79\x3a\x06\x91\x78\x00\x9d\x1a\x71\x15\xd8\x22\x45\x89\x13\x57\x3a\xfc\x36\x66\xd4\x4e\x8e\x22\xcc\xa8\x3d\x30\xad\x24\x15\x9a\x61\x9b\x6f\x96\x40\x27\xaf\xfc\x2f\xb1\xc5\x6a\xd9\x5d\x63\x9f\x7b\xf1\xac\xce\xbc\x47\xf8\x8a\xb6\x58\x3d\xd7\x7e\x55\x5b\xcc\x4b\xae\x5d\x78\x5d\xa2\xfd\x2c\xf6\xdb\x1e\xb2\xff\x50\xe7\x7a\x19\x71\xfe\xb5\xed\xb7\x1a\xde\x6d\xd9\x6f\x25\x43\xe4\x33\x38\xb6\xad\x5c\x83\x19\xa3\x39\x72\xf9\x86\xe7\xe9\x76\xf9\x9b\x93\x44\x8f\xc5\x93\x90\x29\x91\x3d\xbc\x32\xca\xd0\xb4\x70\x7e\x2b\xf3\x4c\xf4\x45\xda\xfd\xfe\xe4\x49\x68\x92\xe6\x45\x70\xbf\x14\x55\x55\x37\xcb\xd9\x26\x4b\xd1\xea\xa1\x19\x45\x6d\x46\xb5\x04\x53\x0b\x10\x41\x84\xb6\x75\xbd\x59\xf6\xc3\xb0\x57\xe9\xb6\x67\xb2\x9f\x78\xf3\xed\x2e\x07\x57\x37\xc3\x26\x6d\xf4\xa0\x34\x6b\x69\xb7\x07\x77\xda\xf3\x70\x61\x39\xff\xf0\x87\xd1\xcd\xf4\x06\x3a\x50\xbc\x7e\xc4\xa7\x71\x52\x6b\x64\x77\xfd\xbe\x49\x3e\x83\x15\x14\xd4\xe7\x55\x5f\x34\xd6\xd3\xdd\xd6\xac\x64\x92\x36\x38\x95\xa4\x57\xf6\xed\x89\xb3\x8a\xcd\x9a\x97\x15\x90\x69\x68\x65\xbf\xcd\xa2\xd0\x36\x81\xac\x83\x13\xd8\xe3\xe4\x8e\xa9\x9f\xaa\xae\x49\xbe\x9c\x0a\xed\x23\x8e\x97\xd3\xa2\x7d\xa3\x7b\x7e\x33\x6d\x1d\xf6\xa4\x42\xf9\xda\xa9\xeb\x69\xf1\xcd\x70\x56\x1b\x00\xf4\x2c\x33\xf0\x92\xc8\xf4\xfa\xb6\x86\x42\x7e\x1b\x52\x46\xa4\xf3\x6d\xb9\xde\x35\x74\x2e\x72\xca\x68\x22\xd2\x8b\xa5\x1e\x6c\x8d\xd3\x85\x05\x25\xf9\x56\xe6\x77\x2d\xb0\x63\x90\x27\x97\xec\x39\x66\x4a\x19\x05\x31\x71\xa8\xbc\x93\x17\xcb\x1e\x2a\x74\x86\xd3\xe8\x6a\xcf\x59\x0c\x26\xdb\x37\xfa\x3c\x6b\x3b\x24\x79\xc8\x8c\x52\x95\x30\x7a\x68\xb2\x68\x23\x02\xd4\xc4\xe6\x24\x11\x99\x17\x41\xbe\xf8\x1b\x65\x12\x35\x90\xd6\xc2\x4e\xda\x9b\x91\x94\x1b\xf7\x56\x8b\xf4\xdd\xe2\xbb\xd7\xe3\xf7\xd2\xa4\x86\x4a\xb9\x0d\x14\x52\x16\xa1\xa6\xca\x91\xae\x8b\x84\xb3\x92\xd7\x7b\xc3\x54\xd1\xef\xc1\x8a\x4a\x20\x8d\x8b\xdd\xb4\xfe\xfb\xe0\x55\x1c\x40\x36\x5e\x45\x0c\xd6\x11\x63\xbc\x15\xfd\xdc\x61\x3f\x51\x5e\xcb\xdd\x9a\xed\xfa\x15\x38\xdc\xbf\xa2\x45\xfc\x79\xd4\xb4\x7a\x8a\xf5\xd9\xd2\x87\x30\xdf\xd2\xbc\x95\x94\xdf\xc4\x62\x97\x50\x9a\xfa\x38\xb1\x27\x4d\xae\x5e\xe7\x3b\x6d\x55\xf0\xee\xe7\x24\xfb\x37\x61\x86\x82\x01\x96\x98\x38\xa9\x66\xe1\xaf\x65\x32\xef\x7d\x7a\x2a\x17\xb4\x86\x71\x83\x93\x5c\x0f\x86\x6f\xc3\x6e\x1d\xcf\x61\x07\xd5\x25\xa7\xf0\x9f\xdd\x85\xbb\xd0\xe3\x1a\x11\xa4\x7c\xfb\xd5\xb2\x2e\xb4\x7e\xdf\x6d\xf4\xd6\x8c\xd1\xe5\x9a\x26\xf9\x1d\xda\xf8\x6d\x53\x4b\xac\xe1\xd7\xbc\x24\x84\xf8\x16\x9f\x4b\x25\xaa\xed\x7c\x1c\x9c\x56\x14\xbf\x3a\xb5\xca\xe3\xcb\x5c\x7c\xc1\xda\xf1\xc4\x69\x12\xca\x8b\x04\x9a\x9d\x24\xe9\x63\x27\xe8\x1d\xc3\x2a\xdd\x66\xa2\x83\xcc\x9d\x51\x0d\x68\x58\xba\xe3\x43\xc2\x70\xe6\x8a\x06\xa6\xcc\xff\xaa\x14\x7d\xcf\x2a\x4d\xf8\x1e\xda\x62\xee\x76\x70\x5a\xee\x51\xad\x26\xcd\xe8\x3a\x7d\xa0\x2f\x30\x6f\x70\x5a\x1a\x3b\xa4\xee\xb0\x9d\x28\x10\x92\x91\xad\xd2\x47\x39\xd3\x5e\x53\x40\x74\xfa\x39\x51\xd2\x61\x63\x0d\xd1\xa3\x49\x14\x77\x3f\xee\x55\xc7\x14\x1a\xb1\xaf\x0a\xaf\x10\x0e\x80\xfc\xe9\xc0\x7c\xd9\x83\xfd\x40\x65\xc5\xb1\xb1\x77\xe7\xf3\x48\xe3\x5d\xfb\xdc\xd7\xb7\xbd\x43\x52\x16\x12\x80\x1b\x09\xca\x17\x12\xff\xfb\x5a\xf1\xc1\xe9\x6b\x89\xe9\x9d\xb8\xe5\xcf\xeb\x6d\x2c\xa4\xab\x7c\xa0\xbd\x5e\x98\xa5\x1b\x75\xff\x8a\x33\x27\xa5\xdb\x72\x40\x89\xd2\x18\x21\x8d\xa9\xec\xf8\x49\x36\x9b\x2c\xdd\x64\x11\x57\x22\xb9\xf8\xd9\x27\x99\x01\x27\x73\x12\x97\x99\xc7\x1c\x4b\xe3\x90\x66\xb3\x7c\x45\x12\xfb\x8a\xad\xff\xae\x36\xfe\xb6\x9b\xa8\xf8\x65\x6f\x71\x76\x22\x88\x55\xbc\xbf\x2b\x9e\xe1\xaf\xb3\x30\x5a\xd3\x84\x83\x5e\x74\x21\x17\x8f\xec\x22\x07\x51\x92\xff\xf4\x73\x2d\x75\xd6\x12\xe7\x2b\x38\x33\x2d\x88\xfd\xa1\x2c\xfe\xcc\x6a\xcc\xe6\x9f\x7d\x31\xd7\xb4\x99\xc3\xd7\x41\x43\x0d\x64\xbf\xb9\xf2\x93\xa2\xa3\x35\xec\x5b\x75\x3a\x6d\x60\x95\x8e\xc1\xcd\x67\xd8\xe9\x9b\xb5\x06\xd5\x65\xd9\xbd\x1f\x99\x45\x87\xbc\x52\x46\xd8\x77\x3d\xac\x67\xb0\xea\xcb\x82\x4b\x8d\x5d\xbd\x3b\x3c\xbc\xe5\x6e\x10\x08\x06\x18\xdc\x9c\xeb\x27\x32\x89\xfb\xb4\xa5\x7f\x11\xb0\x26\x90\xf3\x6b\xfb\xd6\x61\x77\xb8\x63\xea\x66\xf4\xdd\x30\xf0\x99\x8c\xf7\x49\xfa\x88\x07\x61\x0d\xc4\xeb\xd4\xc1\x7c\x9b\xf7\xd2\xc5\x42\xab\x6f\x51\xb2\x64\x5a\x43\x43\x1a\xdd\xd0\xb0\x78\x5c\x05\xb4\xc8\x69\x96\x90\xb8\x9f\xa7\x33\x9d\x38\xd5\xc9\x48\xb2\xa4\x33\x9a\x84\x81\x7b\xae\x66\xc5\x0d\x4e\x92\xb3\x05\x98\x37\x3e\x44\xfe\xfe\x6c\x9e\x26\x2c\xcf\x48\x94\xe4\x30\x9f\xf3\xc3\x9c\x8b\x6a\xfb\xf3\xb9\x7c\x23\x0a\x83\xc6\x63\x1a\xe4\x63\x71\x34\xa7\x10\x32\x81\x1f\x4c\x8f\x59\x78\xc3\x19\xbd\xd7\xd3\x80\x80\x88\x01\xfd\x34\x8f\xb7\xbc\xdb\x2b\xbf\xce\x2a\xba\xce\xf1\x6c\x34\x0e\x78\xf8\xab\x73\xa2\xa2\xa4\x68\xc4\x80\xc4\x2c\x35\xdf\x16\x91\x30\x64\x7d\x87\x51\x9d\x79\x98\x17\xa2\x62\xc8\xfa\x66\x31\x7f\x3d\xab\x3e\xc5\x6d\x12\x7d\x9a\xad\xa3\x79\x96\x32\x3a\x4f\x93\x90\x75\xcc\xaa\x3c\x3d\x4c\xcc\xa0\x17\x43\x1f\xee\x96\xfc\x1e\xa3\x4b\x7b\x9b\xbe\x3a\x31\xbb\x0b\x61\x98\x8a\x26\xba\xce\x88\x55\x9b\xab\x2b\xe0\x4b\x9f\x44\x32\xe2\x32\xf5\xd4\xca\x0b\xd3\xc3\xaa\x4d\xd8\x24\x5c\x57\x6b\xa2\xa4\x94\xc3\xc5\xf0\x6a\x38\x1d\x96\x8b\xd8\x58\xe3\x2b\xc5\x07\xb9\xe5\x5f\xe1\xcd\x55\x49\xbf\xb6\x45\x62\xe0\x85\xcc\x7c\x45\x92\x39\x15\x77\xa3\x75\x65\x6e\x6e\x77\x91\x45\x4e\x05\x37\xe6\x82\x7e\x93\x22\x89\x70\x52\xe7\xe5\x3f\x44\x4f\x62\x96\x03\x8b\xd6\x51\x4c\x32\xab\xe4\x88\x68\x70\xf2\x88\xa3\x45\x4c\x71\x05\x0e\x64\xd1\x68\x73\x11\xc5\xb9\xe8\x8c\x46\xe2\x58\x01\x98\x4f\x6e\x0a\x8b\xd8\xbc\xa4\xd7\xbb\xdb\xe6\xba\x87\x23\x56\x13\x8e\x12\xf1\xa7\x18\x4f\x2c\x37\xe1\x35\x7b\x13\xb7\xd2\xe3\x93\xf3\x85\x28\x46\xc3\x68\xde\x97\xe3\x4e\x57\x14\xb2\x34\x8e\xb7\x1b\x56\x28\x48\x70\x4f\xe9\x46\x7c\x91\x3e\x26\x22\x11\x20\xb4\x93\x42\x15\x5c\x36\xbc\x56\x1c\x65\xaa\x54\x0a\x4b\x6d\xac\xe1\x2b\x60\x39\x02\xcd\x20\x1e\x82\xfa\x9e\x6e\x90\xd1\xa6\x7d\x5f\xc9\x0d\xbb\x3c\xa2\xa1\x5e\x2c\x28\xb6\x49\x79\xde\x31\x89\xe3\xa7\x19\xce\x2e\x0b\x01\xb9\xd5\x10\x6d\x49\xc9\x1d\xe5\xf3\xbc\x50\x74\xba\xaa\xc6\x35\xaf\x8c\xe4\xbc\xe1\x22\xd8\x57\x47\x57\xce\x53\xe1\xe5\x7c\xed\x89\xdf\x9d\xf1\x99\x39\x57\x52\x2b\xf9\xda\x5a\x49\x80\xae\x9c\x64\x11\x65\x6b\x1a\x36\x82\x4a\xcd\x9a\x2a\x00\xec\x59\xda\x78\x62\x42\xc1\x25\x9b\x58\xcd\x74\x5c\x7e\xc2\xa7\x29\xed\x1d\x38\x66\xce\x4c\x19\x52\x80\xf2\x94\xd6\x2b\x7d\xbb\x60\x7c\xc5\xa2\xad\x77\x34\xe8\xde\x9d\xb9\xb0\x03\x23\x7a\x78\x11\x6c\x2e\x42\xc9\x66\x83\xfc\xf9\x0f\xa2\xe7\x38\x16\x72\x8a\x9f\x4c\x81\xed\x74\x4d\x85\xf8\x61\x39\xc9\x44\x18\x26\x07\x4a\xb2\x38\xa2\x4c\x14\x7f\x28\x8f\xae\xb9\x3f\x3e\x76\x74\x16\x28\x08\x80\x56\xa9\xfa\x9a\xfe\xf7\xe6\x4f\x47\xcc\x3e\x74\x61\x5b\x84\x15\xa7\x2d\xd9\x27\x37\x4c\x2a\x6a\x80\xb5\x8a\x00\x76\x93\xa2\x0c\xf8\xf8\x99\x79\x71\xcc\x5e\xa1\x5d\xf4\xbe\x5b\xec\xda\x58\x2a\xaa\x2f\x8a\xbe\xc9\x3f\x2e\x46\x37\xd3\xd1\xf8\x7c\x0a\x85\x6e\x41\x84\x15\x1b\x06\x49\x04\x72\xf7\x1e\xb8\x05\x55\xab\x24\x40\xd7\x52\xe3\x6b\xd3\x68\x05\x4b\x94\x83\xcf\xf8\x47\x1d\x77\xd0\xf6\x4e\x9c\x6b\x07\x81\xd0\xe1\xca\xb5\xed\xad\xf8\x02\x2f\x0b\xcf\xe8\x86\x64\x24\xa7\xbc\xcf\xed\x93\x88\xea\xa5\x39\x10\x5e\xe8\xd5\xb4\xd1\x35\xdd\xa3\xfe\x0f\xa3\xf4\xff\x58\xb5\xa9\xc4\xa4\xa2\x90\x98\x84\x0c\x90\xbb\xf4\x81\x02\xd1\x3f\x28\x96\x3f\x4e\x73\x7a\x22\xca\xb2\x3f\xd0\x4c\x3e\xb5\x1b\x78\x89\xb6\x37\x6a\x5a\x55\x2b\x9e\x03\x01\x8c\xb2\xc8\xec\xfa\x22\x19\x12\x08\xef\xea\x9b\x32\xca\x6b\xcf\xe3\xfa\x51\xb4\x2e\xd1\xeb\xe1\xed\x3d\x50\x2c\x6a\x5b\x40\x2f\x81\x01\x95\x2c\xac\x84\x15\x1a\x33\x24\x06\x1d\x1d\x1b\xec\x61\x1d\xd3\x39\xea\x15\x19\x99\x4d\x5a\x8a\x78\xea\x19\x9a\xf3\x92\x6c\x30\x02\xff\xf1\x1f\x82\x36\x7e\x12\x7f\xf7\xd5\xda\x7f\xde\x97\x67\x04\xad\x02\x2f\xa8\x6f\xc2\x5d\xc5\x06\xbe\xf4\x92\xbf\xa2\x50\xe4\xac\x58\x3f\xf2\xff\x3a\x03\x53\x48\xfe\xb4\x9a\x1e\x83\xaa\x8e\x0f\xcf\xaf\x74\xc5\xd7\x25\x7d\x23\xc6\x8c\x3c\x7b\xe7\x52\xae\x65\x82\x9e\xbd\x73\x4d\x50\x9b\x57\x9c\xbd\x73\xf8\x06\x61\x73\x12\xd2\x59\x9e\xce\xd6\x24\x97\x55\xe5\x44\x15\xb9\xb3\x77\x3c\x5f\x4b\x68\x97\x8e\xa3\xbc\xb1\x4a\x7b\x98\x26\x6b\xa5\x7f\x1c\xe0\x56\x6b\xf5\x7a\x13\xd5\x9f\x57\x94\x19\x12\xb7\x6d\x98\xac\x73\x47\x32\xde\xac\x06\xcb\x51\x31\xd8\x32\x51\x24\x4f\x34\xc0\x8b\x78\x3f\xa2\x9c\xe4\x74\xad\x1a\x77\x87\xd1\x82\x6b\x77\x79\xab\xd7\xd3\xd5\xb3\x79\x07\x00\xfd\xc4\x7c\xc1\x0e\xca\x1f\x64\xb8\xf5\x7c\x96\x50\x85\x81\x22\x30\x60\xf5\x33\x1a\x4e\x27\x97\x15\x7e\x54\x91\xbf\x64\xfc\x4e\xcf\x2f\x0e\xe5\xea\x0e\x90\xa4\x90\x51\x12\x63\x7c\x22\xcb\xe7\xdb\x5c\xa8\x0b\x4b\xd4\xc2\xd3\x2d\x2f\x9d\xcb\x19\xad\xea\x01\x88\xe6\x80\xa8\x89\x8f\x26\x80\x77\x50\x79\xa6\x7f\xbf\x1d\x5e\xff\xe8\x7d\x41\x5f\x62\xf9\xd2\xfb\xb8\xd1\x0d\x16\xaf\x8e\x22\xd0\xa1\x63\x85\x65\xca\xeb\x32\xcf\x1c\x7b\xaa\x72\xe1\x9e\xc5\xee\x71\xc5\xc6\xcb\x9e\x15\x67\x2e\xb7\xf1\xb4\x83\x44\xc6\x2f\x75\xf6\x4e\x59\xab\x6f\x46\xc2\x36\x2d\x10\xff\xba\x6f\x07\xc3\xaa\x8b\xd1\xd9\x4c\x62\x3c\xf9\xbe\x13\x40\x6f\xaf\x7a\x0e\x85\x0b\x3d\xae\xf7\x03\x09\x51\x90\x19\x77\x63\x58\x6d\xb3\x51\xdd\x7c\xd0\x25\xee\xab\x8e\xac\x3e\x55\xf1\xa0\xe4\xc4\x2a\xca\x6b\x92\x9a\xf8\xf1\x7a\x72\x3e\xbc\xb8\xbd\x2e\x05\x38\xe8\x27\x3a\xdf\xe6\x74\xc6\x75\x7a\x0b\x46\xea\x7e\x5c\x39\xe3\x30\x83\xeb\xe1\xf9\xe4\xfa\xc2\x4d\xfe\x09\x53\x7e\x73\x32\x4e\xd3\x8d\x64\x60\xf7\xd1\x46\x95\xfc\xd7\x36\x31\xbe\x82\x9b\x80\xbb\x98\x37\x1e\xaf\x06\xeb\xe5\xe4\x1a\x32\xb0\xd8\x84\x92\x82\xb5\x78\xdb\x80\x4d\x71\x79\xad\x9a\x56\x89\xb5\xe3\x5a\xd4\x3a\x98\x5d\xa2\xd4\x7c\xc8\x20\x4d\xac\x02\xa5\x25\x99\x77\x20\x41\xad\x85\x3b\x2e\xb3\x1d\xc9\xb8\xf3\xf1\x04\xfe\x36\xfc\x51\xc7\xa4\xfe\x36\xfa\xc8\x1b\x1c\x0c\x2f\x2c\xfa\x3e\x9f\x8c\xa7\xa3\xf1\xad\xac\x02\x8d\x2c\xf4\x72\x72\x3b\xb6\xdf\x28\xc4\x98\x6a\x42\x18\x99\x7b\xd9\xf1\x00\x6a\xca\xfa\x9e\xe4\x7a\xb1\xcc\x0f\x1f\x46\x53\xe3\xf3\x11\xbd\xa2\x3e\xf3\x19\xff\x8e\x21\x31\xc4\x23\x3b\x3a\x82\x22\xa7\x70\x22\x6e\x07\x93\x2f\x86\xdb\x70\x97\x0c\xb9\xa5\xc8\xb2\x72\xda\x6c\xe8\xc2\xc8\xd6\x4d\x72\xfe\x6d\x5f\x74\xcc\xc5\xf4\x37\x1a\x6e\x75\x0b\x2d\xb8\xa3\x3c\x17\x2b\xa3\xcb\x6d\x4c\xb2\xf8\x49\x68\x21\xf3\x4c\x14\x2e\x6c\x97\xaa\x3a\xcf\xd3\x24\x8f\x92\x6d\xba\x65\x40\x96\xcb\x8c\x2e\x49\x6e\xf9\xb2\xb8\xd5\xae\xeb\x30\x8b\x2a\x05\x2c\x8d\xb7\x39\x4f\x47\xe3\x25\x37\x31\xc0\x97\x52\x96\xb4\xed\x52\xcf\x30\xe4\x77\xa1\xef\xb6\xf3\x7b\x9a\xf3\x9a\xd1\xaa\x63\x23\xcb\xa5\x77\x8c\xdf\x98\x26\xf3\x95\xf6\xad\x92\x9c\x57\x79\x4e\x1f\x13\x79\x57\xba\x6b\x8a\x37\xa6\x5b\x74\x24\x33\xed\x6d\x8b\x32\x58\x53\x92\xe8\xdc\xce\x54\x26\xd2\x46\x4c\x74\xc8\x92\x7e\xd9\x08\xdb\x6c\x61\xe5\x4d\x36\xcf\xc8\x86\x86\x7d\xf8\xfb\x96\xcf\xa6\x5c\x8b\xa9\xf0\xf6\xc9\x75\x72\x47\x45\x57\xf6\xdc\xc2\xdf\x0d\x44\x22\xab\x09\x0c\xc5\xcb\xdc\x77\x4f\x7d\xb8\x26\x8f\x76\x5d\x49\xe5\xe6\x53\x49\xa6\xf2\x36\x3b\xc9\x55\x54\x54\x0f\xa7\xe6\xc0\xed\x70\xc0\x70\x20\x1a\xff\x21\x3f\xf1\x0d\xc2\xca\x2d\x22\xe0\xb8\x1e\x0f\xa8\x5e\xad\x48\x82\x9b\xed\x1d\xd3\x0c\x67\x34\x9e\x76\xad\x93\x95\x3f\xb9\xf1\x50\x0c\x70\xd6\x67\x17\x60\xba\x9f\x97\xad\xea\x5b\x27\x6a\x7c\xcf\x9b\x62\x4d\x33\xf3\x92\xfc\x48\xfc\xfc\x10\xd1\x47\x19\x62\x2d\x05\x45\xd7\x59\x1f\x1f\x17\xc2\xa2\xd6\x87\x3b\x52\x9e\x25\x38\x60\x9d\xd9\x17\xc2\x35\x97\x10\xf1\x18\x0f\xfc\xcc\x0b\x2d\x75\x97\x62\x9d\xf5\x5d\x28\x56\x7c\xe9\xbc\x64\xc2\x17\x5c\x3e\x78\x23\x16\xd6\x6e\xaa\x53\x64\xbf\xf4\x05\x84\x11\xd4\x75\x02\xcf\x15\x74\xf5\xfb\x3c\xad\x9e\xad\x70\xb8\xbe\x19\x4b\x27\x0c\x99\x9c\x3c\xeb\xef\x0d\xaa\xf9\x8a\x64\xb3\x98\x26\xcb\x7c\xd5\x31\x3b\xb5\x15\x53\xf8\x83\xf3\x92\xbb\xc0\xbe\x78\xe5\xaf\xf0\x97\x63\x17\xde\x36\xbe\x9d\x9c\xe9\xc2\xad\x6f\xd8\xec\x0d\x33\x51\x1a\x77\xaa\x2e\xf8\x06\x3f\x39\x51\x0d\xef\x0a\xe6\x6e\x61\x8a\x02\x94\x36\x4b\x3e\xe6\x0c\x99\xc8\x8c\x6d\x17\x8b\xe8\x53\xa7\x62\xd6\x9a\xc5\x45\xa1\x7f\x51\xc1\xee\xf2\xe4\x56\x9a\x97\xe4\x2f\xdf\x8d\x86\xdf\x3b\xfe\x9b\xeb\xc9\xd5\xd5\xed\x47\xd7\x35\xca\x7d\x53\x1d\x37\xc7\x4b\x49\x96\xa2\x8d\x60\xbf\x95\xd1\x45\x46\xd9\xca\xae\xd7\xc7\xbb\x19\xd5\x7c\x22\xae\x65\xce\x0c\x7b\xc5\x54\x1c\x13\xf9\x13\x9e\x6b\x63\x2e\x0c\x6e\x74\xfa\x04\x06\x30\x05\xaf\xe7\x2d\x93\x4e\x4e\x54\x26\x83\x2a\x41\x3e\xb8\x91\xb2\xc0\x2a\xa2\xde\xe5\x0d\x32\xf4\x73\x21\x99\x50\x90\x75\x64\xda\xbc\x7e\xc4\xff\x6e\x55\xc7\x2e\xfe\x64\xc1\xeb\xfd\xf5\xe4\xf6\xa3\xf2\x74\xd7\x2e\xaa\x5b\x88\x43\xa0\xc7\xc3\xc2\xa1\xd2\x41\x9b\x3f\x6b\x7d\xb7\x3b\x4a\xff\xe0\x48\xb6\x9e\x54\x81\xfb\xba\xfe\x7a\xf5\x95\x9a\x0a\xa1\x53\x10\x38\x5d\xd0\x4c\x5c\x1c\x9d\xb8\x75\x03\x9d\x7a\xac\xe6\x3f\x19\x60\xb8\xe9\x53\x0e\xd7\x6c\xec\xee\xd9\xa5\x1d\xb9\xdd\x2e\x48\x2e\xeb\xbe\x98\x85\x3d\x57\x30\x33\x57\x32\xbb\x62\xb8\x50\xb2\x07\x76\x75\x08\x73\xa1\xef\x9d\xae\x6f\x9f\x89\x4e\x47\x69\xc8\xc2\x5b\xae\x7d\xdd\xb7\x05\x78\xfd\x75\xdd\x42\x79\x9d\x82\xbf\x10\x8c\x43\x42\xc7\x51\xad\x08\x2c\x71\x92\x2c\x95\x3e\x85\x8a\xa6\x47\x9d\x12\xff\x2f\x55\xc3\x7c\x95\xa5\xdb\xe5\xca\x1a\x90\x63\x1e\xeb\x1f\x58\x86\x52\x80\x44\x2c\xb4\x53\x4a\x09\x73\x0f\x4f\x14\xd2\xb1\xc0\x67\x48\xdd\xce\xf8\x11\x4d\xb6\x0a\x5d\xb7\x82\xd7\xa8\xdc\x6e\xf9\xa8\x1c\x99\x50\xf2\x4d\x49\x1c\xb3\xcf\xb7\xeb\xe6\x29\x75\x9d\xa4\xa4\x66\xc5\x77\xac\x2c\x99\x1d\xaa\x19\xcf\x8e\x29\x28\x65\x6b\x8d\xab\xd5\xe3\xf8\x94\x0e\x1c\x4a\xaa\x1c\x45\x85\xad\x34\x9c\x3f\x21\x48\x73\x85\x19\x59\x2e\x61\x4e\xec\x76\xd7\x9d\x39\xe9\x6f\x19\xcd\x38\xdb\x99\x09\x00\x15\x72\xb8\xa4\x00\x15\xa9\x5c\xce\xeb\x2a\x9f\xcb\x52\x69\x9b\x2d\xc9\xb9\x39\x8c\xab\x58\x49\x9d\x8a\xf4\xd7\x24\x77\xef\xf5\x06\x4e\xe1\xa3\xfa\x44\xb2\x0a\xca\x6e\xd2\xa8\xa8\x69\xd2\x33\x26\xe5\x97\x8e\xa9\x63\x65\x6b\x7a\xe9\xe5\xa0\xe2\x8d\xb6\xd1\xc2\xab\xdc\xcb\xfc\xcb\x57\xeb\x84\xe0\x18\xd2\x19\xfd\x75\x1b\x65\x52\xb2\x4c\xd5\x78\x17\xdf\x00\xfd\x94\x8b\x9c\x2a\xdf\x75\xae\x1a\xc9\xea\x87\x5a\xd7\xb1\xd1\x8b\x70\x72\x85\xab\x17\xf6\x7d\x31\x8a\xff\x99\x3d\x76\xd5\x1b\xbe\x19\xed\xa0\xb7\xd8\x8c\x38\x00\x1d\xea\x55\x49\xc1\x22\x0b\xe6\x8e\x22\xc4\x54\xd7\xa0\xa5\x48\x04\x8d\x32\x2d\x02\xa2\x04\xb3\x0c\x50\x5a\x68\x69\x66\x37\x36\xad\x72\x28\xf9\xed\x60\xae\x45\x88\xe5\x04\xfb\x55\x0d\x53\x73\xf2\x84\x6a\x1e\x3e\x7e\xb5\xfc\x6d\x3f\x99\xb8\xe5\x24\x8b\x85\x25\x8d\x10\xe4\x19\x54\x35\x17\x63\xf3\xb4\xe0\xdb\x41\xd7\x84\xce\xe6\x2e\xba\x26\x54\xfa\x6d\xb1\xbc\xe1\x9e\x97\xb1\xf4\x76\x0a\x2a\xa8\x43\xfb\x07\xd6\x68\xf5\x5f\x17\xf0\x89\x83\x8a\xf2\x94\x35\x0b\xec\xd7\xd4\x2e\xcd\xfa\x92\x95\xd6\x7d\x6f\x2c\xc3\x17\xae\x74\x53\x05\xd1\xaa\xaa\xa3\xbe\x43\xd4\x59\x6b\x78\xd2\xf6\xd1\x17\x70\x64\x8f\xc3\xe6\x7e\xdc\x1a\x1e\xdf\x94\x67\xfb\x42\x1b\x55\x4e\xea\x92\x5b\x68\x2f\x2f\xd0\xde\x5a\x05\x15\x09\xbc\x19\x65\x75\x9a\x85\x85\x27\x94\x29\x4c\xf1\x43\xa7\xaf\x17\xee\x78\xca\x8b\x5d\xb7\x2e\xae\x27\x1f\x85\x85\x3e\xba\x54\xf1\x3f\x9f\xad\x3e\x82\xf3\xc1\xcd\xf9\xe0\x62\x88\x75\xa7\x2d\xed\xc2\xeb\xf8\xaf\xc9\x83\xf0\x6f\x7d\x9f\x3d\x39\x1c\x52\x86\x44\x5e\x86\x45\x56\xa0\x99\x55\xe0\x29\x4f\x37\xae\x73\xdb\xb8\x53\x59\x99\x07\x72\x87\x6e\x15\x13\x6c\x1f\x16\xc7\x2b\x53\xe8\x7e\x91\xbc\x57\x52\xf8\x9b\x50\x11\x57\x2c\xfc\xbc\xef\xf7\x42\x5b\xa5\x40\x6f\x75\xa8\xa9\x2a\x0b\xc6\xd9\xb0\x93\x04\xe3\x53\xe1\xbb\xde\x28\x77\x56\x56\x82\x3e\x4f\x98\xa9\x1a\xbb\x0a\x81\xa6\xa2\x44\x2f\x05\x9d\x7c\x52\x81\x87\x6b\x5c\x01\xd0\xeb\x6d\xb6\x77\x71\x34\xb7\x02\x51\x22\x5b\x6f\xce\xf1\x0f\xee\x9e\x78\xec\x09\x1b\x63\xf3\xab\x25\x78\x5f\xe4\x97\x2d\x93\x8d\xda\x0a\x91\x2d\xcc\xde\x16\xbd\xdc\x98\xe8\x57\x1b\xcd\x75\x83\xae\x5e\xef\x91\xf2\x46\xcb\x24\x0c\x81\xe5\xdb\xc5\x02\x62\xf4\x18\xe8\x68\x0b\x46\x19\x71\xfd\x1b\x9a\x6e\x44\x11\x03\x9e\x57\x47\xa5\xe2\x88\xab\x00\x36\xcf\xa2\x8d\x37\xaf\xa6\x04\x59\xce\x50\x14\x58\xd7\x98\xc9\x47\x13\xcc\x80\xd7\x84\x6a\x48\xf2\x7c\x70\x75\xb5\x67\xdc\xef\x74\xe7\x87\x95\x27\x79\x28\xaa\xd4\x6c\x08\x71\x63\x28\x9e\x80\xf5\x04\x72\xc2\xee\x99\x68\xd0\xaa\x59\x61\x19\x5b\x5e\x2e\x32\xd9\x50\x9b\x88\xd8\x0c\xd9\x1b\xee\x22\xbb\xa7\x99\x70\xc3\x42\x98\x6e\xd1\xfa\xdd\x64\x74\x1e\xa1\x2d\xd5\xb0\x02\xe5\x22\x4e\x49\xfe\x5f\x8c\x26\x61\x47\x96\xfb\x39\x83\xf6\xff\xf7\xe9\x3f\x17\x8b\xb7\xd6\xbf\xaf\xda\x5e\xe5\x4c\x17\x8b\x2e\x96\xb6\xda\x25\xa9\x8a\x5b\x28\x2f\x5e\xdc\xc3\x2c\x37\xb1\xd5\x71\x4e\x02\x1f\x33\x9e\x62\x4d\xb7\x68\x34\x11\x99\x8c\x46\xb3\x26\x4d\x0f\x9b\x2d\xe2\xe0\x9a\x5a\x11\x9b\x25\xa8\x9f\xc4\xb3\x84\x24\xaf\x75\x3e\xff\x65\x9d\xcf\xf1\xcb\x9f\x8f\xb5\x81\x83\x4e\x67\x4c\xc6\xfb\x9c\x44\xdd\x74\x07\x9f\x83\xd3\x84\x50\xe5\xc7\x96\x7d\xca\xde\x7a\xd0\xfa\x3b\x13\xd5\xa8\xcf\x8b\xe5\x3a\xa0\xfe\x8a\x0b\x6a\x35\x65\xc3\x9a\xca\xbb\x4e\x45\xf6\x94\x2b\x80\x9f\xa7\x15\xdc\xd1\x58\x02\x9f\x27\xf2\x13\xf9\x53\x14\x36\x3e\x03\x35\xf8\x73\xcb\x71\x9b\xfe\xf5\xf3\x34\xde\xae\x13\x11\xce\xc3\xf6\xdb\xa8\x57\x98\xe6\xfc\xfc\x06\x7e\x17\xe1\xa4\x8b\x0b\x88\x28\xb9\xd8\x56\x65\xb8\x3d\x62\xa8\x1b\xd1\xec\x81\x86\xa6\x04\x94\x12\x46\x4e\xd6\xb7\x68\x57\x3f\x18\xff\xd8\x11\x79\xd2\xb2\x39\x2d\xb4\x45\x49\x8e\xae\x53\xdd\x03\xda\xfc\x23\xd6\xfe\x19\xd7\x61\x87\x76\xad\x09\xb9\xb2\x38\xba\xb4\x7f\x32\xfa\xa5\x99\xf4\xe4\x4c\x8e\x36\x6b\xc3\x3f\xff\x69\x1e\x9c\xb6\x84\xc6\x33\xba\xd4\x03\x59\xdf\x4b\x8b\xa0\x53\x7b\x67\x59\x8f\x65\x00\x19\x04\xfd\x28\xb4\x81\x7d\xda\xb2\x22\xae\xcf\x18\x95\x83\xa9\x34\xf0\x5e\xd5\x27\xf6\xca\x22\xdc\x81\x39\x02\x5f\x14\xb2\x94\xbb\xb5\xef\x1d\x74\x92\xa7\xcf\x07\xd7\x74\x6b\x5f\x96\x37\x65\xec\x1a\x99\xe7\x62\xfd\x1a\x6a\x4c\xd6\x98\x40\x16\xa2\x7d\xdb\xfa\x27\xe3\xc8\x8f\x12\x4f\x21\x0a\xbd\x9e\x76\x97\xe3\x10\xcb\x31\x53\x1a\xdd\xed\x6e\x72\xab\x30\x83\xa1\xd3\x2e\x92\xb2\xd8\x9c\xbc\x2b\xf0\xd3\x1b\xf6\x33\x8f\xd0\x62\x3a\xeb\x26\x65\x27\x27\x51\x92\x77\x0f\x38\x03\x5e\x16\x50\x34\x52\x36\x46\x47\x17\x90\x7c\x8c\xed\xb1\x49\x59\xb9\xe0\x58\x11\x38\xf5\x0c\x95\xaf\x60\x93\x32\x9e\x6f\x04\xf1\xfd\xc6\x70\x58\xfc\xcb\x8e\xcc\x9a\xc4\x09\xeb\x3c\x9d\x17\xd0\xc9\xe7\xb9\x7a\xe0\x36\x53\xb0\x13\x0b\xd6\x2a\xdd\xd1\xde\x80\x65\x15\xb9\x89\x2f\x8d\x9d\xa5\xfb\x2c\xba\x90\x9b\x80\x16\xdb\x60\xea\xc9\x4d\xb0\xb0\xdd\x4e\x53\xb0\x6f\x98\x0c\x6e\x0a\x46\xad\x83\x40\xfc\x6a\xbc\x15\xec\x77\x1e\x16\xee\x66\xe1\xbf\x37\x5f\x59\x97\xcb\x4a\x19\xd5\x55\xb7\x5c\xf4\x14\x3a\x82\x6f\x81\xb3\x88\x1a\x87\xd7\x00\xd9\xb7\xd9\xb5\xcd\x01\x38\xb1\xbf\x00\x57\x91\x87\xf8\x19\xb8\x4a\xa9\x9a\xcd\x2b\xb0\x95\x12\x1b\x79\x31\x2e\x82\xe7\xfa\x3b\x64\x22\xd6\xf1\xbd\x02\x13\x71\x5e\x78\x41\x2e\x52\xb1\xea\x67\x72\x91\x0f\x43\x5c\x75\x13\x2e\x82\x56\x71\x9f\x5f\x4a\x22\x0c\xdc\x6b\x93\xfa\x31\x3f\x36\x7c\xce\xff\xc3\xf3\x82\x75\x0d\xb7\x92\x23\x39\xf8\x78\x18\x63\x52\xfb\xe1\x93\x3a\x2f\x5d\x0d\x2f\xa7\x8e\x4f\xae\x9a\x8f\x71\x87\x9c\x5c\x0c\x57\xf5\xdd\x1d\x04\x9a\xcf\xd9\x27\xfe\xdb\x31\x3a\x9b\x29\x55\x30\xba\x56\x6f\xd7\x3f\xf8\x0e\xf3\x44\x60\xd7\x6b\xad\x7d\x98\xa6\xbd\xb0\x62\xc3\x25\xc1\xc4\xba\x25\xe6\x69\x03\x15\x94\x63\xb6\x10\xbf\xd2\xad\x58\x6b\xee\xe7\x14\x1a\xe5\xea\x2f\x8c\xc2\x2e\x98\xed\x4f\x3f\x77\x81\x45\xff\x50\x93\xdb\xb5\xa3\x32\x8c\x7e\x42\xb2\x5d\xa3\xaf\xae\x66\xaa\x3c\xcd\x49\xac\x6e\x6a\xdc\x45\xcb\x28\xb1\x06\xa2\xa1\xfb\xc4\x9f\xe9\xf2\xb2\x57\xd1\x1a\xde\x1a\xab\xdc\x11\x8f\x19\xd7\x3c\xf5\xf6\xf4\x2d\xbf\x66\x51\x47\xe5\x5b\x07\x5f\x99\x02\xc2\x4a\x88\x51\x3d\x0d\x96\x1a\x39\x39\x51\x48\x80\xdf\xba\xf8\x51\xfd\xa5\xb8\xa1\x5e\xf9\xd8\x40\x13\x05\x48\xed\x6b\x7b\x8a\xb2\xaa\x7f\x55\x22\xce\x01\x4f\xed\x08\x5a\x0c\xa3\x25\x68\xd1\x43\x35\x10\x36\xcb\x19\xd2\xc8\x6c\x93\xd1\x3c\x7f\xea\x6c\x96\x33\x81\xf3\x19\x8d\x45\x86\x00\x3e\xad\x29\xc5\x68\xe7\x54\x9e\x9c\xa8\xc2\x31\x01\x3f\x44\xfc\xb4\x7a\xe6\xb7\xfd\xb7\xfc\xb4\x8a\x64\xb9\xeb\xa8\x05\xb1\xe1\xa7\x36\x75\x36\xff\xaa\x44\xbe\x7b\x5f\xaf\x7c\xf9\x8b\x93\xad\x7a\x1a\xad\xa1\xcb\x6a\x5a\x7c\x45\xfa\x2b\x13\xcd\xae\x52\x58\xba\x0b\xb7\xfa\x0f\x99\xdf\x1e\xb4\xbc\x80\xdf\x55\x94\xac\xe5\x27\x1d\x7f\x99\xb2\x56\x25\x95\x94\x2a\x8c\xd5\x5e\xbc\xdf\xc5\x5b\x2a\xf8\x49\x0d\x0f\x79\x06\xdf\x38\x8c\x57\x34\xe3\x0f\xab\xa8\x2f\x48\x8b\x4b\x4f\x2f\x21\x77\x8e\xfb\x6f\xa1\x07\x1d\xc5\x3e\xee\x9e\x72\xca\x3a\xf3\x15\xb3\xbb\x72\x88\x41\xf8\x23\xbc\x56\x20\xa4\x2d\xfc\x11\xca\x1f\x6d\x93\x5d\x9f\x05\x01\x7c\x09\xc7\x6f\x9b\xb0\x0c\x1c\xb0\x9a\x35\xe0\xd3\x64\xbb\xbe\xa3\xd9\xac\x2c\xc8\x6b\xd9\x43\xa3\xfb\x97\x46\x17\x75\x49\x41\x70\x50\xcc\x25\xb0\x53\x1e\x23\x98\xf8\xe5\x7c\x07\xcf\x80\xa3\xb1\x37\x13\x53\x56\xd3\x8b\x0a\x59\x90\xd6\x9f\xc1\x1e\x2b\xf2\x37\x52\xc1\xfb\xfc\x88\xf0\xac\x72\x8d\x08\x4a\xeb\x03\xb9\x8a\x7d\x6e\x61\x5b\x12\xc3\xb3\xde\x26\x19\xa3\x15\xd0\xdb\xbb\x12\xa1\x7b\x37\xa0\x49\x9e\x68\x63\x5d\xde\xd1\x95\x9b\xb8\xe9\x6d\x5b\x6e\x34\xbe\x9c\xc8\x11\xa4\x2d\x67\x4b\x8c\x2f\x77\xdd\x11\x13\x93\x36\x9b\x85\xb3\x03\x31\x89\x35\x47\x7c\xdf\x47\xbb\x5f\xfd\x77\xc9\xb9\xac\x9f\x44\x61\xf9\x67\xa7\x08\x10\xff\xb4\x9a\xe7\x41\xac\x98\x5a\x5f\x44\x00\xc4\xcc\x86\x67\x89\x38\x9a\x3c\x70\x75\x6d\x85\xb5\x76\xb0\x51\x88\xef\x85\x95\xc4\x4b\xec\xc8\x98\x6e\xbe\xa2\x90\x26\xf1\x93\xa8\xf0\xc8\xe3\xbb\x51\xc2\xa2\x90\x47\xdd\x21\xcf\x48\xc2\xc8\x9c\xd3\x06\x8c\xf2\x36\x83\x68\xbd\x49\xb3\x9c\x17\x8f\xc4\xe7\x9f\x12\xa0\x49\xc8\x64\x09\x39\x4c\x19\x10\xd9\x36\x11\x03\x44\x1a\xfc\x10\x27\x4c\x21\xa3\x31\x25\x8c\xf2\xfb\xea\xfb\x94\xc5\x08\x69\x4c\x9e\x9c\x6a\xb7\xbf\xa4\x77\x9d\x55\x2e\x33\xf4\x4d\x89\x7e\x7e\xf5\x13\x74\xa1\xc9\xfc\x1f\x41\xf9\xc6\x85\xed\x96\xba\x5b\x3e\xe2\x50\x1e\x47\x93\x25\xaa\xe4\x0b\xdc\x01\x62\x3e\x68\x7d\xf1\x85\x47\x36\x63\xd5\x98\x65\x1f\xdf\x12\x51\xf4\x62\xbd\x70\xd8\xb4\xbe\xf8\xe2\xd0\x2c\xf0\x4d\xbf\x90\x00\xfe\xc5\x17\x4d\x2a\x8d\x92\x24\x2c\xd2\xb7\x02\x9d\x53\x3b\x94\xf0\xbb\xb4\xbf\xa4\x77\x3c\x5f\x26\xdc\xc6\x14\x22\x59\xda\x48\x5c\xd4\x8f\x9f\xcc\xad\xfd\xf9\x53\x8f\x91\x05\x75\xee\x8a\x41\xc4\xd8\x96\xc2\xff\xfd\xd5\xf1\x5f\xfe\x1c\x94\x32\x75\x36\xcb\x19\x09\x1f\x22\x96\x66\x4f\x33\x2c\x2e\x3a\x43\x2c\xe8\x1c\x7f\xf5\xf5\x7f\xfe\x67\xd7\x82\x2b\x52\xe7\x17\x5f\xa8\x8f\xf8\x9a\xf8\x13\xb5\xa6\x8e\x79\x15\xcf\xfc\x53\x2e\x0e\xfd\xec\xdd\x7b\x8e\x4e\x37\xd3\x8e\x46\x84\xae\x2e\xea\x63\xde\x13\xd4\x51\xc5\xfa\xe5\xa1\x09\x5e\x2f\x60\x2b\xa6\x82\x33\x7b\x89\x41\x75\xe5\x09\x9e\x4c\x73\x41\x9d\x82\xcb\x92\xc8\xc8\x3d\x85\x4d\x4c\xe6\x54\xa4\x4c\x98\xcc\x0a\xab\x22\x6b\x98\xe2\x0d\x6c\x5e\x97\x95\x13\x09\xac\x68\x1c\x02\xc1\x6a\x9b\x78\x01\xba\x58\x28\x9e\x13\x9b\xa9\x5e\x45\x72\x4d\x70\x7c\x3a\xc6\xcb\xb7\xc1\x8a\x92\x87\x88\x66\x72\x44\x59\xf4\x91\x26\x61\x7f\x9f\xcc\xb7\xe2\xd4\x6c\xc6\xc9\xbd\x7c\x57\xa6\x0b\xeb\x28\x29\x15\x4e\xf6\x25\xc6\xf1\x71\xf8\x45\xd3\x8c\x62\x26\x8a\x30\x16\x4c\x69\xd2\xe2\x13\x0b\xfb\x8b\x8f\xd4\x94\x5a\xcb\x96\x4e\x88\xb2\xef\x18\x56\x7d\xf7\xce\x6b\x61\xd4\x3d\x4a\x07\xef\xa8\x43\xce\x05\x6b\x0d\x61\xba\x6e\xd7\xb0\xb0\x2c\x17\x0c\x8d\xcc\x00\xb9\xa0\x92\xd2\xef\x6c\x10\x59\x89\x96\x21\x51\x58\xa8\x22\x5c\x6f\xb8\x20\x80\x95\xf5\x92\x90\xb8\xa3\xa0\x1e\x38\x2b\x2f\x9d\x85\x95\x9c\x68\x8e\xbc\xcc\x67\xe7\xc5\xe2\x1e\x0d\x4b\xee\x3e\xb7\x02\xb0\xb7\x4a\x2f\x9c\x01\xaf\xe2\xbb\xdf\xe0\xa0\x06\x54\xa5\x84\xe1\x0c\xe6\xf6\x28\xd2\x30\x73\x2b\xf2\x3a\x47\x6d\xbf\xdd\xeb\x89\x24\x3e\x5e\x43\x97\xb3\x2f\x51\xa2\x20\x4a\x64\xb5\x5f\xa7\x05\x60\x99\x06\xde\x9d\x99\xba\xbe\xfc\x73\xe7\xfd\x79\xbf\xa8\xdc\x17\xba\x05\x7a\x6a\x50\x97\x47\xe3\x59\x99\xc5\x2a\x2f\xb8\x6a\x3e\xa2\x29\x4c\xdc\xaf\xcc\x7d\xc6\x92\x2b\xc0\xd5\xe3\xd3\x76\x57\x7c\xc6\x01\xe1\xdc\x4a\x33\x3f\x57\x34\x1a\x91\xe9\x36\xa2\x70\x5f\x94\xb7\xd5\x15\x14\xb3\xc7\x7e\x49\x24\x35\xc3\xb8\x62\xa1\x69\x71\x86\xaa\x6c\xb4\x5e\x57\x14\x36\x83\xaa\x25\x73\xfd\xc5\x02\x40\x5f\x41\x1a\x4f\xa6\xa3\xf3\x21\xb4\x31\x45\x8c\xaf\x0a\x22\x66\x35\x0b\xe1\x77\x6b\x70\x86\x13\x78\xd3\x7f\xb3\x1f\xec\x5c\xa7\x4d\x65\x23\x92\xa2\xf5\xb2\xcf\xe9\x58\xd6\x4c\x8d\x1b\x68\xdf\x3c\x5a\x47\xbc\x52\xb7\xbd\x01\x0d\x41\x95\x11\x2a\xde\x2d\xe5\x97\x4a\x75\xe6\xe3\x1d\xcd\x1f\x29\x4d\x44\xa9\x90\x99\x88\xf8\x24\x21\xca\x44\xfe\x47\xab\xd7\xeb\x68\x1a\x0b\x4c\x01\x13\x75\x03\x75\x4e\x12\x5e\x00\x5d\xd6\x9e\xe4\xb9\x4a\xf9\x8a\xae\xfb\x70\xc5\xb3\x2c\xfd\xa2\xb2\xab\x8b\x7a\x13\x4c\xb8\x35\x89\x95\x72\xf4\x24\x55\x12\x3a\xa3\xb6\xdc\x07\xbf\xd4\x7f\xa6\xf4\x96\x20\xf0\xc9\x6f\x0b\x2a\x96\x04\xef\x6a\xf0\x1c\x26\xd7\x6b\x9a\x26\x98\x09\x0d\xef\xfa\x66\xf4\x5e\x3f\x57\x13\x97\x9f\xbe\x7a\x46\x7d\xb1\x07\x01\x17\x74\xfe\xc6\x03\x7b\x68\x0e\x2f\xd9\x70\xe0\xa5\x9a\x0d\xf8\xdd\x80\xae\x7a\x30\xba\x2c\xef\xbd\xae\x46\x7b\x35\x40\x1b\x6a\x18\x06\x33\x5c\x1d\xc3\x83\x31\xa7\x07\x0c\xaf\x10\xcb\x1d\xbc\x84\x6e\xff\x56\x60\xf6\xef\x2a\xd0\x5c\x89\x51\x75\xeb\x4c\xaf\x00\xab\x17\x82\xa3\xb1\xb8\xea\x07\xb6\x29\x28\x1d\x95\xff\x7d\x9a\x84\xf0\xce\x87\x34\xaf\xa5\x10\xfd\x5b\x1f\xfa\xb7\x3e\xf4\x79\xf4\xa1\x26\xf7\x8a\xaa\xa5\xbf\x10\xf8\x8e\x94\x77\x84\x3b\xbf\x6f\x54\x52\xb7\xb4\x8e\x65\xee\xdd\x1d\xa0\x65\xf9\x72\xba\x5f\x7e\x0b\xe5\x14\x11\xb7\x60\x09\xf6\x30\xe1\x71\x25\xd6\xe5\x57\x07\x43\x79\x43\x56\x62\x75\x8c\x9e\x99\xae\xe9\x95\x21\xf3\x66\x1e\x65\xe9\x6f\x88\x98\xc9\xf1\x99\xd1\x4f\x9b\x0c\x56\xbc\x54\x5d\x92\x6a\x6d\x31\xa6\x0b\xdd\x90\xa2\xb2\xf6\xc9\xbe\x75\x4b\x76\xd5\x6c\x57\xaa\x9c\xbb\x36\x71\x87\x12\x7c\x97\x06\x4a\x57\x36\xce\x27\x83\xab\xe1\xcd\xf9\xb0\xe3\xcb\xae\x33\x45\x9e\x91\xc6\xed\x9a\xe2\x4e\x9d\x5a\xc1\xc6\xac\x0a\x61\x76\x1f\x83\x37\x4c\x69\x17\x81\xc8\x31\x72\x69\x28\x77\xf2\xd0\xdc\x8d\x58\xf5\x6a\xdb\xed\xa0\x8b\xff\x53\xdb\x2d\x6b\x67\x95\x97\x00\x72\xef\xd5\x13\x5d\x05\x03\xad\x0e\xd4\xf6\x05\xd2\xa8\xa3\xe5\xe8\xac\x9b\xcd\x48\x82\xc0\x22\xd2\x89\x5c\x70\xf9\xa0\x2b\xa8\xa2\xd5\xeb\x29\xba\x00\xc7\xf6\xc0\x29\xec\x4b\x12\x22\x58\x87\xe3\xaa\x45\xa8\x8a\xf7\x30\xf5\x9a\x42\x92\x24\xb1\x7c\xe2\x76\xbe\xd2\x5f\xf1\xde\x37\x77\xb6\xb1\x42\x43\xd1\x60\xa5\x2b\x2a\xcb\x56\x52\x5c\xbf\xb5\xb3\x93\x8a\xaf\x6c\x0f\x2f\x84\x18\xe5\x56\x30\x5d\x35\xe2\x91\xc5\xba\xd4\x48\x2d\xdd\x56\x06\x7b\xc5\xc6\xc4\x5c\xde\xe5\xa2\x56\x14\x69\x2c\x9a\x5e\x40\x18\x3c\xd2\x38\xee\xc3\x37\x72\x30\x25\xee\x1e\x68\x26\x6e\x30\x66\xe4\x11\x87\x56\x47\x97\xd9\xc5\x8a\x68\x08\x64\x49\xa2\x44\x0f\xe8\x52\xaf\x2c\x30\x89\x6e\x64\x90\xa5\xce\xdc\x6a\x46\xfd\xfd\xa2\x19\xd4\x64\xed\xca\x79\x6a\x89\x97\x49\x7b\x87\xa7\x70\xed\x65\x9c\xd9\xa4\x2e\xc6\xa8\xb8\x93\xaf\x3b\x4c\xc8\x6d\xdb\xe6\x97\xba\xc4\x98\x3e\xfa\x6f\x32\xff\xfe\x5a\x24\xd9\x6c\x06\x6f\xce\x60\x7b\x04\xbb\x29\xca\xd1\x57\xe6\xcf\xbf\x9e\xc1\xd1\xd7\xa5\x02\xf4\x26\x7e\x21\x0a\xf8\x9b\xb3\xb0\x8f\xc0\x80\xbd\x49\x45\xbe\xdd\x05\xf1\x5f\x6a\xdd\xfb\xac\xf9\xfd\x10\x1b\x92\x0c\xde\x8f\x27\x37\xd3\xd1\xf9\x4d\x09\x13\xce\xe0\x7a\xf2\xfd\xec\x7c\x72\x8b\xe8\x60\x6e\x99\x1b\x9c\xf0\x54\x16\x7e\x06\x23\xde\x59\x2b\xa1\x00\xc7\x37\xa3\xdd\xe0\x13\xfc\x05\x01\x68\x97\xe9\x3b\xfa\x53\x17\x8e\xbe\x72\xde\xd0\x30\x75\xb4\x41\xb3\x57\x57\x42\x59\xbf\xfb\x32\x0d\x9a\x1d\x82\x33\x4c\xb1\x6e\xaa\x51\x00\x0d\x21\x17\x0f\xe8\x05\xca\x2e\xd4\x73\x26\xc1\x8c\x0c\x07\xda\xa5\x2d\x36\x14\x93\x46\x40\x96\xb4\xc8\x03\x14\xc7\x57\xda\x92\x4f\x7b\xb4\x15\x81\x1d\xb2\xde\x74\x39\x4b\x68\x94\xaf\x68\xa6\x40\xd2\x55\x0a\xa1\xf3\x7a\x9a\xa1\x80\xc4\x5b\xf6\x42\xb4\x76\x21\x49\x33\x0b\x72\x28\x8b\x50\x17\xa5\x89\xb9\x2f\xc9\x20\x49\xd5\x02\xb6\x8c\x03\xfb\x09\x1b\x39\xd5\x68\x0e\x46\xc0\xea\x66\x39\x7b\x4b\x2f\xba\xde\xe4\x4f\x12\xac\x0d\x65\x97\x2b\x8d\x6a\x44\x11\x5e\xb6\x17\x76\x2b\x6a\xa7\xfc\x1e\xa2\x2c\xa9\x55\xe8\x01\xe5\xed\xba\xca\xe7\xfa\x2c\x12\xca\x5a\xe8\xc9\x59\x63\x95\xb8\x62\x5e\xa0\x92\x89\x51\x47\x49\xe6\x79\xf9\x8e\x9a\xec\xac\xce\x15\x3b\xe5\x45\x59\x7f\xfd\xf3\x9f\x07\xf6\x7e\xc2\x25\xb4\x83\x0a\xa1\xe6\x6b\x5b\x57\xdf\xa9\x0b\x2a\xe5\xb8\xd3\x50\xc8\xd3\xba\x88\xf5\x6d\xce\xee\xbc\xd0\x18\xe4\x62\xf4\x50\x67\x48\x56\xc2\xba\xfa\x56\x06\x38\x75\xd0\x4a\xb7\x38\x4a\xad\xbf\xd4\x62\x78\xf9\xf3\xce\x97\x81\xb7\xa5\x17\xaf\x17\x5b\xca\xda\x6d\xd4\x35\x6c\xdf\x0e\x61\x06\x27\xa4\xdb\xd3\xfd\xb6\xeb\x50\x53\x51\x88\xfd\xa6\x3d\xbd\xe0\x8a\x6f\x99\xeb\xee\x6c\x45\xa4\x5e\x4f\xe2\x58\x37\xaf\xd5\x05\xe8\xb4\x6d\x61\x78\xb3\x28\xf0\x2a\x9e\x9b\x26\x5f\xbf\x7d\xab\xb0\x5d\xed\xaa\x20\xb6\x6f\x7c\x19\x1a\xb0\x8e\x49\x28\x31\x71\xbf\x74\xb5\xab\xe5\x23\x8e\x22\x82\x1e\xd7\xe6\x74\x4a\x00\xb2\x56\x91\x18\x4b\x0d\xbc\xfa\x76\xd7\x2e\x3b\x67\x38\x70\xeb\x91\xba\xf8\xf6\xf2\xfa\x8b\x23\x9b\x5c\x51\xef\xd1\x51\xaa\xd5\x10\x55\x93\x46\xa9\x31\x8e\x30\xee\x6a\xb7\xb5\x84\x03\x37\x14\xb7\xc9\x96\xd1\xf0\x00\x05\xa5\x6e\xcd\x3e\x25\xe4\x9b\xed\x7a\x23\x55\x10\x9a\xd0\x8c\xd8\x8d\x05\x90\xc0\xd5\x7f\xf3\x22\x30\x79\x57\xe6\xe6\xf1\x74\xa0\x74\xc1\xcd\x70\xb9\x2b\x5e\x92\x87\xa3\x31\x1a\xd7\x21\xa4\x19\x64\x74\x9d\x3e\xec\xa7\x15\x60\xed\x11\x51\x25\x67\x9d\x86\xd1\x22\xa2\x61\x47\xcc\x5c\x28\x0c\xe7\xa9\x7b\x5c\x53\x19\xd3\x1a\x70\x2e\x76\x38\xb8\x81\x35\x74\xd4\xa6\xcc\xd6\x0b\xc5\xa6\x4b\xab\xe9\xab\x4f\x24\x63\x9f\x8c\xe1\x7c\x32\xbe\xbc\xc2\x70\x8f\x1c\x2e\x80\x8b\x89\xae\x37\x38\x9c\x5a\x83\xf3\x84\x58\xeb\xcf\x3f\xc0\xf1\xe1\x45\xfe\x6a\x20\x66\xb7\xee\xc7\xb8\x29\x73\xa3\xce\xce\x89\x42\xc5\xa1\xc9\x83\x16\x85\x37\xa3\x64\x49\x59\xbe\x1f\x3e\x56\xad\xab\x84\x84\xff\xff\x00\x9b\xb4\x48\x24\x76\x35\x01\x00"),
 		},
 		"/idempotent/matcher-functions.sql": &vfsgen۰CompressedFileInfo{
 			name:             "matcher-functions.sql",
@@ -53,16 +53,16 @@ var MigrationFiles = func() http.FileSystem {
 		"/preinstall/002-schemas.sql": &vfsgen۰CompressedFileInfo{
 			name:             "002-schemas.sql",
 			modTime:          time.Time{},
-			uncompressedSize: 3171,
+			uncompressedSize: 4073,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x5d\x6f\xa3\x3a\x10\x7d\xe7\x57\xcc\x43\x2a\x5a\x5d\x72\x7f\xc0\x8d\xfa\xe0\x06\x37\x17\x89\x42\x05\xee\xaa\x6f\x91\x43\x26\x0d\x12\xe0\xc8\x36\x6d\x77\xd5\x1f\xbf\x32\x21\x29\xcd\xe6\xc3\x29\x59\x29\x2f\x0c\x9e\x73\xe6\x9c\x8c\x67\x70\xc6\x09\x25\x8c\x42\x3a\xfe\x9f\x3e\x10\x08\xee\x21\x8a\x19\xd0\xe7\x20\x65\x69\x1b\x9c\x8e\x09\x23\x61\x3c\x19\xc1\x70\x08\x19\xd7\xbc\x10\x2f\xa0\xf9\xac\x40\x05\xff\x40\x5e\x69\x94\x15\x2f\x60\x51\x57\x99\xce\x45\xa5\x9c\x49\x42\x22\x06\x4f\x29\x99\x50\x88\xa3\x0d\xf4\x57\x30\x60\x31\xac\xa4\x28\xa7\x12\xf9\x1c\xe5\xa8\x4d\x4a\x69\x48\xc7\xcc\x64\x91\x30\x04\x46\xee\x42\x9a\x42\x60\x8b\x41\x42\x46\x13\xf0\xe9\x3d\x79\x0a\x19\x3c\x26\xc1\x8f\x20\xa4\x93\x63\x08\xbb\xac\x2d\xe3\xfe\xe2\x2c\x15\xbd\xc9\x5c\xef\x2a\xf2\x20\x88\x52\x9a\x30\x0f\x9e\x1e\x7d\xc2\xa8\x07\x3e\x0d\x29\xa3\xe7\x2a\xdd\x60\xf7\x53\x7a\xac\x9a\x1d\x07\x36\x84\x36\x7d\xf2\x98\xc4\x0f\x4d\x93\xac\xea\x59\x91\x67\xb6\x1d\x61\xd2\xfe\x70\xdc\x86\x8f\x3e\xb3\x86\x4e\xac\x74\x5e\xe6\xbf\x70\x0e\xaf\x28\x95\x21\x04\xb1\xf8\x64\x87\x4c\x22\xd7\x38\x87\xd9\x4f\xd0\x4b\x04\x7c\xd7\x58\x99\x63\xc7\xcb\xa2\xcf\xec\x5b\x55\xa5\x34\x09\x68\xda\x14\xa6\x50\xe6\xa8\xe0\x35\xc7\xb7\x13\x1e\xac\x93\x7a\x5d\x8a\x03\x10\xf6\x9d\xd2\x02\x58\x5e\x09\x1b\x2b\x1e\x28\x4b\x82\x71\x63\x45\x89\x5a\xe6\x99\x8d\x15\xeb\xa4\x5e\x56\x1c\x80\xb0\xb7\xa2\x05\xb8\xa0\x15\x3e\x61\xe4\xc4\x1c\x31\x47\x7a\xc9\xde\x0b\x60\x2f\xba\x49\xbf\xc4\x40\xfc\x52\xc7\x25\xa7\xe1\x5e\xe0\x1e\x02\xff\xd2\x1c\x34\x3c\x9b\x31\x70\xda\xa9\x4b\xdc\xfd\x63\x38\xe7\xf9\x73\xe6\x14\x38\x5b\xdd\xa5\xdb\xe1\x10\x7e\x7f\xd5\xdf\x69\x0e\x9b\xee\x08\xa2\xfb\xf8\x84\x71\xe6\x48\xaf\x7e\xd8\x0b\x60\x6f\x49\x93\x6e\x3b\xfc\x9c\xe1\xb0\x59\xab\x26\xaa\x32\x5e\x74\x16\x2c\x64\xa2\xd2\x3c\x37\xfb\x78\x77\x45\x9b\x0d\xad\x44\x89\x26\x5b\x2c\x40\xd4\xb2\xb3\xb0\x79\x35\x07\xb1\x42\xc9\xb5\x90\xea\x5f\x60\x02\xb0\x52\xb5\xc4\x86\x27\x13\x52\x62\xa6\xbb\x40\x26\xcc\x65\x83\x55\x2b\x9c\x7b\xdd\x15\x5e\xd6\x4a\xc3\x0c\x61\x86\x0b\x21\x11\x78\x51\x6c\xf8\x84\x5e\xa2\x04\x95\x2d\xb1\xe4\x0a\xf2\xaa\x81\x51\xc8\x65\xb6\x84\x15\xd7\x4b\xc7\x8f\x61\x30\x70\x7c\x3a\x0e\x49\x42\x1d\x00\xa8\xf0\x6d\x6a\xde\x80\xc6\x77\x3d\x72\xee\xe8\x24\x88\xbe\xc4\xff\xbb\x85\xac\x96\x12\x2b\x3d\x55\xa8\x75\x5e\xbd\x5c\xbb\x6b\xc4\xe6\xbd\x7b\x03\x1f\x1f\xb0\x10\xb2\xe4\xfa\xda\xf5\xae\xc2\xed\xcf\xf5\xc0\xfd\x2c\xba\xf3\x64\x3e\x8e\x3a\x8f\xeb\xc5\xd4\x09\xb4\x9f\x77\xee\xcd\xc8\x14\x82\xef\x98\xd5\x1a\xb7\x14\xed\x5f\x4e\x18\xb9\x23\x29\x85\xab\x00\x52\xca\xa0\x53\x11\xdc\xc2\x95\x72\xbd\x6d\xd5\x73\xae\xf9\x8c\x2b\xbc\xbe\xf1\xb6\xaa\xf6\x43\x1f\x00\xea\x24\xd1\xc8\x77\x06\x83\x91\xf3\x3b\x00\x00\xff\xff\xa3\xec\xc4\x1e\x63\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x97\x4f\x6f\xe2\x3c\x10\xc6\xef\xf9\x14\x73\xa0\xa2\xd5\x1b\xde\x0f\xb0\xa8\x07\x17\x5c\x36\x52\x20\x28\x31\x2b\x6e\xc8\x24\x43\x89\x94\xc4\xc8\x76\x4a\x77\xd5\x0f\xbf\x4a\x08\x34\xa5\xfc\x31\x9b\x54\xea\x25\x8e\xe7\xf7\xcc\x3c\x1d\x9b\x89\x35\xf0\x29\x61\x14\x82\xc1\x4f\x3a\x26\xe0\x3c\xc3\xc4\x63\x40\xe7\x4e\xc0\x82\x6a\x71\x31\x20\x8c\xb8\xde\xa8\x0f\xbd\x1e\x84\x5c\xf3\x44\xbc\x80\xe6\xcb\x04\x15\xfc\x07\x71\xa6\x51\x66\x3c\x81\x55\x9e\x85\x3a\x16\x99\xb2\x46\x3e\x99\x30\x98\x05\x64\x44\xc1\x9b\xec\xd1\x9f\x61\xc0\x3c\xd8\x48\x91\x2e\x24\xf2\x08\x65\xbf\x0a\x0a\xa8\x4b\x07\xac\x88\x22\xae\x0b\x8c\x3c\xb9\x34\x00\xc7\x94\x41\x5c\x46\x7d\x18\xd2\x67\x32\x73\x19\x4c\x7d\xe7\x97\xe3\xd2\xd1\x25\xc2\xb1\x6a\xa5\x78\x3a\x39\xc3\x8a\xb6\x32\xd6\xc7\x15\xd9\xe0\x4c\x02\xea\x33\x1b\x66\xd3\x21\x61\xd4\x86\x21\x75\x29\xa3\xb7\x56\xba\x67\x37\xab\xf4\x52\x36\x47\x0e\xec\x05\x4d\xfa\x64\xea\x7b\xe3\xb2\x49\x36\xf9\x32\x89\x43\xd3\x8e\x28\xc2\xbe\x38\x6e\xa2\x47\xe7\xac\x94\x13\x1b\x1d\xa7\xf1\x1f\x8c\xe0\x15\xa5\x2a\x04\x41\xac\x3e\xd4\x21\x94\xc8\x35\x46\xb0\xfc\x0d\x7a\x8d\x80\x6f\x1a\xb3\x62\xdb\xe5\xb4\xe8\x9c\xfd\x53\x56\x01\xf5\x1d\x1a\x94\x89\x29\x94\x31\x2a\x78\x8d\x71\x7b\xc5\x83\x5d\x50\xa3\x43\x71\x06\x61\xde\x29\x15\xc0\xf0\x48\x98\x58\x31\xa6\xcc\x77\x06\xa5\x15\x29\x6a\x19\x87\x26\x56\xec\x82\x1a\x59\x71\x06\x61\x6e\x45\x05\x68\xd1\x8a\x21\x61\xe4\xca\x3d\x52\x6c\x69\x54\xf6\x49\x80\x79\xd1\x65\x78\x1b\x17\xe2\xa7\x3c\xda\xbc\x0d\x4f\x82\x1b\x14\xf8\x4d\xf7\x60\xa1\xb3\xbf\x06\xae\x3b\xd5\xc6\xd9\xbf\xc4\xb9\xcd\x9f\x1b\x6f\x81\x9b\xab\x6b\xbb\x1d\xce\xf1\x9b\x57\xfd\x9d\xcd\x41\xe7\x74\x3c\x75\x89\x6f\x62\xe0\x7e\x6f\xf3\x06\x39\x4b\xba\xd1\xac\x03\xa7\xb5\x26\xf9\x92\x59\xeb\x6d\x72\x56\xa1\x8d\xda\xbf\xb3\x55\x7c\xcf\x75\x67\xd3\xf2\x37\x34\x12\xdb\x4c\xf1\x74\x93\x60\x04\x11\xd7\xdc\xc0\xd8\x5d\x74\xf3\xd6\x39\xc3\xb9\xd1\xbc\x8a\xd2\x5a\xdb\x1c\x65\x75\x8b\xb7\xce\xe4\xd9\xbb\x22\x51\x6c\x69\xe4\xdc\x49\x80\xb9\x65\x65\xb8\xe9\x08\x62\xf5\x7a\xe5\x70\x5b\xac\xaa\x90\x27\xb5\x31\x17\x42\x91\x69\x1e\x67\xea\xeb\xa0\x5c\xcc\xc9\x4a\xa4\x58\x44\x8b\x15\x88\x5c\xd6\xc6\x66\x9e\x45\x20\x36\x28\xb9\x16\x52\xfd\x0f\x4c\x00\x66\x2a\x97\x58\xea\x84\x42\x4a\x0c\x75\x1d\x54\x2c\x73\x59\xb2\x72\x85\x91\x5d\x1f\xa4\xd3\x5c\x69\x58\x22\x2c\x71\x25\x24\x02\x4f\x92\xbd\x9e\xd0\x6b\x94\xa0\xc2\x35\xa6\x5c\x41\x9c\x95\x18\x85\x5c\x86\x6b\xd8\x70\xbd\xb6\x86\x1e\x74\x3a\xd6\x90\x0e\x5c\xe2\x53\x0b\x00\x32\xdc\x2e\x8a\x37\xa0\xf1\x4d\xf7\xad\x27\x3a\x72\x26\x9f\xd6\x7f\x3c\x42\x98\x4b\x89\x99\x5e\x28\xd4\x3a\xce\x5e\xee\xbb\x3b\x62\xf9\xbe\xfb\x00\xef\xef\xb0\x12\x32\xe5\xfa\xbe\x6b\xdf\xb9\x87\xbf\xae\x0d\xdd\x8f\xa4\x6b\x4f\xc5\x27\x4a\xed\x71\x37\x1e\xd6\x16\xaa\x8f\xac\xee\x43\xbf\x48\x04\xdf\x30\xcc\x35\x1e\x24\xaa\x7f\x39\x61\xe4\x89\x04\x14\xee\x1c\x08\x28\x83\x5a\x46\xf0\x08\x77\xaa\x6b\x1f\xb2\x2e\x0e\xf7\x92\x2b\xbc\x7f\xb0\x0f\x55\x9d\x46\x9f\x01\xd5\x82\xe8\x64\x68\x75\x3a\x7d\xeb\xef\x00\x77\xaa\x38\x15\xe9\x0f\x00\x00"),
 		},
 		"/preinstall/003-tables.sql": &vfsgen۰CompressedFileInfo{
 			name:             "003-tables.sql",
 			modTime:          time.Time{},
-			uncompressedSize: 2604,
+			uncompressedSize: 4401,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x51\x6f\xe2\x38\x10\x7e\xcf\xaf\x98\xb7\xc0\x8a\xa0\xbd\xb7\xbb\xad\xee\xa4\x14\xbc\xdd\x68\x21\x74\x21\xac\x5a\x9d\x4e\x91\x49\x06\xb0\xea\xd8\x39\xdb\xd9\x96\x7f\x7f\xb2\x93\x90\x84\x96\xed\x4a\xd7\xb7\xe2\xef\x9b\x19\x7f\xdf\xcc\x38\xc1\xdb\x7f\x5e\x10\x40\x42\x77\x1c\x21\xc7\x3d\x13\xcc\x30\x29\x34\xb8\xdf\xdf\xc6\x5b\x02\x05\x5d\x62\xc6\x28\x07\x73\x2a\x11\x9e\x11\x2a\x8d\xc0\x04\xc8\x4a\x81\xb1\xd1\x34\x68\x09\x45\xa5\x0d\xec\x10\x32\x85\xd4\x60\x0e\x47\x54\xe8\xcd\xd6\x24\x4c\x08\xcc\x57\xcb\x30\x8a\x61\x33\xfb\x42\x96\x61\x7a\xbf\x5e\x2d\xa7\x9c\xee\x90\xa7\x54\x29\x7a\x82\x70\x03\x4c\x98\xbf\xff\x81\x78\x95\x40\xbc\x5d\x2c\x6e\xbc\x96\x99\x84\xb7\x0b\x02\x65\xb5\xe3\x2c\x9b\x96\x4a\x16\x29\x13\xda\x50\xce\xa9\xad\x3d\x65\x62\x2f\x61\xe4\x01\x00\x3c\xe1\x09\x12\xf2\x90\xc0\xfd\x3a\x5a\x86\xeb\x47\xf8\x4a\x1e\x27\xee\xe4\x07\xe5\x15\xba\x33\x6f\x7c\xe3\x79\x51\xbc\x21\xeb\x04\xa2\x38\x59\xfd\x3c\xf0\xe8\x09\x4f\x93\x9a\x3d\x86\xef\xe1\x62\x4b\x36\x2e\xde\xc8\xcf\xa8\xa1\x5c\x1e\x40\x67\x47\x2c\xa8\x3f\x81\xe6\xcf\x6f\x6e\x38\x0b\x93\x70\xb1\xba\xf3\xc7\x93\x86\x60\x13\xa0\x39\x62\xa5\x21\xbc\x8f\x3a\x9e\xdf\x93\xa4\x43\xe3\x8b\x41\xa1\x99\x14\x17\x09\x5a\x34\x79\x48\x3a\xb0\x46\xc5\xac\x03\x17\xa5\x9c\xc1\x1b\xb2\x8e\xc8\xa6\xc3\x17\x68\x14\xcb\xae\xe3\x97\x24\x59\x47\xb3\x0e\x9f\x53\x43\x5f\xa3\x3b\xfc\x3c\x4c\xc2\x0e\x6d\x75\x53\x85\xd3\x70\x40\x6a\xd1\x51\xfc\x79\xe5\x5b\x17\x86\x06\x0f\x75\x9b\x36\x77\xaa\x8d\x65\x39\xec\xd8\x81\x09\x73\x6e\x8f\x3a\x59\x7d\x91\x94\xe5\xf0\xfa\xcc\x75\x97\xbe\xda\x70\x2d\x18\x82\xa0\x41\x52\x85\x70\xe0\x72\x47\x39\x3f\x41\x25\xd8\xbf\x15\xc2\x0e\x33\x6a\x5b\x5d\xee\xe1\x28\x9f\xa1\xa4\xca\x34\x13\x63\xd1\x6e\x82\x30\xf7\xc6\x70\x1f\xae\x93\x28\x89\x56\x31\xdc\x3e\xc2\x22\xda\x24\xa3\x73\x69\xe3\x9b\xf6\x9e\x51\x3c\x27\x0f\x50\x5f\x2c\xad\x93\xda\xd2\x57\xf1\x95\xbb\x6f\x37\x51\x7c\x07\x77\x51\x0c\xa3\x1a\xdd\x85\xda\x90\x6f\x5b\x12\xcf\xae\xa8\x96\xb2\xfc\x72\x7c\x2e\x70\x2e\x5e\x27\xae\xa5\x51\x0e\xb3\x2f\x64\xf6\x15\x46\x2c\x87\xbf\xe0\x63\xe3\x67\x3b\x53\xfd\x39\x32\xf8\x62\xea\xff\x7b\x83\x66\x79\x63\x88\xe2\xd9\x62\x3b\x27\xd0\x1f\x9c\x1a\xba\x8d\xa3\x6f\xdb\xe1\x41\x87\x66\xf9\xd8\x0d\x66\x10\x24\x47\xa6\xeb\x95\xd2\xac\x11\x0d\xb4\x75\xa3\xa0\x65\xc9\xc4\xc1\x0b\x82\x1d\x9a\x67\x44\x51\x9b\x6c\x6b\xd4\x40\x45\x0e\xe6\x88\x4c\x41\x26\x79\x55\x08\x10\xb4\xb0\xe4\x4c\x49\xad\x9b\x4e\xd1\xd3\x36\x03\xd3\x90\x4b\x81\xb0\x97\x0a\x2a\x4d\x77\x8c\x33\x73\xb2\x36\xf7\xc8\x13\xc0\x66\xed\xf1\x93\x05\xda\x4d\xc8\xa5\x38\xd4\xf9\xcc\x91\x1a\x38\xa0\x81\xac\x32\x20\xf7\xfb\xe9\xfb\x82\xa7\x4f\x78\x3a\x6b\x6e\x87\x32\x5c\x5c\x15\x39\xad\x0b\x49\x6d\x21\x10\x87\x4b\x32\x69\x88\x57\x0e\x2e\x9d\xe8\x8b\x6e\x35\xaf\xf5\xfd\xa5\x12\xd3\x52\x6a\xd7\xe5\x4d\x83\x34\xad\xec\x12\x3a\xeb\x21\x08\x14\xee\x51\xa1\xc8\xb0\x95\x76\xda\x47\xd9\xe1\x6a\x7e\x66\xb9\xd3\xb8\x44\xe5\xb6\x82\xc8\x10\x14\x52\x2d\x85\x1e\xde\x1c\x82\xc0\xb2\xce\x45\xfc\x84\x38\x75\xcc\x52\x6a\x3b\xf5\xc3\xe6\xea\x15\x31\xb1\xb1\x7b\x2d\x56\x4a\xfd\xbe\x06\xcd\x66\xbc\x30\xe9\xf5\x7b\x72\x29\xc9\xc5\xee\x71\xfd\x5b\x9f\x9e\xf5\xe8\x4e\x5d\x5f\xdb\x17\x26\x93\x45\xc9\xd1\xbe\x94\xb7\xab\xd5\x82\x84\x71\xb7\x95\xe6\xe4\x73\xb8\x5d\x24\xb0\xa7\x5c\x63\x4d\xcb\x71\x4f\x2b\x6e\xd2\xec\x58\x89\xa7\x94\x09\x83\xea\x07\xe5\xd7\xa9\x46\x55\x0d\x53\xa1\x41\xe1\x32\x96\xa8\x98\xcc\xed\xc3\x47\xd6\xdf\xc3\x0e\xeb\x8a\xb3\x16\xd8\x00\x46\xba\xe7\xdd\x1c\xb1\xcd\xf9\x2a\xc2\xb0\x20\x59\x94\x0a\xb5\x7b\xad\x7e\xa1\x9a\x37\xbc\xea\xd9\xd4\x49\x37\x6c\xe1\xde\xef\xef\xba\xd8\x56\xfd\x3f\xbe\x0a\xde\x8e\xe8\x96\xd7\xf0\x6b\x60\xe4\x0f\xfd\xf0\x27\x30\x3a\xcb\xeb\xff\x0e\x47\x59\x29\xed\x8f\x3f\x7d\xb2\x6d\x32\x9e\x78\x23\xff\x52\x4b\xcb\xf8\xe3\x23\x7c\xe8\x5c\xf1\x7f\x83\x9c\x9e\x06\xa4\x46\xac\x9e\xd4\x96\x86\x2f\x4c\x1b\x3d\xd2\xc8\x31\x33\xf0\x01\xf6\x4a\x16\x50\x1e\xd2\x52\xc9\x0c\x9e\xed\xe7\x17\x94\x4a\xba\x16\xfc\x13\xfc\x96\x5c\x77\xd0\x39\xfc\xf8\xc6\xfb\x2f\x00\x00\xff\xff\xcd\x1f\x5f\xa2\x2c\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x57\x4b\x8f\xe3\xb8\x11\xbe\xfb\x57\xd4\x21\x80\xec\x8d\x64\x4c\x6e\xd9\x1d\x64\x01\x8d\x5b\xd3\x2b\xac\x1f\xbd\xb6\xbc\x98\xc9\x62\x22\xd0\x52\xd9\x62\x9a\x22\x35\x24\xd5\x1e\xe7\x90\xdf\x1e\x90\x94\x2c\xc9\x8f\xee\x09\xb6\x6f\x2d\x7e\xf5\xe0\x57\x5f\x15\xcb\xc1\xed\xbf\x51\x10\x40\x42\x76\x0c\x21\xc7\x3d\xe5\x54\x53\xc1\x15\xd8\xef\xb7\xf1\xc6\x80\x80\xaa\x30\xa3\x84\x81\x3e\x55\x08\x47\x84\x5a\x21\x50\x0e\xa2\x96\xa0\x8d\x37\x05\x4a\x40\x59\x2b\x0d\x3b\x84\x4c\x22\xd1\x98\x43\x81\x12\x47\xb3\x75\x14\x26\x11\x3c\xac\x16\x61\xbc\x84\xcd\xec\x97\x68\x11\xa6\x4f\xeb\xd5\x62\xca\xc8\x0e\x59\x4a\xa4\x24\x27\x08\x37\x40\xb9\xfe\xe3\x0b\x2c\x57\x09\x2c\xb7\xf3\xf9\xfb\x51\x6b\x99\x84\x1f\xe6\x11\x54\xf5\x8e\xd1\x6c\x5a\x49\x51\xa6\x94\x2b\x4d\x18\x23\x26\xf7\x94\xf2\xbd\x80\xf1\x08\x00\xe0\x19\x4f\x90\x44\x9f\x12\x78\x5a\xc7\x8b\x70\xfd\x19\x7e\x8d\x3e\xfb\xf6\xe4\x85\xb0\x1a\xed\xd9\x68\xf2\x7e\x34\x8a\x97\x9b\x68\x9d\x40\xbc\x4c\x56\xaf\x3b\x1e\x3f\xe3\xc9\x77\xd6\x13\xf8\x3d\x9c\x6f\xa3\x8d\xf5\x37\xf6\x32\xa2\x09\x13\x07\x50\x59\x81\x25\xf1\x7c\x68\xfe\xbc\xe6\x86\xb3\x30\x09\xe7\xab\x47\x6f\xe2\x37\x06\x26\x00\xea\x02\x6b\x05\xe1\x53\xdc\xd9\x79\x3d\x4a\x3a\x34\x7e\xd3\xc8\x15\x15\xfc\x22\x40\x8b\x8e\x3e\x25\x1d\x58\xa1\xa4\xa8\x2e\x90\x3d\xf0\x26\x5a\xc7\xd1\xa6\xc3\x97\xa8\x25\xcd\xee\xe3\x17\x51\xb2\x8e\x67\x1d\x3e\x27\x9a\x5c\xa3\x3b\xfc\x43\x98\x84\x1d\xda\xf0\x26\x4b\xcb\xe1\xc0\xa8\x45\xc7\xcb\x8f\xab\xfe\x45\xb1\xac\x18\x91\x70\x11\xa4\xef\x3b\x8d\x3e\x45\x8b\xa7\x79\xb8\xee\xcc\xa4\x60\xac\xae\xe0\x2a\xb3\x81\xd9\x7a\x35\x9f\x6f\x9f\x3c\x53\xf1\xa1\x98\x86\x35\x9a\x36\xfc\x39\x11\xd1\x1c\x76\xf4\x40\xb9\x3e\x4b\xd1\xc5\x74\xa4\xa5\x34\x87\xeb\x33\xab\x64\x75\x57\xdc\x2d\x18\x82\xa0\x41\x12\x89\x70\x60\x62\x47\x18\x3b\x41\xcd\xe9\xd7\x1a\x61\x87\x19\x31\x6d\x25\xf6\x50\x88\x23\x54\x44\xea\xa6\x3b\x89\x6c\xba\x15\xf3\xd1\x04\x9e\xc2\x75\x12\x27\xf1\x6a\x09\x1f\x3e\xc3\x3c\xde\x24\xe3\x73\x6a\x93\xf7\xed\x3d\xe3\xe5\x43\xf4\x09\xdc\xc5\x52\x17\xd4\xa4\xbe\x5a\xde\xb9\xfb\x76\x13\x2f\x1f\xe1\x31\x5e\xc2\xd8\xa1\x3b\x57\x9b\xe8\xb7\x6d\xb4\x9c\xdd\x61\x2d\xa5\xf9\xfb\xd7\xd9\xb5\xfe\x3a\x72\x8d\x19\x61\x30\xfb\x25\x9a\xfd\x0a\x63\x9a\xc3\xcf\xf0\x6e\xe2\x0f\xfa\xb7\xdf\xb3\x1a\xbf\x69\xf7\x7f\xaf\xa9\x8d\xdd\x04\xe2\xe5\x6c\xbe\x7d\x88\xa0\xdf\xa4\x0e\xba\x5d\xc6\xbf\x6d\x87\x07\x1d\x9a\xe6\x13\x3b\x04\x82\x20\x29\xa8\x72\xe3\xab\x19\x59\x0a\x48\x5b\x8d\x92\x54\x15\xe5\x87\x51\x10\xec\x50\x1f\x11\xb9\x2b\xb2\xc9\x51\x01\xe1\x39\xe8\x02\xa9\x84\x4c\xb0\xba\xe4\xc0\x49\x69\x8c\x33\x29\x94\x6a\x94\xa2\xa6\x6d\x04\xaa\x20\x17\x1c\x61\x2f\x24\xd4\x8a\xec\x28\xa3\xfa\x64\xca\xdc\x33\xf6\x01\x9b\x11\xcb\x4e\x06\x68\xa6\x2e\x13\xfc\xe0\xe2\xe9\x82\x68\x38\xa0\x86\xac\xd6\x20\xf6\xfb\xe9\xdb\x84\xa7\xcf\x78\x3a\x73\x6e\x06\x40\x38\xbf\x4b\x72\xea\x12\x49\x4d\x22\xb0\x0c\x17\x91\xdf\x18\xde\x39\xb8\xac\x44\x9f\x74\xc3\xb9\xe3\xf7\xbb\x52\x4c\x2b\xa1\xac\xca\x1b\x81\x34\x52\xb6\x01\x6d\xe9\x21\x08\x24\xee\x51\x22\xcf\xb0\xa5\x76\xda\x47\x99\xe6\x6a\x3e\xd3\xdc\x72\x5c\xa1\xb4\x13\x88\x67\x08\x12\x89\x12\x5c\x0d\x6f\x0e\x41\x60\xac\xce\x49\xbc\x62\x38\xb5\x96\x95\x50\xa6\xeb\x87\xe2\xea\x25\xe1\x1b\xdf\x3d\x89\x55\x42\xbd\xcd\x81\xb3\x87\x8b\x22\x5d\xbf\x5d\x97\x94\x5c\xcc\x1e\xab\x5f\x77\x7a\xe6\xa3\x3b\xb5\xba\x36\xaf\x59\x26\xca\x8a\xa1\x79\x95\x3f\xac\x56\xf3\x28\x5c\x9e\x81\xf0\x10\x7d\x0c\xb7\xf3\x04\xf6\x84\x29\x74\x66\x39\xee\x49\xcd\x74\x9a\x15\x35\x7f\x4e\x29\xd7\x28\x5f\x08\xbb\x6f\xaa\x65\xdd\x58\x4a\xd4\xc8\x6d\xc4\x0a\x25\x15\xb9\x79\x64\xa3\xf5\xef\x61\x87\xb5\xc9\x99\x12\x18\x07\x5a\xd8\x55\x42\x17\xd8\xc6\xbc\xf2\x30\x4c\x48\x94\x95\x44\x65\x5f\xc6\xef\xc8\x26\xaf\x2b\x46\x33\xa2\x31\xad\x04\xa3\x59\xb3\x1e\xf4\x33\x69\x07\xd1\x15\xd2\x8c\x42\x8f\x1e\xb8\x90\x68\x5e\x69\xf1\x82\xf2\x28\xa9\xb6\xff\x48\xfc\x37\x66\xda\x9b\x4c\x5e\xbb\xc7\xa5\xc7\x7b\xe2\xe9\xe9\xa6\xab\xe5\xb0\xa7\x7a\xdf\xdf\x94\x55\x1b\xfe\x4f\xac\x44\xb7\x3d\xda\x69\x3a\x5c\x85\xc6\xde\x50\x20\x9e\x0f\xe3\x73\xbd\xbd\xbf\x43\x21\x6a\xa9\xbc\xc9\x4f\x3f\x19\xdd\x4e\xfc\xd1\xd8\xbb\x2c\xae\xb1\xf8\xf1\x1d\xfc\xd0\xc9\xc4\xfb\x1b\xe4\xe4\x34\x30\x6a\xc8\xea\xd5\xde\x98\xe1\x37\xaa\xb4\x1a\x2b\x64\x98\x69\xf8\x01\xf6\x52\x94\x50\x1d\xd2\x4a\x8a\x0c\x8e\x66\xf7\x84\x4a\x0a\xdb\x13\xff\x00\xaf\x35\x76\x92\x3e\xbb\xb7\xfe\x2f\x2b\x65\x4a\xdc\x54\xde\x3d\x14\x0b\xd7\xaa\x25\x6a\x62\x37\x8e\xb1\x59\x83\x7d\xf3\x54\x68\xfb\x16\x14\xc8\xaa\x09\x10\x05\x0a\xb9\x86\xdd\x09\x9e\xba\x65\x8f\x72\x90\x58\x0a\x8d\x81\x95\xcf\xc8\xcc\xb3\xaf\x35\x2a\xad\x7c\x33\x70\x40\x23\x27\x5c\x4f\x21\x6c\x1a\x1d\xf6\xa4\xa4\xec\x04\x25\x39\x41\x41\x5e\x10\xca\x9a\x69\x5a\x31\x04\xe4\xda\xbe\xd5\x74\x0f\x54\xab\x51\x10\x9c\xf3\xc9\x0a\xc2\x0f\x98\x4f\xdf\x9a\x37\x4d\xf6\xcd\xce\xa2\x74\xaa\x10\x39\x24\xf1\x22\xda\x24\xe1\xe2\x29\xf9\xe7\xed\x9d\xa7\xc9\xc8\xea\x68\x08\x30\x3c\xdc\xfa\x6e\x99\xb9\xf1\xdd\x10\x75\xd3\x8f\x25\x61\x78\x72\x6e\x53\xcf\xbb\xf1\xec\x38\x0b\x7f\x98\xa2\x0f\x5d\x61\x7c\x57\x95\xe6\xa9\x5f\xa3\x12\xac\x76\xeb\x94\x69\x52\x67\xd6\xac\x56\xe2\xc8\x15\x31\xe3\x31\x07\x2d\x7c\x40\x92\x15\x70\xa4\xba\x30\x34\x83\x38\xf2\x6e\x24\x81\x53\xed\xeb\x44\xbb\xdd\x34\x95\xe7\x90\x6f\xcf\x78\xab\xd2\xe1\xed\x9b\x49\xd1\x8c\x27\x0b\xf8\x2f\x78\xff\xfa\x83\x04\xff\x79\x17\xfc\xf8\xe5\xaf\x7f\x69\x97\xe1\x5e\x9c\x73\x13\xdd\xf1\xd2\x83\xfe\xdc\xeb\xb8\x77\x9d\xab\x7b\xc3\xbb\x75\x78\xde\x9c\x10\x32\xc1\x35\xe5\xb5\xa8\x15\x90\xc3\x41\xe2\x81\xe8\x1e\x97\x94\x1f\x80\xb4\x92\x26\x1a\x48\x2f\xcf\xe9\x77\xbc\x8b\xa9\x63\x71\xb8\x16\x50\x9b\x51\x77\xbb\x75\xf4\x31\x5a\x9b\xdd\x74\x73\xdb\x89\x5d\x13\x57\x4b\x78\x88\xe6\x51\x12\xc1\x2c\xdc\xcc\xc2\x87\xe8\x92\xb6\xff\xc3\xed\x55\x69\x5f\x8b\xf0\x42\xf1\xd8\xad\x4e\x97\x35\xb9\xa1\xe9\xf3\x2d\xfd\x61\x76\xad\x88\x1f\x91\xa3\x74\x3f\xac\xc4\xde\xbd\x35\xa6\x9f\xc5\x1e\xf0\x05\xe5\x09\xda\x9e\xd8\xd5\x65\x85\xb9\x99\x81\xdc\x1c\x80\x13\xb7\x13\x7b\x33\x2a\x46\x41\x20\xa4\x1d\x4c\x2f\x98\x03\xd9\x6b\x94\xb0\x43\x53\x34\xca\x0f\xa8\x34\xe6\x3e\x28\xe1\x16\x4f\xed\x8a\xcd\x31\xd3\x42\x2a\xc8\xa5\xa8\xec\xb7\xaf\xb5\x09\x2a\x51\xd5\xcc\x8e\x23\x5d\xe0\x09\x32\x92\x15\xe8\xd6\x29\x83\x69\xa7\x9b\x11\x0c\x96\x95\x6e\x93\x04\xa5\x09\xcf\xd5\x19\xd7\xfc\x0c\x31\x5d\x27\xcc\x8e\xcb\xf1\x75\x91\x98\x8b\xa7\xa5\xc8\xe9\x9e\x66\x8e\x91\xf1\xd5\x20\xb9\xea\xb2\x43\xc7\xdf\x87\xf8\xb1\x5f\x72\x43\xf0\xff\x06\x00\xac\x19\xf2\x8f\x31\x11\x00\x00"),
 		},
 		"/preinstall/004-matcher_operators.sql": &vfsgen۰CompressedFileInfo{
 			name:             "004-matcher_operators.sql",
@@ -113,6 +113,53 @@ var MigrationFiles = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xcd\xd1\x4e\x83\x30\x14\x87\xf1\x7b\x9e\xe2\x7f\x07\x98\xc5\x07\xd8\xe2\xc5\x19\x54\x5d\x72\xd6\x26\xa3\x78\x4b\x48\x3d\x73\x64\x40\x49\x5b\x74\xbe\xbd\x17\x6a\x62\x7c\x80\xef\xfb\x11\x5b\x75\x82\xa5\x3d\x2b\x34\xd5\xb3\x3a\x52\x57\x91\x25\x36\x4f\xf7\x93\xa4\x30\xb8\x8c\xea\x1a\x95\xe1\xf6\xa8\xf1\x2a\xe7\x7e\x1d\x53\xe7\xfc\xb4\x04\x89\x71\xf0\x33\xf6\xc6\xb0\x22\x0d\x6d\x2c\x74\xcb\x8c\x5a\x3d\x52\xcb\x16\x29\xac\xb2\xcb\xb2\x83\x6e\xd4\xc9\xe2\xa0\xad\xf9\x0f\xfc\xec\x8a\xab\x7c\x6e\xde\xfb\x71\x95\x12\x2f\xc4\xad\x6a\xb2\x22\xff\xc6\xff\x4a\xf9\x06\x85\xdc\x86\x98\x62\x11\x65\x14\x97\x70\x87\x73\xf0\x13\x96\xb7\x6e\x09\xde\xe1\xe3\x22\x41\xb0\x04\x3f\xf7\x93\xe0\x01\xf9\x6f\xdc\xb9\xcb\x3a\x5f\xf3\x72\xbb\x4d\x72\x4b\x65\xb9\xcb\xbe\x02\x00\x00\xff\xff\xae\xdc\x15\xea\xf6\x00\x00\x00"),
 		},
+		"/versions/dev/0.1.1-dev/2-add_metric_metadata.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2-add_metric_metadata.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 233,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x8d\xc1\x0a\x82\x40\x14\x45\xf7\x7e\xc5\x5d\x2a\x0c\xfd\x40\xab\x97\x3c\x4a\x9a\x51\xd1\x17\x64\x1b\x19\x6a\x22\x41\x45\x72\x5a\xf8\xf7\xc1\xec\x8a\xd9\x1e\xce\xbd\x27\x6f\x98\x84\x21\x74\xd0\x8c\x36\x3f\xb1\xa1\x3e\x27\x21\x5d\x1d\x77\x93\xf3\xf6\x61\xbd\x45\x9a\x00\xc0\x68\x57\xdf\xaf\xce\xcd\x90\xc2\x70\x2b\x64\x6a\xb9\xa1\xac\x04\xe5\x45\x6b\x15\x9c\xc9\xf9\xf7\x70\xef\x9f\x76\x1a\xc6\x0d\xc2\x57\xf9\x13\xfc\xb6\xb8\x18\xff\xcc\x83\x8f\xf1\x97\x1b\x97\x18\xaf\x9b\xc2\x50\xd3\xe1\xcc\x1d\xd2\x9f\xaa\x0a\x0d\x15\x1e\x55\xd8\x67\x49\xb6\x4f\xbe\x03\x00\xf8\xbf\x24\xaa\xe9\x00\x00\x00"),
+		},
+		"/versions/dev/0.1.1-dev/3-add_exemplar_schema.sql": &vfsgen۰CompressedFileInfo{
+			name:             "3-add_exemplar_schema.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 671,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x90\xcb\x6e\xc2\x30\x10\x45\xf7\xf9\x8a\xbb\x0b\x48\x51\x7f\x80\xd5\x94\x0c\xd4\x92\x49\x22\x7b\x82\xd8\x45\x2e\xb8\x6a\xd4\x3c\x50\x08\x7d\xfc\x7d\x45\x4b\x36\x51\xd4\x8a\xaa\x5b\x7b\xee\xdc\x73\x66\x69\x98\x84\x61\x97\x0f\xbc\x21\xa8\x15\x92\x54\xc0\x3b\x65\xc5\x5e\x1f\x8b\x98\x84\x0a\xde\xf1\x26\xd3\x64\x16\xc1\xda\x50\x22\xc8\x2d\xad\x19\x69\x32\x24\xa7\x66\x21\x29\x8e\x5d\x5b\x17\x9d\x77\x07\xdf\x0d\x51\xcb\x9a\x97\x72\xc9\x92\xd6\x10\xba\xd7\x6c\xa1\x6e\xdb\x44\x5a\xd8\x20\xe6\x15\xe5\x5a\x90\x19\xb5\x55\x9a\xd7\xbf\xef\x19\x13\x5c\xdb\xa7\x41\x6f\x72\x7c\xeb\xca\x7e\xec\x18\x41\x25\x96\x8d\x44\xc8\xb3\x98\x84\x23\xc4\xac\x59\xf8\x6f\xee\x43\xc3\x7f\xb8\xff\x44\x36\xba\xc9\x50\x1b\x7c\x27\xa0\x92\xcb\xcf\xf9\xb1\x2a\xf7\x77\x5f\x03\x65\x73\xea\x5d\x55\xb9\xbe\x6c\x9b\xa2\x6c\x9e\xda\xd9\x8b\xff\x88\xf0\xea\xaa\xb3\x9f\x63\x4b\x3a\x67\x1b\x00\xc0\x2c\xf4\xef\xbe\x3e\x56\xae\xc3\xc1\xf5\x0e\xa7\xfd\xb3\xaf\x5d\x18\x01\xe1\x14\x70\x38\x5f\x04\x9f\x03\x00\x3b\x68\x7a\x33\x9f\x02\x00\x00"),
+		},
+		"/versions/dev/0.1.1-dev/4-add_rollup_schema.sql": &vfsgen۰CompressedFileInfo{
+			name:             "4-add_rollup_schema.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 1171,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x94\x5f\x6f\x9b\x30\x14\xc5\xdf\xf9\x14\xe7\x61\x12\xa0\x85\xaa\xaf\x53\xa5\x49\x1e\xb9\x4d\x51\x5d\xe8\x8c\xa9\x5a\x4d\x1b\xf2\x82\x97\x58\x23\x10\x81\x69\xb4\x3d\xec\xb3\x4f\xfc\x69\x9b\x36\x5d\xa7\xbc\xda\xf7\x9c\x7b\xee\xef\x1a\x42\x41\x4c\x12\xd2\xf0\x82\xae\x18\xa2\x73\xc4\x89\x04\xdd\x46\xa9\x4c\xa7\xc3\x7c\xce\x24\xcb\x45\xc2\x79\x76\x7d\x86\x20\x40\x51\xef\xaa\x56\x6d\xb6\xa5\x2e\x50\x28\xab\x9c\x85\x60\xb1\x44\x96\xb2\x05\x21\x89\x1f\xbc\x0e\xd5\x90\x09\xb6\x4d\xbd\xc9\x1b\xad\x0a\xdd\x9c\x4d\xc2\x94\x38\x85\xb2\x57\x32\xce\x21\xd9\x27\x4e\x29\xa2\x63\x7c\x18\x97\x24\x30\xa7\x73\x96\x71\x89\x6b\x11\xdd\x44\x9c\x16\xff\x73\x79\xd9\x7d\xea\xfc\x7a\xc8\x23\xa6\xdb\x35\xc6\xf6\x42\x27\x08\x84\x6e\xeb\xb2\xb3\xa6\xae\x5a\xd8\xb5\xc6\x46\xdb\xc6\x2c\x5b\xa8\x46\x3f\xc3\x68\xeb\x19\xb4\x5a\xae\xb1\x33\x76\x0d\x63\x5b\xd4\xbb\x0a\x8d\xb6\xba\xea\xc5\xd8\xea\xc6\xd4\xc5\x89\x33\x6d\x6b\x48\xfa\x10\x21\x64\x92\xf1\x64\x71\xd2\xd4\x65\xd9\x6d\xf3\xe6\xb1\x25\x3c\x07\x00\x4c\x81\x94\x44\xc4\x78\x0f\xe6\x8a\x89\x3b\x5c\xd2\xdd\x6c\xb8\xaa\xd4\x46\x43\xd2\xad\x1c\xb6\x1e\x67\x9c\x23\x8b\xa3\xcf\x19\x21\xbc\xa0\xf0\x12\xde\x50\xf0\x07\xee\xb7\x2f\x2a\xf8\x7d\x1a\x7c\xf8\xfa\xfe\x9d\xeb\x8f\xda\xbd\x3e\x51\x2c\x49\xdc\x30\xfe\x2f\x97\xbd\xd2\x8f\x4f\xc5\xee\xe9\x93\xd5\x34\x67\x3e\xce\x79\x68\xe8\xf8\x03\x4e\xb9\xd6\x58\xd6\x95\x35\x55\x57\x77\x2d\xd4\x6a\xd5\xe8\x95\xb2\x7b\x2c\x4d\xb5\x82\x9a\x30\x43\x59\xa8\xbd\x9c\x6f\xe3\x1b\x35\xf9\x48\x71\x42\x37\x9d\x99\x21\xd1\xd3\x74\x82\xce\x49\x50\x1c\x52\xfa\xba\x89\x67\x0a\xbf\x7f\x2a\x73\xe2\x24\x09\x21\x4b\x43\x36\xa7\x97\xd8\x8e\xb0\x3d\x58\xed\x5b\x1d\xee\x8d\xde\xe5\xc3\xe2\x62\x76\x45\x2f\x77\x32\xd6\xec\xbd\x04\x78\x8f\x53\xce\x9e\xa7\xf3\x07\xea\x51\x9c\x92\x90\x7d\xd2\x04\xdb\xee\x7b\x69\x96\x27\xc3\x2b\x37\x55\x6b\x55\x59\xaa\xb1\xb8\xfa\x51\x7b\x3f\xf5\xaf\x19\xee\x55\xd9\x69\x1f\x37\x8c\x67\x94\x0e\xbd\x3c\x77\x62\xda\xff\x2b\xd0\x2e\xd7\x7a\xa3\xdc\x59\x7f\xe3\x1e\x7e\x44\xae\x7f\xe6\xfc\x1d\x00\x82\x97\x63\xb7\x93\x04\x00\x00"),
+		},
+		"/versions/dev/0.1.1-dev/5-add_duplicate_policy.sql": &vfsgen۰CompressedFileInfo{
+			name:             "5-add_duplicate_policy.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 229,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\xce\x41\x4b\xc3\x40\x10\x05\xe0\xfb\xfe\x8a\xb9\x6d\x02\xc5\x3f\xd0\xd3\xb8\x19\x6d\x70\xba\x81\x66\x56\xbc\x95\xb0\x1d\x65\x75\x35\x65\xd9\x54\xfa\xef\x45\xf1\x94\x1e\x1f\x3c\xde\xf7\x90\x85\x0e\x20\x78\xcf\x04\xa3\xdb\xd1\x1e\x8f\x0e\x05\x79\x78\xbc\xfb\xd4\x5a\x52\x34\xd8\x75\xe0\x06\x0e\x7b\x0f\xa7\xe5\x9c\x53\x9c\xaa\x1e\xcf\x73\x4e\xf1\x0a\x42\x2f\x02\x1d\x3d\x60\x60\x01\x1f\x98\xc1\xed\xc8\x3d\x41\x73\xd3\xec\x3d\x34\x36\xbd\x7d\xcd\x45\xed\x06\xec\x7c\xd1\xf2\x5d\x52\xfd\x0b\x45\xdf\x35\x56\xdb\xb6\x5b\x63\x7a\x3f\xd2\x41\xa0\xf7\x32\xac\xff\x9c\xf4\x75\x5a\x72\x6d\x3e\xf4\xba\xb9\x4c\x79\xd1\x16\x9e\x91\x03\x8d\xa6\xb1\x6b\xef\x77\xf6\x5f\x6b\xb7\xe6\x67\x00\xa6\x8e\x69\x08\xe5\x00\x00\x00"),
+		},
+		"/versions/dev/0.1.1-dev/6-add_data_modification.sql": &vfsgen۰FileInfo{
+			name:    "6-add_data_modification.sql",
+			modTime: time.Time{},
+			content: []byte("\x43\x52\x45\x41\x54\x45\x20\x54\x41\x42\x4c\x45\x20\x53\x43\x48\x45\x4d\x41\x5f\x43\x41\x54\x41\x4c\x4f\x47\x2e\x64\x61\x74\x61\x5f\x6d\x6f\x64\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x20\x20\x74\x65\x6e\x61\x6e\x74\x20\x54\x45\x58\x54\x20\x50\x52\x49\x4d\x41\x52\x59\x20\x4b\x45\x59\x2c\x0a\x20\x20\x20\x20\x67\x65\x6e\x65\x72\x61\x74\x69\x6f\x6e\x20\x42\x49\x47\x49\x4e\x54\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x0a\x29\x3b\x0a"),
+		},
+		"/versions/dev/0.1.1-dev/7-rollup_last_sample_time.sql": &vfsgen۰CompressedFileInfo{
+			name:             "7-rollup_last_sample_time.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 1237,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x93\xcf\x6e\xa3\x3e\x10\xc7\xef\x3c\xc5\x1c\x88\x4a\x24\x82\xf4\xfb\x73\x6a\xd4\x03\x05\xb7\x65\x45\x21\x22\xb4\xdd\x3d\x21\x37\x4c\x83\x55\x8c\xb3\xb6\x93\x6c\xdf\x7e\x65\x13\x28\xa9\xb6\xea\xfa\xe4\xf1\xcc\x7c\xc6\x1e\x7f\x67\xb1\x28\x1b\x04\x29\xda\x76\xbf\x53\xd0\x89\x23\xbc\x22\xee\x40\x37\x08\x9a\x71\x04\xf1\x62\xf7\x2d\x55\x1a\x14\xe5\xbb\xd6\x1e\xe1\x01\xe5\x1b\x3c\xef\x37\xaf\xa8\x7d\x90\x54\x37\x28\x41\x37\xb4\x73\x16\x0b\xa5\x29\xdf\xb1\x6e\x0b\x4c\xc3\x91\xe9\xc6\xe6\x2b\x4d\xa5\x1e\x60\x43\x9e\x12\xc6\x7c\x03\x2a\x11\x36\x12\xa9\xc6\x1a\xe8\x96\xb2\x2e\x80\xb2\x41\x26\x9d\xc5\xa2\xa6\x9a\x82\x68\xeb\x13\xde\xa6\x4b\x7a\x84\xfe\xbc\xc7\x71\xd4\x92\x6d\x80\x29\x68\x85\xd2\x81\x13\xe7\xe0\xba\x4e\x4c\xa2\x34\x2c\x88\x03\x00\x20\xa1\x20\x51\x5e\xc4\x4b\xe7\x9a\xdc\x26\x99\x3d\xbb\xc9\x0b\x90\x70\x32\xcc\x5a\x93\x94\x44\x25\x70\x19\x1c\x18\x1e\xab\x8e\x72\xf4\x41\xa2\x0a\x24\x2a\xd1\xee\x35\x13\x9d\x0f\x3c\xe8\xab\x9d\xdc\x3c\xd0\xf4\xb9\x45\x6b\x8d\xa0\x9b\x22\xbf\x87\x75\x74\x47\xee\xc3\x2a\x0a\xcb\x30\xcd\x6f\x87\xac\xbe\xcf\xc0\xe5\x18\x9c\x64\x19\x29\xe0\x5b\x9e\x64\x7f\x4e\x01\x0e\x79\x06\x1e\x0f\x58\x0d\x57\xe6\x72\x27\x12\xab\xe7\x7f\xc1\xe8\xeb\x55\xef\x2f\x30\x0f\xb2\x40\xf3\xb0\x01\xf9\xee\x1e\xb1\x69\x9e\xaf\x46\x3e\xf9\x4e\xa2\x87\x92\xc0\x8b\x90\x9c\x6a\xef\x22\x2e\xf2\x15\x3c\x26\xe4\x69\x28\x17\x87\x65\x58\x15\x79\x9a\x3e\xac\x82\x59\x02\x51\xb8\x8e\xc2\x98\x5c\xf8\x30\xe9\xe5\x7c\xf9\x19\xcf\x55\x3f\x5b\x77\x74\x9a\x15\x15\x24\x2c\xc9\xe7\x25\xfe\x71\x93\xb3\xf8\xa7\xa4\xbc\x03\xcf\xe8\x55\x6d\x68\x8b\xf5\x73\xb0\x11\x9d\x66\xdd\x5e\xec\x95\x7f\x16\xd9\xaf\x69\xa4\xc4\x17\x89\xaa\xa9\x58\xa7\x51\x1e\x68\x0b\x57\x30\xfb\xd7\x4d\xbf\x4a\x63\xdb\x4e\x48\xac\x58\x77\xa0\x2d\xab\xa9\x6d\x9e\xd5\x69\x65\x75\x7a\x05\xb3\xff\xdc\x74\x7e\x06\x09\xd7\x83\xc6\x0c\xa8\xea\xa7\xc0\x33\xc5\x2e\x2f\x93\xac\x24\xc5\x63\x98\xfa\xd6\x37\x37\xb1\xe3\x94\xa0\x64\xa8\x2a\x56\xfb\xc0\xe9\x2f\x6f\xf4\x9b\x8d\x6f\x07\xd3\x3b\xd0\x76\x8f\x93\x54\x6b\x9f\xd5\x9e\x6a\xd2\xb4\x32\x98\xfd\xff\xa1\x87\xb7\x45\xfe\xb0\x82\xeb\x1f\x5f\x5f\x6e\x72\xa5\x91\x60\xff\xf0\xec\xbf\x7d\x98\x0a\xeb\xbc\x9d\x1f\x54\xba\x45\x5d\x0d\x03\x82\x1a\x3b\xdb\xcc\x1d\x4a\x26\x6a\x4f\x4e\x07\x6e\x6e\xa0\xef\x13\x77\x92\x14\xc9\x62\xab\xd7\xa5\x43\xb2\xd8\x71\xdd\xa5\xf3\x7b\x00\xbf\x57\x5e\x93\xd5\x04\x00\x00"),
+		},
+		"/versions/dev/0.1.1-dev/8-add_metadata_tenant.sql": &vfsgen۰CompressedFileInfo{
+			name:             "8-add_metadata_tenant.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 242,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\xce\xc1\x8a\x83\x30\x14\x46\xe1\xbd\x4f\xf1\xef\x9c\x81\x30\x2f\xe0\xea\x4e\x92\x99\x4a\x63\x22\xf1\x0a\x75\x25\xa1\x4d\xa9\x54\x45\x4a\xba\xf0\xed\x8b\x94\xee\x5d\x1f\xf8\x38\x64\x58\x7b\x30\xfd\x1a\x8d\x46\x1e\x74\x45\xbd\x24\x26\xe3\xfe\x7f\xa6\x98\xc2\x25\xa4\x00\x52\x0a\xd2\x99\xb6\xb2\x48\x71\x0e\x73\x02\xeb\x13\xc3\x3a\x86\x6d\x8d\x81\xd2\x7f\xd4\x1a\x46\x9e\x17\xd9\x1e\x4f\x79\x57\x43\x3a\xdb\xb0\xa7\xd2\x32\x3e\xa1\x5f\xee\x71\xdd\x47\x6c\x4b\xb5\x2f\x2b\xf2\x1d\x8e\xba\xc3\xd7\x7b\x4c\x6c\xd6\x63\x38\xf7\xd7\x30\x0d\xe3\x2a\x90\xd6\x25\x0a\x3c\xe7\x21\x09\xdc\xe2\xb8\x7c\x17\xd9\x6b\x00\xb5\x72\xe6\xf1\xf2\x00\x00\x00"),
+		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/idempotent"].(os.FileInfo),
@@ -146,6 +193,13 @@ var MigrationFiles = func() http.FileSystem {
 	}
 	fs["/versions/dev/0.1.1-dev"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/versions/dev/0.1.1-dev/1-add_default_compression_setting.sql"].(os.FileInfo),
+		fs["/versions/dev/0.1.1-dev/2-add_metric_metadata.sql"].(os.FileInfo),
+		fs["/versions/dev/0.1.1-dev/3-add_exemplar_schema.sql"].(os.FileInfo),
+		fs["/versions/dev/0.1.1-dev/4-add_rollup_schema.sql"].(os.FileInfo),
+		fs["/versions/dev/0.1.1-dev/5-add_duplicate_policy.sql"].(os.FileInfo),
+		fs["/versions/dev/0.1.1-dev/6-add_data_modification.sql"].(os.FileInfo),
+		fs["/versions/dev/0.1.1-dev/7-rollup_last_sample_time.sql"].(os.FileInfo),
+		fs["/versions/dev/0.1.1-dev/8-add_metadata_tenant.sql"].(os.FileInfo),
 	}
 
 	return fs
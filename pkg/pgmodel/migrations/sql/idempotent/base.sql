@@ -18,6 +18,14 @@ $func$
 LANGUAGE SQL STABLE PARALLEL SAFE;
 GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_default_retention_period() TO prom_reader;
 
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_default_duplicate_policy()
+    RETURNS TEXT
+AS $func$
+    SELECT COALESCE((SELECT value FROM SCHEMA_CATALOG.default WHERE key='duplicate_policy'), 'ignore');
+$func$
+LANGUAGE SQL STABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_default_duplicate_policy() TO prom_reader;
+
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.is_timescaledb_installed()
     RETURNS BOOLEAN
 AS $func$
@@ -95,6 +103,10 @@ BEGIN
             PERFORM SCHEMA_PROM.set_compression_on_metric_table(r.table_name, TRUE);
         END IF;
 
+        IF SCHEMA_CATALOG.is_timescaledb_installed() THEN
+            PERFORM SCHEMA_CATALOG.create_metric_rollups(r.id);
+        END IF;
+
         --do this before taking exclusive lock to minimize work after taking lock
         UPDATE SCHEMA_CATALOG.metric SET creation_completed = TRUE WHERE id = r.id;
 
@@ -766,6 +778,97 @@ END
 $func$
 LANGUAGE PLPGSQL VOLATILE;
 GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_or_create_series_id_for_kv_array(TEXT, text[], text[]) TO prom_writer;
+
+--Read only version of get_or_create_series_id_for_kv_array, used once a metric
+--reached its series limit. Returns a NULL series_id if the series does not exist.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_series_id_for_kv_array(metric_name TEXT, label_keys text[], label_values text[], OUT table_name NAME, OUT series_id BIGINT)
+AS $func$
+DECLARE
+  label_array SCHEMA_PROM.label_array;
+BEGIN
+   SELECT mtn.table_name FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(metric_name) mtn
+   INTO table_name;
+
+   IF table_name IS NULL THEN
+       RETURN;
+   END IF;
+
+   --a series can only exist if all of its labels and key positions do
+   WITH idx_val AS (
+       SELECT lkp.pos idx, l.id val
+       FROM ROWS FROM(unnest(label_keys), UNNEST(label_values)) AS kv(key, value)
+           LEFT JOIN SCHEMA_CATALOG.label l
+              ON (l.key = kv.key AND l.value = kv.value)
+           LEFT JOIN SCHEMA_CATALOG.label_key_position lkp
+              ON
+              (
+                 lkp.metric_name = get_series_id_for_kv_array.metric_name AND
+                 lkp.key = kv.key
+              )
+   )
+   SELECT CASE WHEN bool_and(idx_val.idx IS NOT NULL AND idx_val.val IS NOT NULL)
+       THEN ARRAY(
+           SELECT coalesce(i.val, 0)
+           FROM generate_series(1, (SELECT max(idx) FROM idx_val)) g
+               LEFT JOIN idx_val i ON (i.idx = g)
+       )::SCHEMA_PROM.label_array
+   END
+   FROM idx_val
+   INTO label_array;
+
+   IF label_array IS NULL THEN
+       RETURN;
+   END IF;
+
+   EXECUTE format($query$
+    SELECT id
+    FROM SCHEMA_DATA_SERIES.%1$I as series
+    WHERE labels = $1
+   $query$, table_name)
+   USING label_array
+   INTO series_id;
+
+   RETURN;
+END
+$func$
+LANGUAGE PLPGSQL STABLE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_series_id_for_kv_array(TEXT, text[], text[]) TO prom_writer;
+
+--Creates the table holding the exemplars of a metric if it does not exist yet.
+--The table is named like the data table of the metric and its rows reference
+--the series of that metric. Returns true if the table was created.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.create_exemplar_table_if_not_exists(table_name_arg NAME)
+    RETURNS BOOLEAN
+AS $func$
+BEGIN
+    IF to_regclass(format('SCHEMA_DATA_EXEMPLAR.%I', table_name_arg)) IS NOT NULL THEN
+        RETURN false;
+    END IF;
+
+    --serialize concurrent creations of the same table and check again,
+    --the table might have been created while we were waiting
+    PERFORM pg_advisory_xact_lock(hashtext(format('SCHEMA_DATA_EXEMPLAR.%I', table_name_arg)));
+    IF to_regclass(format('SCHEMA_DATA_EXEMPLAR.%I', table_name_arg)) IS NOT NULL THEN
+        RETURN false;
+    END IF;
+
+    EXECUTE format('CREATE TABLE SCHEMA_DATA_EXEMPLAR.%I(time TIMESTAMPTZ NOT NULL, value DOUBLE PRECISION NOT NULL, series_id BIGINT NOT NULL, exemplar_labels JSONB NOT NULL)',
+                    table_name_arg);
+    EXECUTE format('CREATE UNIQUE INDEX ON SCHEMA_DATA_EXEMPLAR.%I (series_id, time) INCLUDE (value)',
+                    table_name_arg);
+
+    IF SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        PERFORM create_hypertable(format('SCHEMA_DATA_EXEMPLAR.%I', table_name_arg), 'time',
+                             chunk_time_interval=>SCHEMA_CATALOG.get_default_chunk_interval(),
+                             create_default_indexes=>false);
+    END IF;
+    RETURN true;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.create_exemplar_table_if_not_exists(NAME)
+IS 'creates the exemplar table of the metric with the given table name if it does not exist';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.create_exemplar_table_if_not_exists(NAME) TO prom_writer;
 --
 -- Parameter manipulation functions
 --
@@ -886,6 +989,85 @@ LANGUAGE SQL VOLATILE;
 COMMENT ON FUNCTION SCHEMA_PROM.reset_metric_retention_period(TEXT)
 IS 'resets the retention period for a specific metric to using the default';
 
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.check_duplicate_policy(duplicate_policy TEXT)
+RETURNS VOID
+AS $func$
+BEGIN
+    IF duplicate_policy IS NULL OR duplicate_policy NOT IN ('ignore', 'overwrite', 'reject') THEN
+        RAISE EXCEPTION 'invalid duplicate policy %, must be one of ignore, overwrite or reject', duplicate_policy;
+    END IF;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE;
+
+--Returns the policy applied to the samples of every metric whose series and
+--timestamp are already stored, along with the default policy as the policy
+--of the metric with an empty name.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_duplicate_policies()
+RETURNS TABLE(metric_name TEXT, duplicate_policy TEXT)
+AS $func$
+    SELECT ''::TEXT, SCHEMA_CATALOG.get_default_duplicate_policy()
+    UNION ALL
+    SELECT m.metric_name, m.duplicate_policy
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.duplicate_policy IS NOT NULL
+$func$
+LANGUAGE SQL STABLE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_duplicate_policies() TO prom_reader;
+
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_duplicate_policy(metric_name TEXT)
+RETURNS TEXT
+AS $$
+    SELECT COALESCE(
+        (SELECT m.duplicate_policy
+        FROM SCHEMA_CATALOG.metric m
+        WHERE id IN (SELECT id FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(get_metric_duplicate_policy.metric_name))),
+        SCHEMA_CATALOG.get_default_duplicate_policy())
+$$
+LANGUAGE SQL STABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_metric_duplicate_policy(TEXT) TO prom_reader;
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.set_default_duplicate_policy(duplicate_policy TEXT)
+RETURNS BOOLEAN
+AS $$
+    SELECT SCHEMA_CATALOG.check_duplicate_policy(duplicate_policy);
+    INSERT INTO SCHEMA_CATALOG.default(key, value) VALUES('duplicate_policy', duplicate_policy)
+    ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value;
+    SELECT true;
+$$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.set_default_duplicate_policy(TEXT)
+IS 'set the policy (ignore, overwrite or reject) applied to samples already stored for any metrics (existing and new) without an explicit override';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.set_metric_duplicate_policy(metric_name TEXT, new_duplicate_policy TEXT)
+RETURNS BOOLEAN
+AS $func$
+    SELECT SCHEMA_CATALOG.check_duplicate_policy(new_duplicate_policy);
+
+    --use get_or_create_metric_table_name because we want to be able to set /before/ any data is ingested
+    --needs to run before update so row exists before update.
+    SELECT SCHEMA_CATALOG.get_or_create_metric_table_name(set_metric_duplicate_policy.metric_name);
+
+    UPDATE SCHEMA_CATALOG.metric SET duplicate_policy = new_duplicate_policy
+    WHERE id IN (SELECT id FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(set_metric_duplicate_policy.metric_name));
+
+    SELECT true;
+$func$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.set_metric_duplicate_policy(TEXT, TEXT)
+IS 'set the policy (ignore, overwrite or reject) applied to samples already stored for a specific metric (this overrides the default)';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.reset_metric_duplicate_policy(metric_name TEXT)
+RETURNS BOOLEAN
+AS $func$
+    UPDATE SCHEMA_CATALOG.metric SET duplicate_policy = NULL
+    WHERE id = (SELECT id FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(metric_name));
+    SELECT true;
+$func$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.reset_metric_duplicate_policy(TEXT)
+IS 'resets the duplicate policy for a specific metric to using the default';
+
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_compression_setting(metric_name TEXT)
 RETURNS BOOLEAN
 AS $$
@@ -1081,9 +1263,16 @@ BEGIN
         RETURN false;
     END IF;
 
+    --exemplars reference the series, so they must go before the series do
+    IF to_regclass(format('SCHEMA_DATA_EXEMPLAR.%I', metric_table)) IS NOT NULL THEN
+        EXECUTE format($$ DELETE FROM SCHEMA_DATA_EXEMPLAR.%I WHERE time < %L $$, metric_table, older_than);
+    END IF;
+
     --chances are that the hour after the drop point will have the most similar
     --series to what is dropped, so first filter by all series that have been dropped
-    --but that aren't in that first hour and then make sure they aren't in the dataset
+    --but that aren't in that first hour and then make sure they aren't in the dataset.
+    --The rollups of the metric keep the downsampled samples of the dropped ones,
+    --so the series they still reference are kept too.
     EXECUTE format(
     $query$
         WITH potentially_drop_series AS (
@@ -1105,6 +1294,7 @@ BEGIN
                  ORDER BY time ASC
                  LIMIT 1
             )
+            %4$s
         ), deleted_series AS (
           DELETE from SCHEMA_DATA_SERIES.%1$I
           WHERE id IN (SELECT series_id FROM confirmed_drop_series)
@@ -1112,7 +1302,8 @@ BEGIN
         )
         SELECT ARRAY(SELECT DISTINCT unnest(labels) as label_id
         FROM deleted_series)
-    $query$, metric_table, older_than, check_time) INTO label_array;
+    $query$, metric_table, older_than, check_time,
+        SCHEMA_CATALOG.get_rollup_series_check(metric_table, 'potentially_drop_series.series_id')) INTO label_array;
 
     --needs to be a separate query and not a CTE since this needs to "see"
     --the series rows deleted above as deleted.
@@ -1206,6 +1397,173 @@ $$ LANGUAGE PLPGSQL;
 COMMENT ON PROCEDURE SCHEMA_CATALOG.execute_data_retention_policy()
 IS 'drops old data according to the data retention policy. This procedure should be run regularly in a cron job';
 
+--Creates the continuous aggregate downsampling a metric at a resolution, if it
+--doesn't exist yet. Every bucket holds the last sample of each series, at its own
+--time, so that counters keep their meaning and no value is read before it was
+--scraped. Queries filter on the bucket start, which the aggregate is partitioned
+--by. Raw chunks are dropped without invalidating the aggregate, which keeps the
+--downsampled data past the retention of the metric.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.create_metric_rollup(metric_id INT, resolution_id INT)
+    RETURNS NAME
+AS $func$
+DECLARE
+    metric_row SCHEMA_CATALOG.metric;
+    resolution_row SCHEMA_CATALOG.rollup_resolution;
+    rollup_view NAME;
+BEGIN
+    SELECT mr.view_name
+    INTO rollup_view
+    FROM SCHEMA_CATALOG.metric_rollup mr
+    WHERE mr.metric_id = create_metric_rollup.metric_id
+    AND mr.resolution_id = create_metric_rollup.resolution_id;
+
+    IF FOUND THEN
+        RETURN rollup_view;
+    END IF;
+
+    SELECT * INTO STRICT metric_row FROM SCHEMA_CATALOG.metric m WHERE m.id = create_metric_rollup.metric_id;
+    SELECT * INTO STRICT resolution_row FROM SCHEMA_CATALOG.rollup_resolution r WHERE r.id = create_metric_rollup.resolution_id;
+
+    IF char_length(metric_row.table_name) + char_length(resolution_row.name) < 61 THEN
+        rollup_view := format('%s_%s', metric_row.table_name, resolution_row.name)::name;
+    ELSE
+        rollup_view := SCHEMA_CATALOG.pg_name_with_suffix(metric_row.table_name, format('%s_%s', metric_row.id, resolution_row.name));
+    END IF;
+
+    EXECUTE format($$
+        CREATE VIEW SCHEMA_DATA_ROLLUP.%1$I
+        WITH (timescaledb.continuous,
+              timescaledb.refresh_interval = %2$L,
+              timescaledb.ignore_invalidation_older_than = %3$L)
+        AS SELECT time_bucket(%2$L::INTERVAL, time) AS bucket, series_id, max(time) AS time, last(value, time) AS value
+        FROM SCHEMA_DATA.%4$I
+        GROUP BY time_bucket(%2$L::INTERVAL, time), series_id
+    $$, rollup_view, resolution_row.resolution,
+        SCHEMA_CATALOG.get_metric_retention_period(metric_row.metric_name), metric_row.table_name);
+
+    INSERT INTO SCHEMA_CATALOG.metric_rollup(metric_id, resolution_id, view_name)
+    VALUES (metric_row.id, resolution_row.id, rollup_view);
+    RETURN rollup_view;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+
+--Creates the continuous aggregates of a metric at every resolution.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.create_metric_rollups(metric_id INT)
+    RETURNS VOID
+AS $func$
+    SELECT SCHEMA_CATALOG.create_metric_rollup(create_metric_rollups.metric_id, r.id)
+    FROM SCHEMA_CATALOG.rollup_resolution r
+    ORDER BY r.resolution;
+$func$
+LANGUAGE SQL VOLATILE;
+
+--Returns the materialization hypertables of the rollups of a metric table, which hold the
+--downsampled samples read through the rollup views.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_rollup_tables(metric_table NAME)
+    RETURNS TABLE(resolution INTERVAL, schema_name NAME, table_name NAME)
+AS $func$
+BEGIN
+    IF NOT SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        RETURN;
+    END IF;
+
+    RETURN QUERY
+    SELECT r.resolution, h.schema_name, h.table_name
+    FROM SCHEMA_CATALOG.metric m
+    INNER JOIN SCHEMA_CATALOG.metric_rollup mr ON (mr.metric_id = m.id)
+    INNER JOIN SCHEMA_CATALOG.rollup_resolution r ON (r.id = mr.resolution_id)
+    INNER JOIN _timescaledb_catalog.continuous_agg ca
+        ON (ca.user_view_schema = 'SCHEMA_DATA_ROLLUP' AND ca.user_view_name = mr.view_name)
+    INNER JOIN _timescaledb_catalog.hypertable h ON (h.id = ca.mat_hypertable_id)
+    WHERE m.table_name = metric_table
+    ORDER BY r.resolution;
+END
+$func$
+LANGUAGE PLPGSQL STABLE;
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.add_rollup_resolution(name TEXT, resolution INTERVAL, retention_period INTERVAL)
+RETURNS BOOLEAN
+AS $func$
+DECLARE
+    new_id INT;
+BEGIN
+    IF NOT SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        RAISE EXCEPTION 'downsampling requires the TimescaleDB extension';
+    END IF;
+
+    INSERT INTO SCHEMA_CATALOG.rollup_resolution(name, resolution, retention_period)
+    VALUES (add_rollup_resolution.name, add_rollup_resolution.resolution, add_rollup_resolution.retention_period)
+    RETURNING id INTO new_id;
+
+    --metrics still being created get their rollups in finalize_metric_creation
+    PERFORM SCHEMA_CATALOG.create_metric_rollup(m.id, new_id)
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.creation_completed;
+    RETURN true;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.add_rollup_resolution(TEXT, INTERVAL, INTERVAL)
+IS 'downsample all metrics (existing and new) to a resolution, keeping the downsampled data for the retention period';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.set_rollup_retention_period(name TEXT, retention_period INTERVAL)
+RETURNS BOOLEAN
+AS $func$
+    UPDATE SCHEMA_CATALOG.rollup_resolution r SET retention_period = set_rollup_retention_period.retention_period
+    WHERE r.name = set_rollup_retention_period.name;
+    SELECT true;
+$func$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.set_rollup_retention_period(TEXT, INTERVAL)
+IS 'set the retention period of the data downsampled to a resolution';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.drop_rollup_resolution(name TEXT)
+RETURNS BOOLEAN
+AS $func$
+DECLARE
+    r RECORD;
+BEGIN
+    FOR r IN
+        SELECT mr.view_name
+        FROM SCHEMA_CATALOG.metric_rollup mr
+        INNER JOIN SCHEMA_CATALOG.rollup_resolution res ON (res.id = mr.resolution_id)
+        WHERE res.name = drop_rollup_resolution.name
+    LOOP
+        EXECUTE format('DROP VIEW IF EXISTS SCHEMA_DATA_ROLLUP.%I CASCADE', r.view_name);
+    END LOOP;
+
+    DELETE FROM SCHEMA_CATALOG.rollup_resolution res WHERE res.name = drop_rollup_resolution.name;
+    RETURN FOUND;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.drop_rollup_resolution(TEXT)
+IS 'stop downsampling the metrics to a resolution, dropping the downsampled data';
+
+CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.execute_rollup_retention_policy()
+AS $$
+DECLARE
+    r RECORD;
+BEGIN
+    IF NOT SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        RETURN;
+    END IF;
+
+    FOR r IN
+        SELECT mr.view_name, res.retention_period
+        FROM SCHEMA_CATALOG.metric_rollup mr
+        INNER JOIN SCHEMA_CATALOG.rollup_resolution res ON (res.id = mr.resolution_id)
+        ORDER BY random()
+    LOOP
+        PERFORM drop_chunks(table_name=>r.view_name, schema_name=>'SCHEMA_DATA_ROLLUP', older_than=>NOW() - r.retention_period);
+        COMMIT;
+    END LOOP;
+END;
+$$ LANGUAGE PLPGSQL;
+COMMENT ON PROCEDURE SCHEMA_CATALOG.execute_rollup_retention_policy()
+IS 'drops old downsampled data according to the retention period of each resolution';
+
 --public procedure to be called by cron
 --right now just does data retention but name is generic so that
 --we can add stuff later without needing people to change their cron scripts
@@ -1213,6 +1571,7 @@ CREATE OR REPLACE PROCEDURE SCHEMA_PROM.execute_maintenance()
 AS $$
 BEGIN
     CALL SCHEMA_CATALOG.execute_data_retention_policy();
+    CALL SCHEMA_CATALOG.execute_rollup_retention_policy();
 END;
 $$ LANGUAGE PLPGSQL;
 COMMENT ON PROCEDURE SCHEMA_PROM.execute_maintenance()
@@ -1498,3 +1857,178 @@ BEGIN
     END LOOP;
 END;
 $$ LANGUAGE PLPGSQL;
+
+--Decompresses the compressed chunks of a metric table holding data between start_time and end_time
+--(inclusive), so that samples can be deleted from them. Like decompress_chunks_after, this is a
+--procedure so that no locks are held across decompress_chunk calls.
+CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.decompress_chunks_between(metric_table NAME, start_time TIMESTAMPTZ, end_time TIMESTAMPTZ)
+AS $$
+DECLARE
+    chunk_row record;
+    time_dimension_id INT;
+    start_time_internal BIGINT;
+    end_time_internal BIGINT;
+BEGIN
+    IF NOT SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        RETURN;
+    END IF;
+
+    SELECT d.id
+    INTO time_dimension_id
+    FROM _timescaledb_catalog.hypertable h
+    INNER JOIN _timescaledb_catalog.dimension d ON (d.hypertable_id = h.id)
+    WHERE h.schema_name = 'SCHEMA_DATA' AND h.table_name = metric_table
+    ORDER BY d.id ASC
+    LIMIT 1;
+
+    IF time_dimension_id IS NULL THEN
+        RETURN;
+    END IF;
+
+    SELECT _timescaledb_internal.time_to_internal(start_time) INTO STRICT start_time_internal;
+    SELECT _timescaledb_internal.time_to_internal(end_time) INTO STRICT end_time_internal;
+
+    FOR chunk_row IN
+        SELECT c.*
+        FROM _timescaledb_catalog.dimension_slice ds
+        INNER JOIN _timescaledb_catalog.chunk_constraint cc ON cc.dimension_slice_id = ds.id
+        INNER JOIN _timescaledb_catalog.chunk c ON cc.chunk_id = c.id
+        WHERE ds.dimension_id = time_dimension_id
+        -- the range_starts are inclusive and the range_ends exclusive
+        AND ds.range_start <= end_time_internal
+        AND ds.range_end > start_time_internal
+        AND c.compressed_chunk_id IS NOT NULL
+        ORDER BY ds.range_start
+    LOOP
+        --lock the chunk exclusive.
+        EXECUTE format('LOCK %I.%I;', chunk_row.schema_name, chunk_row.table_name);
+        --double check it's still compressed.
+        PERFORM c.*
+        FROM _timescaledb_catalog.chunk c
+        WHERE c.id = chunk_row.id AND c.compressed_chunk_id IS NOT NULL;
+
+        IF FOUND THEN
+            RAISE NOTICE 'Promscale is decompressing chunk: %.%', chunk_row.schema_name, chunk_row.table_name;
+            PERFORM decompress_chunk(format('%I.%I', chunk_row.schema_name, chunk_row.table_name)::regclass);
+        END IF;
+
+        COMMIT;
+    END LOOP;
+END;
+$$ LANGUAGE PLPGSQL;
+COMMENT ON PROCEDURE SCHEMA_CATALOG.decompress_chunks_between(NAME, TIMESTAMPTZ, TIMESTAMPTZ)
+IS 'decompresses the chunks of the metric table holding data between start_time and end_time';
+GRANT EXECUTE ON PROCEDURE SCHEMA_CATALOG.decompress_chunks_between(NAME, TIMESTAMPTZ, TIMESTAMPTZ) TO prom_writer;
+
+--Returns the conditions, to add to a WHERE clause, that the series whose id is series_id_expr has
+--no samples left in the rollups of a metric table.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_rollup_series_check(metric_table NAME, series_id_expr TEXT)
+    RETURNS TEXT
+AS $func$
+    SELECT COALESCE(string_agg(
+        format($$ AND NOT EXISTS (SELECT 1 FROM %I.%I r WHERE r.series_id = %s LIMIT 1) $$, t.schema_name, t.table_name, series_id_expr),
+        ''), '')
+    FROM SCHEMA_CATALOG.get_metric_rollup_tables(metric_table) t
+$func$
+LANGUAGE SQL STABLE;
+
+--Deletes the samples and exemplars of the given series of a metric table between start_time and
+--end_time (inclusive), and returns the number of samples deleted. The compressed chunks holding
+--such samples must be decompressed first, with decompress_chunks_between.
+--The rollups of the metric keep downsampled samples past its retention, so the buckets of the
+--series overlapping the range are deleted from them as well. Buckets still covered by raw
+--samples are materialized again from the samples left on the next refresh of the rollup.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.delete_series_samples(metric_table NAME, series_ids BIGINT[], start_time TIMESTAMPTZ, end_time TIMESTAMPTZ)
+    RETURNS BIGINT
+AS $func$
+DECLARE
+    deleted_samples BIGINT;
+    rollup_row RECORD;
+BEGIN
+    IF to_regclass(format('SCHEMA_DATA_EXEMPLAR.%I', metric_table)) IS NOT NULL THEN
+        EXECUTE format($$ DELETE FROM SCHEMA_DATA_EXEMPLAR.%I WHERE series_id = ANY($1) AND time >= $2 AND time <= $3 $$, metric_table)
+        USING series_ids, start_time, end_time;
+    END IF;
+
+    EXECUTE format($$ DELETE FROM SCHEMA_DATA.%I WHERE series_id = ANY($1) AND time >= $2 AND time <= $3 $$, metric_table)
+    USING series_ids, start_time, end_time;
+    GET DIAGNOSTICS deleted_samples = ROW_COUNT;
+
+    FOR rollup_row IN
+        SELECT * FROM SCHEMA_CATALOG.get_metric_rollup_tables(metric_table)
+    LOOP
+        EXECUTE format($$ DELETE FROM %I.%I WHERE series_id = ANY($1) AND bucket >= time_bucket($4, $2) AND bucket <= $3 $$,
+            rollup_row.schema_name, rollup_row.table_name)
+        USING series_ids, start_time, end_time, rollup_row.resolution;
+    END LOOP;
+    RETURN deleted_samples;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.delete_series_samples(NAME, BIGINT[], TIMESTAMPTZ, TIMESTAMPTZ)
+IS 'deletes the samples and exemplars of the series of the metric table between start_time and end_time';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.delete_series_samples(NAME, BIGINT[], TIMESTAMPTZ, TIMESTAMPTZ) TO prom_writer;
+
+--Deletes the given series of a metric table that have neither samples, in the metric table or its
+--rollups, nor exemplars left, then the labels no series uses anymore, and returns the number of
+--series deleted.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.delete_empty_series(metric_table NAME, series_ids BIGINT[])
+    RETURNS INT
+AS $func$
+DECLARE
+    data_check TEXT := '';
+    deleted_series INT;
+    label_array INT[];
+BEGIN
+    IF to_regclass(format('SCHEMA_DATA_EXEMPLAR.%I', metric_table)) IS NOT NULL THEN
+        data_check := format($$ AND NOT EXISTS (SELECT 1 FROM SCHEMA_DATA_EXEMPLAR.%I e WHERE e.series_id = s.id LIMIT 1) $$, metric_table);
+    END IF;
+    data_check := data_check || SCHEMA_CATALOG.get_rollup_series_check(metric_table, 's.id');
+
+    EXECUTE format($query$
+        WITH deleted_series AS (
+            DELETE FROM SCHEMA_DATA_SERIES.%1$I s
+            WHERE s.id = ANY($1)
+            AND NOT EXISTS (SELECT 1 FROM SCHEMA_DATA.%1$I d WHERE d.series_id = s.id LIMIT 1)
+            %2$s
+            RETURNING s.labels
+        )
+        SELECT (SELECT count(*) FROM deleted_series)::INT,
+               ARRAY(SELECT DISTINCT unnest(labels) FROM deleted_series)
+    $query$, metric_table, data_check) INTO deleted_series, label_array USING series_ids;
+
+    --needs to be a separate query and not a CTE since this needs to "see"
+    --the series rows deleted above as deleted. Labels are shared by all the
+    --metrics, so the series of every metric are checked.
+    --Note: we never delete metric name keys since there are check constraints that
+    --rely on those ids not changing.
+    DELETE FROM SCHEMA_CATALOG.label l
+    WHERE l.id = ANY(label_array) AND l.key != '__name__'
+    AND NOT EXISTS (
+        SELECT 1
+        FROM SCHEMA_CATALOG.series s
+        WHERE s.labels && ARRAY[l.id]
+        LIMIT 1
+    );
+
+    RETURN deleted_series;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.delete_empty_series(NAME, BIGINT[])
+IS 'deletes the series of the metric table without samples nor exemplars, and the labels left unused';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.delete_empty_series(NAME, BIGINT[]) TO prom_writer;
+
+--Bumps the generation of the data of the tenant, after some of its samples
+--were changed or removed.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.mark_data_modified(tenant TEXT)
+RETURNS VOID
+AS $func$
+    INSERT INTO SCHEMA_CATALOG.data_modification AS m (tenant, generation)
+    VALUES (mark_data_modified.tenant, 1)
+    ON CONFLICT (tenant) DO UPDATE SET generation = m.generation + 1
+$func$
+LANGUAGE SQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.mark_data_modified(TEXT)
+IS 'records that samples of the tenant were changed or removed after being ingested';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.mark_data_modified(TEXT) TO prom_writer;
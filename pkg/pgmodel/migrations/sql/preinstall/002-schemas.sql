@@ -39,6 +39,19 @@ GRANT USAGE ON SCHEMA SCHEMA_DATA_SERIES TO prom_writer;
 GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA SCHEMA_DATA_SERIES TO prom_writer;
 ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_SERIES GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prom_writer;
 
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_EXEMPLAR;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT ON TABLES TO prom_reader;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prom_writer;
+
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_ROLLUP; -- downsampled data
+GRANT USAGE ON SCHEMA SCHEMA_DATA_ROLLUP TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_ROLLUP TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_ROLLUP GRANT SELECT ON TABLES TO prom_reader;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_ROLLUP TO prom_writer;
 
 CREATE SCHEMA IF NOT EXISTS SCHEMA_INFO;
 GRANT USAGE ON SCHEMA SCHEMA_INFO TO prom_reader;
@@ -17,7 +17,9 @@ INSERT INTO public.prom_installation_info(key, value) VALUES
     ('series schema',         'SCHEMA_SERIES'),
     ('metric schema',         'SCHEMA_METRIC'),
     ('data schema',           'SCHEMA_DATA'),
-    ('information schema',    'SCHEMA_INFO');
+    ('information schema',    'SCHEMA_INFO'),
+    ('exemplar data schema',  'SCHEMA_DATA_EXEMPLAR'),
+    ('rollup data schema',    'SCHEMA_DATA_ROLLUP');
 
 
 CREATE TABLE SCHEMA_CATALOG.series (
@@ -64,6 +66,7 @@ CREATE TABLE SCHEMA_CATALOG.metric (
     default_chunk_interval BOOLEAN NOT NULL DEFAULT true,
     retention_period INTERVAL DEFAULT NULL, --NULL to use the default retention_period
     default_compression BOOLEAN NOT NULL DEFAULT true,
+    duplicate_policy TEXT DEFAULT NULL CHECK (duplicate_policy IN ('ignore', 'overwrite', 'reject')), --NULL to use the default duplicate_policy
     UNIQUE (metric_name) INCLUDE (table_name),
     UNIQUE(table_name)
 );
@@ -76,4 +79,42 @@ CREATE TABLE SCHEMA_CATALOG.default (
 INSERT INTO SCHEMA_CATALOG.default(key,value) VALUES
 ('chunk_interval', (INTERVAL '8 hours')::text),
 ('retention_period', (90 * INTERVAL '1 day')::text),
-('metric_compression', (exists(select * from pg_proc where proname = 'compress_chunk')::text));
+('metric_compression', (exists(select * from pg_proc where proname = 'compress_chunk')::text)),
+('duplicate_policy', 'ignore');
+
+--Metric metadata (type, unit and help) as sent by Prometheus in remote-write
+--requests, per tenant. A metric family may have multiple entries if its
+--metadata changed.
+CREATE TABLE SCHEMA_CATALOG.metadata (
+    last_seen TIMESTAMPTZ NOT NULL,
+    metric_family TEXT NOT NULL,
+    type TEXT NOT NULL,
+    unit TEXT NOT NULL,
+    help TEXT NOT NULL,
+    tenant TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (tenant, metric_family, type, unit, help)
+);
+
+--Resolutions the metrics are downsampled to, each with its own retention period.
+CREATE TABLE SCHEMA_CATALOG.rollup_resolution (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE CHECK (name ~ '^[a-z0-9]+$'),
+    resolution INTERVAL NOT NULL UNIQUE CHECK (resolution > INTERVAL '0'),
+    retention_period INTERVAL NOT NULL
+);
+
+--The continuous aggregate downsampling a metric at a resolution.
+CREATE TABLE SCHEMA_CATALOG.metric_rollup (
+    metric_id INT NOT NULL REFERENCES SCHEMA_CATALOG.metric(id) ON DELETE CASCADE,
+    resolution_id INT NOT NULL REFERENCES SCHEMA_CATALOG.rollup_resolution(id) ON DELETE CASCADE,
+    view_name NAME NOT NULL UNIQUE,
+    PRIMARY KEY (metric_id, resolution_id)
+);
+
+--Generation of the data of every tenant, bumped whenever samples are changed
+--or removed after being ingested, so that the connectors drop the query results
+--they cached for the tenant. The empty tenant stands for the series without one.
+CREATE TABLE SCHEMA_CATALOG.data_modification (
+    tenant TEXT PRIMARY KEY,
+    generation BIGINT NOT NULL
+);
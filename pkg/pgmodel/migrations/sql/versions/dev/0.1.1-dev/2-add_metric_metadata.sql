@@ -0,0 +1,8 @@
+CREATE TABLE SCHEMA_CATALOG.metadata (
+    last_seen TIMESTAMPTZ NOT NULL,
+    metric_family TEXT NOT NULL,
+    type TEXT NOT NULL,
+    unit TEXT NOT NULL,
+    help TEXT NOT NULL,
+    PRIMARY KEY (metric_family, type, unit, help)
+);
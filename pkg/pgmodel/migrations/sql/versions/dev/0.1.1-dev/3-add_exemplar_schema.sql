@@ -0,0 +1,10 @@
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_EXEMPLAR;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT ON TABLES TO prom_reader;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prom_writer;
+
+INSERT INTO public.prom_installation_info(key, value) VALUES
+    ('exemplar data schema',  'SCHEMA_DATA_EXEMPLAR');
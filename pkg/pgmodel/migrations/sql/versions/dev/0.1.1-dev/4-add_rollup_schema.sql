@@ -0,0 +1,24 @@
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_ROLLUP; -- downsampled data
+GRANT USAGE ON SCHEMA SCHEMA_DATA_ROLLUP TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_ROLLUP TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_ROLLUP GRANT SELECT ON TABLES TO prom_reader;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_ROLLUP TO prom_writer;
+
+--Resolutions the metrics are downsampled to, each with its own retention period.
+CREATE TABLE SCHEMA_CATALOG.rollup_resolution (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE CHECK (name ~ '^[a-z0-9]+$'),
+    resolution INTERVAL NOT NULL UNIQUE CHECK (resolution > INTERVAL '0'),
+    retention_period INTERVAL NOT NULL
+);
+
+--The continuous aggregate downsampling a metric at a resolution.
+CREATE TABLE SCHEMA_CATALOG.metric_rollup (
+    metric_id INT NOT NULL REFERENCES SCHEMA_CATALOG.metric(id) ON DELETE CASCADE,
+    resolution_id INT NOT NULL REFERENCES SCHEMA_CATALOG.rollup_resolution(id) ON DELETE CASCADE,
+    view_name NAME NOT NULL UNIQUE,
+    PRIMARY KEY (metric_id, resolution_id)
+);
+
+INSERT INTO public.prom_installation_info(key, value) VALUES
+    ('rollup data schema',    'SCHEMA_DATA_ROLLUP');
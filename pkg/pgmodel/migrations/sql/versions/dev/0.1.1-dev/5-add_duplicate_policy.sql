@@ -0,0 +1,5 @@
+ALTER TABLE SCHEMA_CATALOG.metric
+ADD COLUMN duplicate_policy TEXT DEFAULT NULL CHECK (duplicate_policy IN ('ignore', 'overwrite', 'reject'));
+
+INSERT INTO SCHEMA_CATALOG.default(key,value) VALUES
+('duplicate_policy', 'ignore');
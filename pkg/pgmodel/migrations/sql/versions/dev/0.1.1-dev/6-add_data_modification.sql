@@ -0,0 +1,4 @@
+CREATE TABLE SCHEMA_CATALOG.data_modification (
+    tenant TEXT PRIMARY KEY,
+    generation BIGINT NOT NULL
+);
@@ -0,0 +1,27 @@
+--The rollups now keep the time of the last sample of every bucket, rather than
+--stamping it with the start of the bucket, so they are created again. Their
+--data older than the raw data of the metric is lost.
+DO $$
+DECLARE
+    r RECORD;
+BEGIN
+    FOR r IN
+        SELECT mr.view_name, res.resolution, m.metric_name, m.table_name
+        FROM SCHEMA_CATALOG.metric_rollup mr
+        INNER JOIN SCHEMA_CATALOG.metric m ON (m.id = mr.metric_id)
+        INNER JOIN SCHEMA_CATALOG.rollup_resolution res ON (res.id = mr.resolution_id)
+    LOOP
+        EXECUTE format('DROP VIEW SCHEMA_DATA_ROLLUP.%I CASCADE', r.view_name);
+        EXECUTE format($sql$
+            CREATE VIEW SCHEMA_DATA_ROLLUP.%1$I
+            WITH (timescaledb.continuous,
+                  timescaledb.refresh_interval = %2$L,
+                  timescaledb.ignore_invalidation_older_than = %3$L)
+            AS SELECT time_bucket(%2$L::INTERVAL, time) AS bucket, series_id, max(time) AS time, last(value, time) AS value
+            FROM SCHEMA_DATA.%4$I
+            GROUP BY time_bucket(%2$L::INTERVAL, time), series_id
+        $sql$, r.view_name, r.resolution,
+            SCHEMA_CATALOG.get_metric_retention_period(r.metric_name), r.table_name);
+    END LOOP;
+END
+$$;
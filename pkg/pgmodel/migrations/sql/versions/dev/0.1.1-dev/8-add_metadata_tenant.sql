@@ -0,0 +1,3 @@
+ALTER TABLE SCHEMA_CATALOG.metadata ADD COLUMN tenant TEXT NOT NULL DEFAULT '';
+ALTER TABLE SCHEMA_CATALOG.metadata DROP CONSTRAINT metadata_pkey;
+ALTER TABLE SCHEMA_CATALOG.metadata ADD PRIMARY KEY (tenant, metric_family, type, unit, help);
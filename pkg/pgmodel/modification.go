@@ -0,0 +1,136 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/timescale/promscale/pkg/log"
+)
+
+const (
+	markDataModifiedSQL     = "SELECT " + catalogSchema + ".mark_data_modified($1)"
+	getDataModificationsSQL = "SELECT tenant, generation FROM " + catalogSchema + ".data_modification"
+
+	// DefaultDataModificationPollInterval is how often the modifications
+	// recorded by other connectors and the import are checked for.
+	DefaultDataModificationPollInterval = 10 * time.Second
+)
+
+// CacheInvalidator drops the cached query results that changed or removed
+// samples contribute to, like query.ResultsCache.
+type CacheInvalidator interface {
+	// Invalidate drops the results of tenant, or of all the tenants if it
+	// is empty, that samples at or after mint contribute to. It returns
+	// false if none of those results can be cached.
+	Invalidate(tenant string, mint int64) bool
+}
+
+// markDataModified records in the database that samples of tenant were
+// changed or removed, so that the other connectors drop the results they
+// cached for it.
+func markDataModified(conn pgxConn, tenant string) error {
+	_, err := conn.Exec(context.Background(), markDataModifiedSQL, tenant)
+	return err
+}
+
+// MarkDataModified records that samples of tenant, or of all the tenants if
+// it is empty, were changed or removed by another process than the
+// connectors, such as an import.
+func MarkDataModified(pool *pgxpool.Pool, tenant string) error {
+	return markDataModified(&pgxConnImpl{conn: pool}, tenant)
+}
+
+// invalidateModified drops the cached results of the tenants that samples
+// at or after mint were changed or removed for, and records the
+// modification for the other connectors if any cached results were dropped.
+func invalidateModified(conn pgxConn, invalidator CacheInvalidator, tenants map[string]struct{}, mint int64) {
+	if invalidator == nil {
+		return
+	}
+	for tenant := range tenants {
+		if !invalidator.Invalidate(tenant, mint) {
+			continue
+		}
+		if err := markDataModified(conn, tenant); err != nil {
+			log.Warn("msg", "could not record the modification of the data", "tenant", tenant, "err", err)
+		}
+	}
+}
+
+// DataModificationWatcher drops the cached results of the tenants whose
+// data were modified by other connectors or an import.
+type DataModificationWatcher struct {
+	conn        pgxConn
+	invalidator CacheInvalidator
+	generations map[string]int64
+	stop        chan struct{}
+}
+
+// WatchDataModifications starts checking the modifications recorded in the
+// database every interval, until the watcher is stopped.
+func WatchDataModifications(pool *pgxpool.Pool, invalidator CacheInvalidator, interval time.Duration) *DataModificationWatcher {
+	w := &DataModificationWatcher{
+		conn:        &pgxConnImpl{conn: pool},
+		invalidator: invalidator,
+		stop:        make(chan struct{}),
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := w.check(); err != nil {
+				log.Warn("msg", "could not check the modifications of the data", "err", err)
+			}
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return w
+}
+
+// Stop stops the watcher.
+func (w *DataModificationWatcher) Stop() {
+	close(w.stop)
+}
+
+// check invalidates the tenants whose generation changed since the last
+// check. The first check only records the generations.
+func (w *DataModificationWatcher) check() error {
+	rows, err := w.conn.Query(context.Background(), getDataModificationsSQL)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	generations := make(map[string]int64)
+	for rows.Next() {
+		var tenant string
+		var generation int64
+		if err = rows.Scan(&tenant, &generation); err != nil {
+			return err
+		}
+		generations[tenant] = generation
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if w.generations != nil {
+		for tenant, generation := range generations {
+			if w.generations[tenant] != generation {
+				w.invalidator.Invalidate(tenant, math.MinInt64)
+			}
+		}
+	}
+	w.generations = generations
+	return nil
+}
@@ -0,0 +1,66 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDataModificationWatcher(t *testing.T) {
+	invalidator := &recordingInvalidator{}
+	w := &DataModificationWatcher{
+		conn: &sqlRecorder{t: t, queries: []sqlQuery{
+			{sql: getDataModificationsSQL, results: rowResults{{"", int64(1)}, {"t1", int64(3)}}},
+			{sql: getDataModificationsSQL, results: rowResults{{"", int64(2)}, {"t1", int64(3)}, {"t2", int64(1)}}},
+		}},
+		invalidator: invalidator,
+	}
+
+	if err := w.check(); err != nil {
+		t.Fatal(err)
+	}
+	if len(invalidator.tenants) != 0 {
+		t.Errorf("unexpected invalidation on the first check: %v", invalidator.tenants)
+	}
+
+	if err := w.check(); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(invalidator.tenants)
+	if !reflect.DeepEqual(invalidator.tenants, []string{"", "t2"}) {
+		t.Errorf("unexpected invalidated tenants: %v", invalidator.tenants)
+	}
+	for _, mint := range invalidator.mints {
+		if mint != math.MinInt64 {
+			t.Errorf("unexpected invalidation from %d", mint)
+		}
+	}
+}
+
+// freshInvalidator caches no results of the samples after its horizon.
+type freshInvalidator struct {
+	horizon int64
+}
+
+func (f freshInvalidator) Invalidate(tenant string, mint int64) bool {
+	return mint <= f.horizon
+}
+
+func TestInvalidateModified(t *testing.T) {
+	tenants := map[string]struct{}{"t1": {}}
+
+	// nothing is recorded for samples without cached results
+	invalidateModified(&sqlRecorder{t: t}, freshInvalidator{horizon: 100}, tenants, 200)
+	invalidateModified(&sqlRecorder{t: t}, nil, tenants, 0)
+
+	conn := &sqlRecorder{t: t, queries: []sqlQuery{{sql: markDataModifiedSQL, args: []interface{}{"t1"}}}}
+	invalidateModified(conn, freshInvalidator{horizon: 100}, tenants, 50)
+	if conn.nextQuery != 1 {
+		t.Errorf("the modification wasn't recorded")
+	}
+}
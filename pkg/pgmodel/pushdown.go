@@ -0,0 +1,193 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+)
+
+const (
+	// Steps of the evaluation, shared by all pushdowns so that the values
+	// line up with the times.
+	pushdownTimeClause = "ARRAY(SELECT generate_series($%d::timestamptz, $%d::timestamptz, $%d))"
+
+	// Signature shared by the range functions of the extension, which
+	// compute the value of every step at once.
+	extensionValueClauseFormat = "%s($%%d, $%%d, $%%d, $%%d, time, value ORDER BY time ASC)"
+
+	// Computes the value of every step by applying an aggregate to the
+	// samples w(t, v) within the range of the step. Stale markers are never
+	// part of a range, and steps with no samples are NULL, which the series
+	// set skips.
+	windowValueClauseFormat = `ARRAY(
+		SELECT (
+			SELECT %s
+			FROM unnest(array_agg(m.time ORDER BY m.time), array_agg(m.value ORDER BY m.time)) AS w(t, v)
+			WHERE w.t >= g.t - $%%d::interval AND w.t <= g.t
+			AND float8send(w.v) <> '\x7ff0000000000002'::bytea
+		)
+		FROM generate_series($%%d::timestamptz, $%%d::timestamptz, $%%d) AS g(t)
+		ORDER BY g.t)`
+
+	// Aggregates the per-series results of the wrapped query ps across the
+	// series of every group, a group being identified by the ids of its
+	// grouping labels.
+	aggregateSQLFormat = `SELECT a.labels, array_agg(a.t ORDER BY a.t), array_agg(a.v ORDER BY a.t)
+	FROM (
+		SELECT ARRAY(
+			SELECT l.id FROM ` + catalogSchema + `.label l
+			WHERE l.id = ANY(ps.labels) AND l.key = ANY($%%d::text[])
+			ORDER BY l.id) AS labels,
+		u.t, %s AS v
+		FROM (%s) AS ps(labels, time_array, value_array),
+		unnest(ps.time_array, ps.value_array) AS u(t, v)
+		WHERE u.v IS NOT NULL
+		GROUP BY 1, u.t
+	) AS a
+	GROUP BY a.labels`
+
+	// Prometheus' irate: the per-second rate between the last two samples
+	// of the range, the last one alone being the increase on counter resets.
+	iRateWindowAggregate = `CASE WHEN count(*) < 2 THEN NULL ELSE
+		(CASE WHEN (array_agg(w.v ORDER BY w.t DESC))[1] < (array_agg(w.v ORDER BY w.t DESC))[2]
+			THEN (array_agg(w.v ORDER BY w.t DESC))[1]
+			ELSE (array_agg(w.v ORDER BY w.t DESC))[1] - (array_agg(w.v ORDER BY w.t DESC))[2] END)
+		/ extract(epoch FROM (array_agg(w.t ORDER BY w.t DESC))[1] - (array_agg(w.t ORDER BY w.t DESC))[2]) END`
+)
+
+// PromQL's min and max skip NaN samples unless every sample is NaN, while
+// PostgreSQL sorts NaN above every number. These aggregates only fall back to
+// NaN when there is nothing else to aggregate.
+const (
+	nanSkippingMin = "COALESCE(min(%[1]s) FILTER (WHERE %[1]s <> 'NaN'), min(%[1]s))"
+	nanSkippingMax = "COALESCE(max(%[1]s) FILTER (WHERE %[1]s <> 'NaN'), max(%[1]s))"
+)
+
+// rangePushdown describes how a PromQL range function is evaluated in the
+// database. Exactly one of the fields is set.
+type rangePushdown struct {
+	// extension function computing the values of all the steps
+	extensionFunc string
+	// SQL aggregate computing the value of a single step from the samples
+	// w(t, v) within its range
+	windowAggregate string
+}
+
+// rangePushdowns are the range functions we can evaluate in the database,
+// keyed by name.
+var rangePushdowns = map[string]rangePushdown{
+	"delta":            {extensionFunc: "prom_delta"},
+	"increase":         {extensionFunc: "prom_increase"},
+	"rate":             {extensionFunc: "prom_rate"},
+	"irate":            {windowAggregate: iRateWindowAggregate},
+	"avg_over_time":    {windowAggregate: "avg(w.v)"},
+	"min_over_time":    {windowAggregate: fmt.Sprintf(nanSkippingMin, "w.v")},
+	"max_over_time":    {windowAggregate: fmt.Sprintf(nanSkippingMax, "w.v")},
+	"sum_over_time":    {windowAggregate: "sum(w.v)"},
+	"count_over_time":  {windowAggregate: "NULLIF(count(*), 0)::float8"},
+	"stddev_over_time": {windowAggregate: "stddev_pop(w.v)"},
+	"stdvar_over_time": {windowAggregate: "var_pop(w.v)"},
+}
+
+// aggregatePushdowns are the SQL aggregates evaluating PromQL aggregations
+// across the values u.v of a group's series at a single step, keyed by the
+// PromQL operator.
+var aggregatePushdowns = map[string]string{
+	"sum":   "sum(u.v)",
+	"avg":   "avg(u.v)",
+	"min":   fmt.Sprintf(nanSkippingMin, "u.v"),
+	"max":   fmt.Sprintf(nanSkippingMax, "u.v"),
+	"count": "count(u.v)::float8",
+}
+
+// getPushdownFinalizer returns a finalizer evaluating the range function
+// applied to the selected matrix, along with a simple aggregation of its
+// results if there is one, in the database. Returns a nil finalizer if the
+// expression cannot be pushed down.
+// The storage does not know the lookback delta of instant vector selectors,
+// so only range functions, and aggregations over them, are pushed down.
+func getPushdownFinalizer(otherClauses string, values []interface{}, hints *storage.SelectHints, path []parser.Node) (*queryFinalizer, parser.Node) {
+	pushdown, ok := rangePushdowns[hints.Func]
+	if !ok || (pushdown.extensionFunc != "" && !ExtensionIsInstalled) {
+		return nil, nil
+	}
+	// subqueries are evaluated at steps of their own, not at the ones of the
+	// hints
+	for _, n := range path {
+		if _, ok := n.(*parser.SubqueryExpr); ok {
+			return nil, nil
+		}
+	}
+
+	// the function must be applied directly to the selected matrix
+	matrix, ok := path[len(path)-1].(*parser.MatrixSelector)
+	if !ok {
+		return nil, nil
+	}
+	// the engine expects results at the evaluation times, not at the ones
+	// shifted by the offset
+	if vs, ok := matrix.VectorSelector.(*parser.VectorSelector); !ok || vs.Offset != 0 {
+		return nil, nil
+	}
+	call, ok := path[len(path)-2].(*parser.Call)
+	if !ok || call.Func.Name != hints.Func || len(call.Args) != 1 {
+		return nil, nil
+	}
+
+	queryStart := hints.Start + hints.Range
+	queryEnd := hints.End
+	stepDuration := time.Second
+	rangeDuration := time.Duration(hints.Range) * time.Millisecond
+	if hints.Step > 0 {
+		stepDuration = time.Duration(hints.Step) * time.Millisecond
+	} else if queryStart != queryEnd {
+		return nil, nil
+	}
+
+	qf := &queryFinalizer{
+		timeClause:        pushdownTimeClause,
+		timeParams:        []interface{}{model.Time(queryStart).Time(), model.Time(queryEnd).Time(), stepDuration},
+		restOfQuery:       otherClauses,
+		restOfQueryParams: values,
+	}
+	if pushdown.extensionFunc != "" {
+		qf.valueClause = fmt.Sprintf(extensionValueClauseFormat, pushdown.extensionFunc)
+		qf.valueParams = []interface{}{model.Time(hints.Start).Time(), model.Time(queryEnd).Time(), stepDuration.Milliseconds(), rangeDuration.Milliseconds()}
+	} else {
+		qf.valueClause = fmt.Sprintf(windowValueClauseFormat, pushdown.windowAggregate)
+		qf.valueParams = []interface{}{rangeDuration, model.Time(queryStart).Time(), model.Time(queryEnd).Time(), stepDuration}
+	}
+
+	var topNode parser.Node = call
+	if len(path) >= 3 {
+		if agg, ok := path[len(path)-3].(*parser.AggregateExpr); ok && canPushdownAggregate(agg) {
+			qf.aggregateClause = aggregatePushdowns[agg.Op.String()]
+			qf.aggregateGrouping = agg.Grouping
+			topNode = agg
+		}
+	}
+	return qf, topNode
+}
+
+func canPushdownAggregate(agg *parser.AggregateExpr) bool {
+	if _, ok := aggregatePushdowns[agg.Op.String()]; !ok {
+		return false
+	}
+	if agg.Without || agg.Param != nil {
+		return false
+	}
+	// the engine drops the metric name from pushed down results
+	for _, l := range agg.Grouping {
+		if l == MetricNameLabelName {
+			return false
+		}
+	}
+	return true
+}
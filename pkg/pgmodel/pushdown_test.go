@@ -0,0 +1,169 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+)
+
+func TestGetQueryFinalizerPushdown(t *testing.T) {
+	defer func(installed bool) { ExtensionIsInstalled = installed }(ExtensionIsInstalled)
+
+	testCases := []struct {
+		name         string
+		query        string
+		function     string
+		extension    bool
+		instant      bool
+		expectTop    string
+		expectValue  string
+		expectGroups []string
+	}{
+		{
+			name:        "extension function",
+			query:       `rate(m[5m])`,
+			function:    "rate",
+			extension:   true,
+			expectTop:   `rate(m[5m])`,
+			expectValue: "prom_rate(",
+		},
+		{
+			name:     "extension function without the extension",
+			query:    `rate(m[5m])`,
+			function: "rate",
+		},
+		{
+			name:        "window function without the extension",
+			query:       `avg_over_time(m[5m])`,
+			function:    "avg_over_time",
+			expectTop:   `avg_over_time(m[5m])`,
+			expectValue: "avg(w.v)",
+		},
+		{
+			name:        "instant query",
+			query:       `count_over_time(m[5m])`,
+			function:    "count_over_time",
+			instant:     true,
+			expectTop:   `count_over_time(m[5m])`,
+			expectValue: "count(*)",
+		},
+		{
+			name:         "aggregation",
+			query:        `sum by (job) (irate(m[5m]))`,
+			function:     "irate",
+			expectTop:    `sum by(job) (irate(m[5m]))`,
+			expectValue:  "sum(u.v)",
+			expectGroups: []string{"job"},
+		},
+		{
+			name:         "aggregation without grouping",
+			query:        `max(increase(m[5m]))`,
+			function:     "increase",
+			extension:    true,
+			expectTop:    `max(increase(m[5m]))`,
+			expectValue:  "COALESCE(max(u.v) FILTER (WHERE u.v <> 'NaN'), max(u.v))",
+			expectGroups: []string{},
+		},
+		{
+			name:        "aggregation using without",
+			query:       `sum without (job) (sum_over_time(m[5m]))`,
+			function:    "sum_over_time",
+			expectTop:   `sum_over_time(m[5m])`,
+			expectValue: "sum(w.v)",
+		},
+		{
+			name:        "aggregation with a parameter",
+			query:       `topk(2, max_over_time(m[5m]))`,
+			function:    "max_over_time",
+			expectTop:   `max_over_time(m[5m])`,
+			expectValue: "COALESCE(max(w.v) FILTER (WHERE w.v <> 'NaN'), max(w.v))",
+		},
+		{
+			name:        "aggregation by the metric name",
+			query:       `count by (__name__) (min_over_time(m[5m]))`,
+			function:    "min_over_time",
+			expectTop:   `min_over_time(m[5m])`,
+			expectValue: "COALESCE(min(w.v) FILTER (WHERE w.v <> 'NaN'), min(w.v))",
+		},
+		{
+			name:     "offset",
+			query:    `avg_over_time(m[5m] offset 1m)`,
+			function: "avg_over_time",
+		},
+		{
+			name:     "unsupported function",
+			query:    `quantile_over_time(0.5, m[5m])`,
+			function: "quantile_over_time",
+		},
+		{
+			name:     "subquery",
+			query:    `avg_over_time(rate(m[5m])[10m:1m])`,
+			function: "rate",
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			ExtensionIsInstalled = c.extension
+
+			expr, err := parser.ParseExpr(c.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var path []parser.Node
+			parser.Inspect(expr, func(node parser.Node, p []parser.Node) error {
+				if _, ok := node.(*parser.VectorSelector); ok {
+					path = append([]parser.Node{}, p...)
+				}
+				return nil
+			})
+
+			hints := &storage.SelectHints{
+				Start: 0,
+				End:   3600 * 1000,
+				Step:  60 * 1000,
+				Range: 300 * 1000,
+				Func:  c.function,
+			}
+			if c.instant {
+				hints.Start = hints.End - hints.Range
+				hints.Step = 0
+			}
+
+			qf, topNode, err := getQueryFinalizer("", []interface{}{"param"}, hints, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.expectTop == "" {
+				if topNode != nil {
+					t.Fatalf("unexpected pushdown of %s", topNode)
+				}
+				return
+			}
+			if topNode == nil || topNode.String() != c.expectTop {
+				t.Fatalf("unexpected top node: got %v wanted %s", topNode, c.expectTop)
+			}
+
+			sql, args, err := qf.Finalize()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(sql, c.expectValue) {
+				t.Errorf("expected %q in the query:\n%s", c.expectValue, sql)
+			}
+			if strings.Contains(sql, "%!") {
+				t.Errorf("badly formatted query:\n%s", sql)
+			}
+			if c.expectGroups != nil && !reflect.DeepEqual(args[1], c.expectGroups) {
+				t.Errorf("unexpected grouping: got %v wanted %v", args[1], c.expectGroups)
+			}
+		})
+	}
+}
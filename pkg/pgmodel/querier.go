@@ -5,6 +5,9 @@
 package pgmodel
 
 import (
+	"context"
+	"io"
+
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
@@ -14,20 +17,41 @@ import (
 // Reader reads the data based on the provided read request.
 type Reader interface {
 	Read(*prompb.ReadRequest) (*prompb.ReadResponse, error)
+	// StreamRead answers the read request with STREAMED_XOR_CHUNKS framing:
+	// every series is encoded into XOR chunks and written to w as a
+	// serialized ChunkedReadResponse as soon as it is read from the database.
+	StreamRead(req *prompb.ReadRequest, w io.Writer) error
+	ExemplarReader
+	MetadataReader
+	// ForTenant returns a Reader that only sees the series and metadata of
+	// tenant.
+	ForTenant(tenant string) Reader
 }
 
 // Querier queries the data using the provided query data and returns the
 // matching timeseries.
 type Querier interface {
 	Query(*prompb.Query) ([]*prompb.TimeSeries, error)
+	StreamQuery(query *prompb.Query, fn func(*prompb.TimeSeries) error) error
 	Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node)
 	LabelNames() ([]string, error)
 	LabelValues(labelName string) ([]string, error)
 	NumCachedLabels() int
 	LabelsCacheCapacity() int
+	MetadataReader
+	ExemplarReader
+	// ForTenant returns a Querier that only sees the series and metadata of
+	// tenant.
+	ForTenant(tenant string) Querier
+	// WithStats returns a Querier recording the SQL statements it runs in
+	// stats.
+	WithStats(stats *QueryStats) Querier
+	// WithContext returns a Querier running its SQL statements with ctx, the
+	// statements sharing a single row limit.
+	WithContext(ctx context.Context) Querier
 }
 
-//HealthChecker allows checking for proper operations.
+// HealthChecker allows checking for proper operations.
 type HealthChecker interface {
 	HealthCheck() error
 }
@@ -40,7 +64,21 @@ type QueryHealthChecker interface {
 
 // DBReader reads data from the database.
 type DBReader struct {
-	db QueryHealthChecker
+	db     QueryHealthChecker
+	tenant string
+}
+
+// ForTenant returns a reader that only sees the series of tenant.
+func (r *DBReader) ForTenant(tenant string) Reader {
+	return &DBReader{db: r.db, tenant: tenant}
+}
+
+// querier returns the querier scoped to the tenant of the reader, if any.
+func (r *DBReader) querier() Querier {
+	if r.tenant == "" {
+		return r.db
+	}
+	return r.db.ForTenant(r.tenant)
 }
 
 func (r *DBReader) GetQuerier() QueryHealthChecker {
@@ -56,8 +94,10 @@ func (r *DBReader) Read(req *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 		Results: make([]*prompb.QueryResult, len(req.Queries)),
 	}
 
+	// the queries of the request share a single row limit
+	querier := r.querier().WithContext(context.Background())
 	for i, q := range req.Queries {
-		tts, err := r.db.Query(q)
+		tts, err := querier.Query(q)
 		if err != nil {
 			return nil, err
 		}
@@ -69,6 +109,37 @@ func (r *DBReader) Read(req *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 	return &resp, nil
 }
 
+func (r *DBReader) StreamRead(req *prompb.ReadRequest, w io.Writer) error {
+	if req == nil {
+		return nil
+	}
+
+	cw := newChunkedResponseWriter(w, maxBytesInFrame)
+	// the queries of the request share a single row limit
+	querier := r.querier().WithContext(context.Background())
+	for i, q := range req.Queries {
+		queryIndex := int64(i)
+		err := querier.StreamQuery(q, func(ts *prompb.TimeSeries) error {
+			return cw.writeSeries(queryIndex, ts)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Metadata returns the metric metadata stored from remote-write requests.
+func (r *DBReader) Metadata(metric string, limit int) (map[string][]MetricMetadata, error) {
+	return r.querier().Metadata(metric, limit)
+}
+
+// Exemplars returns the stored exemplars of the selected series.
+func (r *DBReader) Exemplars(start, end int64, matcherSets ...[]*labels.Matcher) ([]ExemplarQueryResult, error) {
+	return r.querier().Exemplars(start, end, matcherSets...)
+}
+
 // HealthCheck checks that the reader is properly connected
 func (r *DBReader) HealthCheck() error {
 	return r.db.HealthCheck()
@@ -4,6 +4,7 @@
 package pgmodel
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -32,6 +33,18 @@ func (q *mockQuerier) Query(*prompb.Query) ([]*prompb.TimeSeries, error) {
 	return q.tts, q.err
 }
 
+func (q *mockQuerier) StreamQuery(_ *prompb.Query, fn func(*prompb.TimeSeries) error) error {
+	if q.err != nil {
+		return q.err
+	}
+	for _, ts := range q.tts {
+		if err := fn(ts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (q *mockQuerier) LabelNames() ([]string, error) {
 	return q.labelNames, q.labelNamesErr
 }
@@ -53,6 +66,26 @@ func (q *mockQuerier) LabelsCacheCapacity() int {
 	return 0
 }
 
+func (q *mockQuerier) Metadata(string, int) (map[string][]MetricMetadata, error) {
+	return nil, nil
+}
+
+func (q *mockQuerier) Exemplars(int64, int64, ...[]*labels.Matcher) ([]ExemplarQueryResult, error) {
+	return nil, nil
+}
+
+func (q *mockQuerier) ForTenant(string) Querier {
+	return q
+}
+
+func (q *mockQuerier) WithStats(*QueryStats) Querier {
+	return q
+}
+
+func (q *mockQuerier) WithContext(context.Context) Querier {
+	return q
+}
+
 func TestDBReaderRead(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -134,7 +167,7 @@ func TestDBReaderRead(t *testing.T) {
 				err: c.err,
 			}
 
-			r := DBReader{mq}
+			r := DBReader{db: mq}
 
 			res, err := r.Read(c.req)
 
@@ -174,7 +207,7 @@ func TestDBReaderRead(t *testing.T) {
 func TestHealthCheck(t *testing.T) {
 	mq := &mockQuerier{}
 
-	r := DBReader{mq}
+	r := DBReader{db: mq}
 
 	err := r.HealthCheck()
 	if err != nil {
@@ -12,7 +12,6 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v4"
-	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/prometheus/prometheus/promql/parser"
@@ -176,39 +175,48 @@ func buildTimeSeries(rows []timescaleRow, q *pgxQuerier) ([]*prompb.TimeSeries,
 	results := make([]*prompb.TimeSeries, 0, len(rows))
 
 	for _, row := range rows {
-		if row.err != nil {
-			return nil, row.err
-		}
-
-		if len(row.times.Elements) != len(row.values.Elements) {
-			return nil, fmt.Errorf("query returned a mismatch in timestamps and values")
-		}
-
-		promLabels, err := q.getPrompbLabelsForIds(row.labelIds)
+		result, err := rowToTimeSeries(row, q)
 		if err != nil {
 			return nil, err
 		}
 
-		sort.Slice(promLabels, func(i, j int) bool {
-			return promLabels[i].Name < promLabels[j].Name
-		})
+		results = append(results, result)
+	}
 
-		result := &prompb.TimeSeries{
-			Labels:  promLabels,
-			Samples: make([]prompb.Sample, 0, len(row.times.Elements)),
-		}
+	return results, nil
+}
 
-		for i := range row.times.Elements {
-			result.Samples = append(result.Samples, prompb.Sample{
-				Timestamp: timestamptzToMs(row.times.Elements[i]),
-				Value:     row.values.Elements[i].Float,
-			})
-		}
+func rowToTimeSeries(row timescaleRow, q *pgxQuerier) (*prompb.TimeSeries, error) {
+	if row.err != nil {
+		return nil, row.err
+	}
 
-		results = append(results, result)
+	if len(row.times.Elements) != len(row.values.Elements) {
+		return nil, fmt.Errorf("query returned a mismatch in timestamps and values")
 	}
 
-	return results, nil
+	promLabels, err := q.getPrompbLabelsForIds(row.labelIds)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(promLabels, func(i, j int) bool {
+		return promLabels[i].Name < promLabels[j].Name
+	})
+
+	result := &prompb.TimeSeries{
+		Labels:  promLabels,
+		Samples: make([]prompb.Sample, 0, len(row.times.Elements)),
+	}
+
+	for i := range row.times.Elements {
+		result.Samples = append(result.Samples, prompb.Sample{
+			Timestamp: timestamptzToMs(row.times.Elements[i]),
+			Value:     row.values.Elements[i].Float,
+		})
+	}
+
+	return result, nil
 }
 
 func buildMetricNameSeriesIDQuery(cases []string) string {
@@ -222,7 +230,7 @@ func buildTimeseriesBySeriesIDQuery(filter metricTimeRangeFilter, series []Serie
 	}
 	return fmt.Sprintf(
 		timeseriesBySeriesIDsSQLFormat,
-		pgx.Identifier{dataSchema, filter.metric}.Sanitize(),
+		filter.samplesTable(),
 		pgx.Identifier{dataSeriesSchema, filter.metric}.Sanitize(),
 		strings.Join(s, ","),
 		filter.startTime,
@@ -234,7 +242,7 @@ func buildTimeseriesByLabelClausesQuery(filter metricTimeRangeFilter, cases []st
 	hints *storage.SelectHints, path []parser.Node) (string, []interface{}, parser.Node, error) {
 	restOfQuery := fmt.Sprintf(
 		timeseriesByMetricSQLFormat,
-		pgx.Identifier{dataSchema, filter.metric}.Sanitize(),
+		filter.samplesTable(),
 		pgx.Identifier{dataSeriesSchema, filter.metric}.Sanitize(),
 		strings.Join(cases, " AND "),
 		filter.startTime,
@@ -270,51 +278,35 @@ type queryFinalizer struct {
 	valueParams       []interface{}
 	restOfQuery       string
 	restOfQueryParams []interface{}
+	// if set, the per-series results are aggregated with this SQL aggregate
+	// by the labels in aggregateGrouping
+	aggregateClause   string
+	aggregateGrouping []string
 }
 
 func (t *queryFinalizer) Finalize() (string, []interface{}, error) {
 	fullQuery := `SELECT s.labels, ` + t.timeClause + `, ` + t.valueClause + t.restOfQuery
 	newParams := append(t.timeParams, t.valueParams...)
+	if t.aggregateClause != "" {
+		grouping := t.aggregateGrouping
+		if grouping == nil {
+			grouping = []string{}
+		}
+		fullQuery = fmt.Sprintf(aggregateSQLFormat, t.aggregateClause, fullQuery)
+		newParams = append([]interface{}{grouping}, newParams...)
+	}
 	return fillInParameters(fullQuery, t.restOfQueryParams, newParams...)
 }
 
 /* The path is the list of ancestors (direct parent last) returned node is the most-ancestral node processed by the pushdown */
 func getQueryFinalizer(otherClauses string, values []interface{}, hints *storage.SelectHints, path []parser.Node) (*queryFinalizer, parser.Node, error) {
-	if ExtensionIsInstalled && path != nil && hints != nil && len(path) >= 2 && !hasSubquery(path) {
-		var topNode parser.Node
-
-		node := path[len(path)-2]
-		switch n := node.(type) {
-		case *parser.Call:
-			if n.Func.Name == "delta" {
-				topNode = node
-				queryStart := hints.Start + hints.Range
-				queryEnd := hints.End
-				stepDuration := time.Second
-				rangeDuration := time.Duration(hints.Range) * time.Millisecond
-
-				if hints.Step > 0 {
-					stepDuration = time.Duration(hints.Step) * time.Millisecond
-				} else {
-					if queryStart != queryEnd {
-						panic("query start should equal query end")
-					}
-				}
-				qf := queryFinalizer{
-					timeClause:        "ARRAY(SELECT generate_series($%d::timestamptz, $%d::timestamptz, $%d))",
-					timeParams:        []interface{}{model.Time(queryStart).Time(), model.Time(queryEnd).Time(), stepDuration},
-					valueClause:       "prom_delta($%d, $%d,$%d, $%d, time, value ORDER BY time ASC)",
-					valueParams:       []interface{}{model.Time(hints.Start).Time(), model.Time(queryEnd).Time(), int64(stepDuration.Milliseconds()), int64(rangeDuration.Milliseconds())},
-					restOfQuery:       otherClauses,
-					restOfQueryParams: values,
-				}
-				return &qf, topNode, nil
-			}
-		default:
-			//No pushdown optimization by default
+	if path != nil && hints != nil && len(path) >= 2 && !hasSubquery(path) {
+		if qf, topNode := getPushdownFinalizer(otherClauses, values, hints, path); qf != nil {
+			return qf, topNode, nil
 		}
 	}
 
+	//No pushdown optimization by default
 	qf := queryFinalizer{
 		timeClause:        "array_agg(m.time ORDER BY time) as time_array",
 		valueClause:       "array_agg(m.value ORDER BY time)",
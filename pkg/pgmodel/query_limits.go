@@ -0,0 +1,140 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+)
+
+const setStatementTimeoutSQL = "SELECT set_config('statement_timeout', $1, true)"
+
+// QueryLimits bound the resources used by the queries of a reader. Zero
+// values disable a limit.
+type QueryLimits struct {
+	// MaxSeriesPerSelector is the maximum number of series a selector may
+	// return.
+	MaxSeriesPerSelector int
+	// MaxRowsPerQuery is the maximum number of rows the SQL queries run to
+	// answer a query may return, all together.
+	MaxRowsPerQuery int64
+	// StatementTimeout is the statement_timeout of the SQL queries.
+	StatementTimeout time.Duration
+}
+
+// ErrQueryLimit is returned when a query goes over one of the QueryLimits.
+type ErrQueryLimit string
+
+func (e ErrQueryLimit) Error() string {
+	return string(e)
+}
+
+func errTooManySeries(max int) error {
+	return ErrQueryLimit(fmt.Sprintf("query processing would load too many series, a selector may select at most %d series", max))
+}
+
+func errTooManyRows(max int64) error {
+	return ErrQueryLimit(fmt.Sprintf("query processing would read too many rows from the database, the SQL queries of a query may return at most %d rows", max))
+}
+
+type rowCountKey struct{}
+
+// withRowCount returns a context counting the rows read by the SQL queries
+// run with it, so that they share a single MaxRowsPerQuery.
+func withRowCount(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rowCountKey{}, new(int64))
+}
+
+// rowCount returns the row count of ctx, or a new one if it has none.
+func rowCount(ctx context.Context) *int64 {
+	if count, ok := ctx.Value(rowCountKey{}).(*int64); ok {
+		return count
+	}
+	return new(int64)
+}
+
+// IsStatementTimeout returns true if err was caused by an SQL query running
+// for longer than the statement timeout.
+func IsStatementTimeout(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.QueryCanceled
+}
+
+// limitSeries returns a visitor calling visit for at most max rows, each row
+// being a series. It doesn't limit anything if max isn't positive.
+func limitSeries(max int, visit rowVisitor) rowVisitor {
+	if max <= 0 {
+		return visit
+	}
+	seen := 0
+	return func(row timescaleRow) error {
+		seen++
+		if seen > max {
+			return errTooManySeries(max)
+		}
+		return visit(row)
+	}
+}
+
+// limitedRows stops reading rows with an error once more than max rows,
+// counted in count, were read. The count may be shared by rows read
+// concurrently.
+type limitedRows struct {
+	pgx.Rows
+	count *int64
+	max   int64
+	err   error
+}
+
+func newLimitedRows(rows pgx.Rows, count *int64, max int64) pgx.Rows {
+	if max <= 0 {
+		return rows
+	}
+	return &limitedRows{Rows: rows, count: count, max: max}
+}
+
+func (r *limitedRows) Next() bool {
+	if r.err != nil || !r.Rows.Next() {
+		return false
+	}
+	if atomic.AddInt64(r.count, 1) > r.max {
+		r.err = errTooManyRows(r.max)
+		r.Rows.Close()
+		return false
+	}
+	return true
+}
+
+func (r *limitedRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.Rows.Err()
+}
+
+// txRows are the rows of a query run in its own transaction, ended once the
+// rows are closed.
+type txRows struct {
+	pgx.Rows
+	tx pgx.Tx
+}
+
+func (r *txRows) Close() {
+	r.Rows.Close()
+	_ = r.tx.Rollback(context.Background())
+}
+
+// setStatementTimeout sets the statement timeout for the rest of tx.
+func setStatementTimeout(ctx context.Context, tx pgx.Tx, timeout time.Duration) error {
+	_, err := tx.Exec(ctx, setStatementTimeoutSQL, fmt.Sprintf("%dms", timeout.Milliseconds()))
+	return err
+}
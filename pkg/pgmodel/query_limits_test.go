@@ -0,0 +1,132 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+)
+
+func TestLimitedRows(t *testing.T) {
+	var (
+		count int64
+		value string
+	)
+	page := newLimitedRows(&mockRows{results: rowResults{{"a"}, {"b"}}}, &count, 3)
+	for page.Next() {
+		if err := page.Scan(&value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if page.Err() != nil || count != 2 {
+		t.Fatalf("unexpected first page: %v, %d rows", page.Err(), count)
+	}
+
+	// the rows are counted over all the pages
+	page = newLimitedRows(&mockRows{results: rowResults{{"c"}, {"d"}}}, &count, 3)
+	if !page.Next() || page.Scan(&value) != nil || page.Next() {
+		t.Errorf("only one more row should be read")
+	}
+	var limitErr ErrQueryLimit
+	if !errors.As(page.Err(), &limitErr) {
+		t.Errorf("unexpected error: %v", page.Err())
+	}
+
+	if rows := newLimitedRows(&mockRows{}, &count, 0); rows.Next() {
+		t.Errorf("rows shouldn't be limited without a max")
+	}
+}
+
+func TestRowCount(t *testing.T) {
+	ctx := withRowCount(context.Background())
+	if rowCount(ctx) != rowCount(ctx) {
+		t.Errorf("the queries run with a context should share its row count")
+	}
+	if rowCount(context.Background()) == rowCount(context.Background()) {
+		t.Errorf("the queries run without a row count should each have their own")
+	}
+
+	var value string
+	first := newLimitedRows(&mockRows{results: rowResults{{"a"}, {"b"}}}, rowCount(ctx), 3)
+	second := newLimitedRows(&mockRows{results: rowResults{{"c"}, {"d"}}}, rowCount(ctx), 3)
+	for first.Next() {
+		if err := first.Scan(&value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for second.Next() {
+		if err := second.Scan(&value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var limitErr ErrQueryLimit
+	if first.Err() != nil || !errors.As(second.Err(), &limitErr) {
+		t.Errorf("unexpected errors: %v, %v", first.Err(), second.Err())
+	}
+}
+
+func TestLimitSeries(t *testing.T) {
+	visited := 0
+	visit := limitSeries(2, func(timescaleRow) error {
+		visited++
+		return nil
+	})
+	for i := 0; i < 2; i++ {
+		if err := visit(timescaleRow{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := visit(timescaleRow{}); err != errTooManySeries(2) || visited != 2 {
+		t.Errorf("unexpected result over the limit: %v, %d visited", err, visited)
+	}
+}
+
+func TestSelectMaxSeries(t *testing.T) {
+	mock := &sqlRecorder{t: t}
+	querier := &pgxQuerier{
+		conn:                 mock,
+		metricTableNames:     &mockMetricCache{metricCache: map[string]string{"foo": "foo"}},
+		labels:               clockcache.WithMax(0),
+		maxSeriesPerSelector: 1,
+	}
+	matcher := labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "foo")
+	ss, _ := querier.Select(1000, 2000, false, &storage.SelectHints{}, nil, matcher)
+	cursor := ss.(*pgxCursorSeriesSet)
+	mock.queries = []sqlQuery{{
+		sql:  cursor.sql,
+		args: cursor.args,
+		results: rowResults{
+			{[]int64{1}, []time.Time{time.Unix(1, 0)}, []float64{1}},
+			{[]int64{2}, []time.Time{time.Unix(1, 0)}, []float64{2}},
+		},
+	}}
+
+	if !ss.Next() || ss.Next() {
+		t.Errorf("only one series should be returned")
+	}
+	var limitErr ErrQueryLimit
+	if !errors.As(ss.Err(), &limitErr) {
+		t.Errorf("unexpected error: %v", ss.Err())
+	}
+}
+
+func TestIsStatementTimeout(t *testing.T) {
+	timeout := &pgconn.PgError{Code: pgerrcode.QueryCanceled}
+	if !IsStatementTimeout(fmt.Errorf("Error retrieving series set: %w", timeout)) {
+		t.Errorf("a wrapped query canceled error should be a statement timeout")
+	}
+	if IsStatementTimeout(&pgconn.PgError{Code: pgerrcode.UndefinedTable}) || IsStatementTimeout(fmt.Errorf("foo")) {
+		t.Errorf("other errors aren't statement timeouts")
+	}
+}
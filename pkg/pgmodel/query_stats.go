@@ -0,0 +1,144 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// SQLStatement describes an SQL query run to answer a PromQL query.
+type SQLStatement struct {
+	SQL      string        `json:"sql"`
+	Rows     int64         `json:"rows"`
+	Duration time.Duration `json:"duration"`
+}
+
+func (s SQLStatement) String() string {
+	return fmt.Sprintf("%q rows=%d duration=%s", s.SQL, s.Rows, s.Duration)
+}
+
+// QueryStats collects the SQL statements run by a querier. It is safe for
+// concurrent use, since the metrics of a selector are queried in parallel.
+type QueryStats struct {
+	lock       sync.Mutex
+	statements []SQLStatement
+}
+
+// Statements returns the statements run so far, in the order they started.
+func (s *QueryStats) Statements() []SQLStatement {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	statements := make([]SQLStatement, len(s.statements))
+	copy(statements, s.statements)
+	return statements
+}
+
+// Rows returns the number of rows read by all the statements.
+func (s *QueryStats) Rows() int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var rows int64
+	for _, st := range s.statements {
+		rows += st.Rows
+	}
+	return rows
+}
+
+func (s *QueryStats) start(sql string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.statements = append(s.statements, SQLStatement{SQL: sql})
+	return len(s.statements) - 1
+}
+
+func (s *QueryStats) finish(statement int, rows int64, duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.statements[statement].Rows += rows
+	s.statements[statement].Duration = duration
+}
+
+// statsConn records the queries and cursors of a connection in stats. A
+// statement lasts until its rows or cursor are closed.
+type statsConn struct {
+	pgxConn
+	stats *QueryStats
+}
+
+func (c *statsConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+	statement := c.stats.start(sql)
+	rows, err := c.pgxConn.Query(ctx, sql, args...)
+	if err != nil {
+		c.stats.finish(statement, 0, time.Since(start))
+		return rows, err
+	}
+	return &statsRows{Rows: rows, onClose: func(read int64) {
+		c.stats.finish(statement, read, time.Since(start))
+	}}, nil
+}
+
+func (c *statsConn) DeclareCursor(ctx context.Context, sql string, args ...interface{}) (pgxCursor, error) {
+	start := time.Now()
+	statement := c.stats.start(sql)
+	cursor, err := c.pgxConn.DeclareCursor(ctx, sql, args...)
+	if err != nil {
+		c.stats.finish(statement, 0, time.Since(start))
+		return nil, err
+	}
+	return &statsCursor{pgxCursor: cursor, stats: c.stats, statement: statement, start: start}, nil
+}
+
+type statsCursor struct {
+	pgxCursor
+	stats     *QueryStats
+	statement int
+	start     time.Time
+}
+
+func (c *statsCursor) Fetch(ctx context.Context, n int) (pgx.Rows, error) {
+	rows, err := c.pgxCursor.Fetch(ctx, n)
+	if err != nil {
+		return rows, err
+	}
+	return &statsRows{Rows: rows, onClose: func(read int64) {
+		c.stats.finish(c.statement, read, time.Since(c.start))
+	}}, nil
+}
+
+func (c *statsCursor) Close(ctx context.Context) error {
+	err := c.pgxCursor.Close(ctx)
+	c.stats.finish(c.statement, 0, time.Since(c.start))
+	return err
+}
+
+// statsRows counts the rows read and reports them once closed.
+type statsRows struct {
+	pgx.Rows
+	read    int64
+	closed  bool
+	onClose func(read int64)
+}
+
+func (r *statsRows) Next() bool {
+	if !r.Rows.Next() {
+		return false
+	}
+	r.read++
+	return true
+}
+
+func (r *statsRows) Close() {
+	r.Rows.Close()
+	if !r.closed {
+		r.closed = true
+		r.onClose(r.read)
+	}
+}
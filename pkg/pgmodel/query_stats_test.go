@@ -0,0 +1,62 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+)
+
+func TestQueryStats(t *testing.T) {
+	mock := &sqlRecorder{t: t}
+	stats := &QueryStats{}
+	querier := (&pgxQuerier{
+		conn:             mock,
+		metricTableNames: &mockMetricCache{metricCache: map[string]string{"foo": "foo"}},
+		labels:           clockcache.WithMax(0),
+		cursorPageSize:   1,
+	}).WithStats(stats).(*pgxQuerier)
+
+	matcher := labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "foo")
+	ss, _ := querier.Select(1000, 2000, false, &storage.SelectHints{}, nil, matcher)
+	cursor := ss.(*pgxCursorSeriesSet)
+	mock.queries = []sqlQuery{
+		{
+			sql:  cursor.sql,
+			args: cursor.args,
+			results: rowResults{
+				{[]int64{1}, []time.Time{time.Unix(1, 0)}, []float64{1}},
+				{[]int64{2}, []time.Time{time.Unix(1, 0)}, []float64{2}},
+			},
+		},
+		{sql: "SELECT", results: rowResults{}},
+	}
+	for ss.Next() {
+	}
+	if ss.Err() != nil {
+		t.Fatal(ss.Err())
+	}
+	if err := querier.HealthCheck(); err != nil {
+		t.Fatal(err)
+	}
+
+	statements := stats.Statements()
+	if len(statements) != 2 {
+		t.Fatalf("unexpected statements: %v", statements)
+	}
+	if statements[0].SQL != cursor.sql || statements[0].Rows != 2 {
+		t.Errorf("unexpected cursor statement: %v", statements[0])
+	}
+	if statements[1].SQL != "SELECT" || statements[1].Rows != 0 {
+		t.Errorf("unexpected query statement: %v", statements[1])
+	}
+	if stats.Rows() != 2 {
+		t.Errorf("unexpected number of rows: %d", stats.Rows())
+	}
+}
@@ -0,0 +1,126 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync/atomic"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/relabel"
+	"github.com/timescale/promscale/pkg/prompb"
+	"gopkg.in/yaml.v2"
+)
+
+// relabelConfigFile is the format of the relabel config file. It uses the
+// same syntax as the write_relabel_configs of a Prometheus remote_write
+// section so that rules can be moved from Prometheus as they are.
+type relabelConfigFile struct {
+	WriteRelabelConfigs []*relabel.Config `yaml:"write_relabel_configs"`
+}
+
+// Relabeler applies Prometheus relabel rules to the series being ingested.
+// The rules are read from a file and can be reloaded while ingesting.
+type Relabeler struct {
+	path string
+	// configs holds the current []*relabel.Config
+	configs atomic.Value
+}
+
+// NewRelabeler returns a Relabeler applying the rules of the config file at
+// path. No rules are applied if path is empty.
+func NewRelabeler(path string) (*Relabeler, error) {
+	r := &Relabeler{path: path}
+	r.configs.Store([]*relabel.Config(nil))
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the config file again. If the file is invalid, the previous
+// rules are kept.
+func (r *Relabeler) Reload() error {
+	if r.path == "" {
+		return nil
+	}
+	configs, err := loadRelabelConfigs(r.path)
+	if err != nil {
+		return err
+	}
+	r.configs.Store(configs)
+	return nil
+}
+
+func loadRelabelConfigs(path string) ([]*relabel.Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading relabel config file: %w", err)
+	}
+	cfg := relabelConfigFile{}
+	if err = yaml.UnmarshalStrict(content, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing relabel config file %s: %w", path, err)
+	}
+	for i, c := range cfg.WriteRelabelConfigs {
+		if c == nil {
+			return nil, fmt.Errorf("parsing relabel config file %s: empty rule %d", path, i)
+		}
+	}
+	return cfg.WriteRelabelConfigs, nil
+}
+
+// apply relabels the series of tts in place. Series dropped by the rules, or
+// left without a metric name, have their samples and exemplars removed so
+// that they are skipped on insert. The tenant of a series can't be changed by
+// the rules.
+func (r *Relabeler) apply(tts []prompb.TimeSeries) {
+	if r == nil {
+		return
+	}
+	configs := r.configs.Load().([]*relabel.Config)
+	if len(configs) == 0 {
+		return
+	}
+
+	for i := range tts {
+		t := &tts[i]
+		if len(t.Samples) == 0 && len(t.Exemplars) == 0 {
+			continue
+		}
+
+		lset := make(labels.Labels, 0, len(t.Labels))
+		tenant := ""
+		for _, l := range t.Labels {
+			if l.Name == TenantLabelName {
+				tenant = l.Value
+				continue
+			}
+			lset = append(lset, labels.Label{Name: l.Name, Value: l.Value})
+		}
+		sort.Sort(lset)
+
+		lset = relabel.Process(lset, configs...)
+		if lset == nil || lset.Get(MetricNameLabelName) == "" {
+			relabelDroppedSamples.Add(float64(len(t.Samples)))
+			t.Samples = nil
+			t.Exemplars = nil
+			continue
+		}
+
+		newLabels := make([]prompb.Label, 0, len(lset)+1)
+		for _, l := range lset {
+			if l.Name == TenantLabelName {
+				continue
+			}
+			newLabels = append(newLabels, prompb.Label{Name: l.Name, Value: l.Value})
+		}
+		if tenant != "" {
+			newLabels = append(newLabels, prompb.Label{Name: TenantLabelName, Value: tenant})
+		}
+		t.Labels = newLabels
+	}
+}
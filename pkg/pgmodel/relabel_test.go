@@ -0,0 +1,135 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const testRelabelConfig = `
+write_relabel_configs:
+  - source_labels: [__name__]
+    regex: go_.*
+    action: drop
+  - regex: pod_uid|__tenant__
+    action: labeldrop
+  - source_labels: [job]
+    regex: (.*)-canary
+    target_label: job
+    replacement: $1
+  - source_labels: [__name__]
+    regex: up
+    target_label: __tenant__
+    replacement: other
+  - source_labels: [env]
+    regex: test
+    action: drop
+`
+
+func writeRelabelConfig(t *testing.T, dir, content string) string {
+	path := filepath.Join(dir, "relabel.yml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func seriesWithLabels(kv ...string) prompb.TimeSeries {
+	ts := prompb.TimeSeries{Samples: []prompb.Sample{{Timestamp: 1, Value: 1}}}
+	for i := 0; i < len(kv); i += 2 {
+		ts.Labels = append(ts.Labels, prompb.Label{Name: kv[i], Value: kv[i+1]})
+	}
+	return ts
+}
+
+func TestRelabelerApply(t *testing.T) {
+	dir, err := ioutil.TempDir("", "relabel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := NewRelabeler(writeRelabelConfig(t, dir, testRelabelConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tts := []prompb.TimeSeries{
+		seriesWithLabels(MetricNameLabelName, "go_goroutines", "job", "api"),
+		seriesWithLabels("pod_uid", "1234", MetricNameLabelName, "requests", "job", "api-canary"),
+		seriesWithLabels(MetricNameLabelName, "up", "job", "api", TenantLabelName, "team-a"),
+		seriesWithLabels(MetricNameLabelName, "requests", "env", "test"),
+	}
+	r.apply(tts)
+
+	expected := [][]prompb.Label{
+		nil,
+		{{Name: MetricNameLabelName, Value: "requests"}, {Name: "job", Value: "api"}},
+		{{Name: MetricNameLabelName, Value: "up"}, {Name: "job", Value: "api"}, {Name: TenantLabelName, Value: "team-a"}},
+		nil,
+	}
+	for i, ts := range tts {
+		if expected[i] == nil {
+			if ts.Samples != nil {
+				t.Errorf("series %d should have been dropped: %v", i, ts.Labels)
+			}
+			continue
+		}
+		if ts.Samples == nil {
+			t.Errorf("series %d should have been kept", i)
+		}
+		if !reflect.DeepEqual(ts.Labels, expected[i]) {
+			t.Errorf("unexpected labels for series %d:\ngot\n%v\nwanted\n%v", i, ts.Labels, expected[i])
+		}
+	}
+}
+
+func TestRelabelerReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "relabel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := writeRelabelConfig(t, dir, testRelabelConfig)
+	r, err := NewRelabeler(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeRelabelConfig(t, dir, "write_relabel_configs:\n  - action: unknown\n")
+	if err = r.Reload(); err == nil {
+		t.Fatal("expected an error for an invalid config")
+	}
+	tts := []prompb.TimeSeries{seriesWithLabels(MetricNameLabelName, "go_goroutines")}
+	r.apply(tts)
+	if tts[0].Samples != nil {
+		t.Errorf("the previous rules should be kept on an invalid config")
+	}
+
+	writeRelabelConfig(t, dir, "write_relabel_configs: []\n")
+	if err = r.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	tts = []prompb.TimeSeries{seriesWithLabels(MetricNameLabelName, "go_goroutines")}
+	r.apply(tts)
+	if tts[0].Samples == nil {
+		t.Errorf("the new rules should be applied after a reload")
+	}
+
+	if _, err = NewRelabeler(filepath.Join(dir, "missing.yml")); err == nil {
+		t.Errorf("expected an error for a missing config file")
+	}
+	if r, err = NewRelabeler(""); err != nil {
+		t.Fatal(err)
+	}
+	r.apply(tts)
+}
@@ -0,0 +1,241 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+)
+
+const (
+	getRollupsSQL = `SELECT m.table_name, r.name, (EXTRACT(epoch FROM r.resolution) * 1000)::BIGINT, mr.view_name
+	FROM ` + catalogSchema + `.metric_rollup mr
+	INNER JOIN ` + catalogSchema + `.metric m ON (m.id = mr.metric_id)
+	INNER JOIN ` + catalogSchema + `.rollup_resolution r ON (r.id = mr.resolution_id)`
+	getRollupResolutionSQL = "SELECT (EXTRACT(epoch FROM resolution) * 1000)::BIGINT FROM " + catalogSchema + ".rollup_resolution WHERE name = $1"
+	addRollupResolutionSQL = "SELECT " + promSchema + ".add_rollup_resolution($1, $2, $3)"
+	setRollupRetentionSQL  = "SELECT " + promSchema + ".set_rollup_retention_period($1, $2)"
+
+	// rollupSamplesSQLFormat reads the last samples of the buckets of a
+	// rollup within a time range. The buckets are filtered on, since the
+	// rollup is partitioned by their start, from the one the start of the
+	// range is in.
+	rollupSamplesSQLFormat = `(SELECT bucket, series_id, time, value FROM %[1]s
+	WHERE bucket >= time_bucket('%[4]d milliseconds'::interval, '%[2]s'::timestamptz) AND bucket <= '%[3]s')`
+
+	// rollupCacheTTL is how long the rollups read from the catalog are used
+	// before being read again.
+	rollupCacheTTL = time.Minute
+	// rollupLookbackDelta is the lookback delta of the PromQL engine. Instant
+	// selectors only find a sample if the buckets are no longer than it.
+	rollupLookbackDelta = 5 * time.Minute
+)
+
+var rollupNameRegexp = regexp.MustCompile("^[a-z0-9]+$")
+
+// RollupResolution is a resolution all metrics are downsampled to.
+type RollupResolution struct {
+	// Name names the continuous aggregates of the resolution.
+	Name       string
+	Resolution time.Duration
+	// Retention is how long the downsampled data is kept.
+	Retention time.Duration
+}
+
+// ParseRollupResolutions parses a comma separated list of
+// <resolution>:<retention> pairs, such as 5m:30d,1h:1y. Every resolution is
+// named after its duration.
+func ParseRollupResolutions(list string) ([]RollupResolution, error) {
+	var res []RollupResolution
+	for _, e := range strings.Split(list, ",") {
+		if e = strings.TrimSpace(e); e == "" {
+			continue
+		}
+		parts := strings.Split(e, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid downsampling resolution %q, must be <resolution>:<retention>", e)
+		}
+		resolution, err := model.ParseDuration(parts[0])
+		if err != nil || resolution <= 0 {
+			return nil, fmt.Errorf("invalid downsampling resolution %q", parts[0])
+		}
+		retention, err := model.ParseDuration(parts[1])
+		if err != nil || retention <= 0 {
+			return nil, fmt.Errorf("invalid downsampling retention %q", parts[1])
+		}
+		if !rollupNameRegexp.MatchString(parts[0]) {
+			return nil, fmt.Errorf("invalid downsampling resolution %q, must only use lowercase letters and digits", parts[0])
+		}
+		res = append(res, RollupResolution{
+			Name:       parts[0],
+			Resolution: time.Duration(resolution),
+			Retention:  time.Duration(retention),
+		})
+	}
+	return res, nil
+}
+
+// SetupRollupResolutions makes sure all metrics are downsampled to every
+// resolution with its retention. Resolutions that aren't listed are left as
+// is.
+func SetupRollupResolutions(pool *pgxpool.Pool, resolutions []RollupResolution) error {
+	return setupRollupResolutions(&pgxConnImpl{conn: pool}, resolutions)
+}
+
+func setupRollupResolutions(conn pgxConn, resolutions []RollupResolution) error {
+	for _, r := range resolutions {
+		if err := setupRollupResolution(conn, r); err != nil {
+			return fmt.Errorf("error setting up downsampling resolution %s: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+func setupRollupResolution(conn pgxConn, r RollupResolution) error {
+	rows, err := conn.Query(context.Background(), getRollupResolutionSQL, r.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		rows.Close()
+		_, err = conn.Exec(context.Background(), addRollupResolutionSQL, r.Name, r.Resolution, r.Retention)
+		return err
+	}
+
+	var existing int64
+	if err = rows.Scan(&existing); err != nil {
+		return err
+	}
+	rows.Close()
+	if existing != r.Resolution.Milliseconds() {
+		return fmt.Errorf("the resolution already exists with a different duration")
+	}
+	_, err = conn.Exec(context.Background(), setRollupRetentionSQL, r.Name, r.Retention)
+	return err
+}
+
+// rollupRangeFuncs are the functions over range vectors that give about the
+// same result on the last sample of every bucket as on the raw samples.
+var rollupRangeFuncs = map[string]bool{
+	"rate":           true,
+	"increase":       true,
+	"irate":          true,
+	"delta":          true,
+	"idelta":         true,
+	"deriv":          true,
+	"predict_linear": true,
+}
+
+// rollup is a continuous aggregate downsampling a metric to a resolution.
+type rollup struct {
+	name string
+	// resolution is the length of the buckets, in milliseconds.
+	resolution int64
+	view       string
+}
+
+// rollupCache caches the rollups of every metric table, coarsest first.
+type rollupCache struct {
+	conn pgxConn
+
+	mtx      sync.Mutex
+	loadedAt time.Time
+	byTable  map[string][]rollup
+}
+
+func newRollupCache(conn pgxConn) *rollupCache {
+	return &rollupCache{conn: conn}
+}
+
+// get returns the rollups of the metric table, coarsest first.
+func (c *rollupCache) get(table string) ([]rollup, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.byTable == nil || time.Since(c.loadedAt) > rollupCacheTTL {
+		byTable, err := c.load()
+		if err != nil {
+			return nil, err
+		}
+		c.byTable = byTable
+		c.loadedAt = time.Now()
+	}
+	return c.byTable[table], nil
+}
+
+func (c *rollupCache) load() (map[string][]rollup, error) {
+	rows, err := c.conn.Query(context.Background(), getRollupsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byTable := make(map[string][]rollup)
+	for rows.Next() {
+		var (
+			table string
+			r     rollup
+		)
+		if err = rows.Scan(&table, &r.name, &r.resolution, &r.view); err != nil {
+			return nil, err
+		}
+		byTable[table] = append(byTable[table], r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, rollups := range byTable {
+		sort.Slice(rollups, func(i, j int) bool {
+			return rollups[i].resolution > rollups[j].resolution
+		})
+	}
+	return byTable, nil
+}
+
+// chooseRollup returns the coarsest of rollups, sorted coarsest first, that
+// can answer the select described by hints and path without changing its
+// result much. Only range queries use rollups, with buckets no longer than
+// their step: instant selectors need a sample within the lookback delta, and
+// range selectors at least two samples in their range.
+func chooseRollup(rollups []rollup, hints *storage.SelectHints, path []parser.Node) (rollup, bool) {
+	if hints == nil || hints.Step <= 0 || hasSubquery(path) {
+		return rollup{}, false
+	}
+	if hints.Range > 0 && !rollupRangeFuncs[hints.Func] {
+		return rollup{}, false
+	}
+	for _, r := range rollups {
+		if r.resolution > hints.Step {
+			continue
+		}
+		if hints.Range == 0 && r.resolution > rollupLookbackDelta.Milliseconds() {
+			continue
+		}
+		if hints.Range > 0 && 2*r.resolution > hints.Range {
+			continue
+		}
+		return r, true
+	}
+	return rollup{}, false
+}
+
+// rollupWarning is the warning returned with the results read from r.
+func rollupWarning(metric string, r rollup) error {
+	return fmt.Errorf("using data of metric %s downsampled to %s", metric, time.Duration(r.resolution)*time.Millisecond)
+}
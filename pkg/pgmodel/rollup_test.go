@@ -0,0 +1,145 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+)
+
+func TestParseRollupResolutions(t *testing.T) {
+	res, err := ParseRollupResolutions("5m:30d, 1h:1y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []RollupResolution{
+		{Name: "5m", Resolution: 5 * time.Minute, Retention: 30 * 24 * time.Hour},
+		{Name: "1h", Resolution: time.Hour, Retention: 365 * 24 * time.Hour},
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("unexpected resolutions: %+v", res)
+	}
+
+	if res, err = ParseRollupResolutions(""); err != nil || len(res) != 0 {
+		t.Errorf("an empty list should have no resolutions: %v %v", res, err)
+	}
+	for _, invalid := range []string{"5m", "5m:30d:1y", "foo:30d", "5m:foo", "0s:30d"} {
+		if _, err = ParseRollupResolutions(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestChooseRollup(t *testing.T) {
+	rollups := []rollup{
+		{name: "1h", resolution: time.Hour.Milliseconds(), view: "foo_1h"},
+		{name: "5m", resolution: (5 * time.Minute).Milliseconds(), view: "foo_5m"},
+	}
+	hours := func(h int64) int64 { return h * time.Hour.Milliseconds() }
+
+	testCases := []struct {
+		name     string
+		hints    *storage.SelectHints
+		path     []parser.Node
+		expected string
+	}{
+		{name: "no hints"},
+		{name: "instant query", hints: &storage.SelectHints{}},
+		{name: "step shorter than the rollups", hints: &storage.SelectHints{Step: 60 * 1000}},
+		{name: "instant selector", hints: &storage.SelectHints{Step: hours(2)}, expected: "foo_5m"},
+		{name: "rate over a long range", hints: &storage.SelectHints{Step: hours(2), Range: hours(2), Func: "rate"}, expected: "foo_1h"},
+		{name: "rate over a short range", hints: &storage.SelectHints{Step: hours(2), Range: hours(1), Func: "rate"}, expected: "foo_5m"},
+		{name: "range function changed by downsampling", hints: &storage.SelectHints{Step: hours(2), Range: hours(2), Func: "count_over_time"}},
+		{name: "subquery", hints: &storage.SelectHints{Step: hours(2)}, path: []parser.Node{&parser.SubqueryExpr{}}},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			r, ok := chooseRollup(rollups, c.hints, c.path)
+			if ok != (c.expected != "") || r.view != c.expected {
+				t.Errorf("unexpected rollup: got %q wanted %q", r.view, c.expected)
+			}
+		})
+	}
+}
+
+func TestSelectRollup(t *testing.T) {
+	mock := &sqlRecorder{
+		t: t,
+		queries: []sqlQuery{
+			{
+				sql: getRollupsSQL,
+				results: rowResults{
+					{"foo", "5m", (5 * time.Minute).Milliseconds(), "foo_5m"},
+					{"foo", "1h", time.Hour.Milliseconds(), "foo_1h"},
+				},
+			},
+		},
+	}
+	querier := &pgxQuerier{
+		conn:             mock,
+		metricTableNames: &mockMetricCache{metricCache: map[string]string{"foo": "foo"}},
+		labels:           clockcache.WithMax(0),
+		rollups:          newRollupCache(mock),
+	}
+	matcher := labels.MustNewMatcher(labels.MatchEqual, MetricNameLabelName, "foo")
+
+	ss, _ := querier.Select(1000, 2000, false, &storage.SelectHints{Step: 10 * 60 * 1000}, nil, matcher)
+	cursor, ok := ss.(*pgxCursorSeriesSet)
+	if !ok {
+		t.Fatalf("unexpected series set: %T %v", ss, ss.Err())
+	}
+	if !strings.Contains(cursor.sql, `FROM "prom_data_rollup"."foo_5m"
+	WHERE bucket >= time_bucket('300000 milliseconds'::interval, '1970-01-01T00:00:01Z'::timestamptz) AND bucket <= '1970-01-01T00:00:02Z') m`) {
+		t.Errorf("the buckets of the 5m rollup within the range should be used:\n%s", cursor.sql)
+	}
+	if len(cursor.Warnings()) != 1 || cursor.Warnings()[0].Error() != "using data of metric foo downsampled to 5m0s" {
+		t.Errorf("unexpected warnings: %v", cursor.Warnings())
+	}
+
+	// instant queries read raw data, the rollups are cached
+	ss, _ = querier.Select(1000, 2000, false, &storage.SelectHints{}, nil, matcher)
+	cursor = ss.(*pgxCursorSeriesSet)
+	if !strings.Contains(cursor.sql, `"prom_data"."foo"`) || len(cursor.Warnings()) != 0 {
+		t.Errorf("raw data should be used:\n%s\n%v", cursor.sql, cursor.Warnings())
+	}
+	ss, _ = querier.Select(1000, 2000, false, &storage.SelectHints{Step: 2 * 60 * 60 * 1000}, nil, matcher)
+	if cursor = ss.(*pgxCursorSeriesSet); !strings.Contains(cursor.sql, `"prom_data_rollup"."foo_5m"`) {
+		t.Errorf("the 5m rollup should be used:\n%s", cursor.sql)
+	}
+	if mock.nextQuery != 1 {
+		t.Errorf("the rollups should only be read once, got %d queries", mock.nextQuery)
+	}
+}
+
+func TestSetupRollupResolutions(t *testing.T) {
+	mock := &sqlRecorder{
+		t: t,
+		queries: []sqlQuery{
+			{sql: getRollupResolutionSQL, args: []interface{}{"5m"}},
+			{sql: addRollupResolutionSQL, args: []interface{}{"5m", 5 * time.Minute, 24 * time.Hour}},
+			{sql: getRollupResolutionSQL, args: []interface{}{"1h"}, results: rowResults{{time.Hour.Milliseconds()}}},
+			{sql: setRollupRetentionSQL, args: []interface{}{"1h", 48 * time.Hour}},
+			{sql: getRollupResolutionSQL, args: []interface{}{"2h"}, results: rowResults{{time.Hour.Milliseconds()}}},
+		},
+	}
+	err := setupRollupResolutions(mock, []RollupResolution{
+		{Name: "5m", Resolution: 5 * time.Minute, Retention: 24 * time.Hour},
+		{Name: "1h", Resolution: time.Hour, Retention: 48 * time.Hour},
+		{Name: "2h", Resolution: 2 * time.Hour, Retention: 48 * time.Hour},
+	})
+	if err == nil || !strings.Contains(err.Error(), "resolution 2h") {
+		t.Errorf("expected an error for a resolution with a different duration, got %v", err)
+	}
+	if mock.nextQuery != len(mock.queries) {
+		t.Errorf("unexpected number of queries: %d", mock.nextQuery)
+	}
+}
@@ -1,10 +1,14 @@
 package pgmodel
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/prometheus/prometheus/tsdb/chunkenc"
@@ -61,14 +65,24 @@ func (p *pgxSeriesSet) At() storage.Series {
 		return nil
 	}
 
-	row := &p.rows[p.rowIdx]
+	ps, err := rowToSeries(&p.rows[p.rowIdx], p.querier)
+	if err == errInvalidData {
+		p.err = err
+	}
+	if ps == nil {
+		return nil
+	}
+	return ps
+}
 
+// rowToSeries converts a database row into a storage.Series, resolving the
+// label ids through querier.
+func rowToSeries(row *timescaleRow, querier labelQuerier) (*pgxSeries, error) {
 	if row.err != nil {
-		return nil
+		return nil, row.err
 	}
 	if len(row.times.Elements) != len(row.values.Elements) {
-		p.err = errInvalidData
-		return nil
+		return nil, errInvalidData
 	}
 
 	ps := &pgxSeries{
@@ -79,16 +93,16 @@ func (p *pgxSeriesSet) At() storage.Series {
 	// this should pretty much always be non-empty due to __name__, but it
 	// costs little to check here
 	if len(row.labelIds) != 0 {
-		lls, err := p.querier.getLabelsForIds(row.labelIds)
+		lls, err := querier.getLabelsForIds(row.labelIds)
 		if err != nil {
 			log.Error("err", err)
-			return nil
+			return nil, err
 		}
 		sort.Sort(lls)
 		ps.labels = lls
 	}
 
-	return ps
+	return ps, nil
 }
 
 // Err implements storage.SeriesSet.
@@ -101,6 +115,225 @@ func (p *pgxSeriesSet) Err() error {
 
 func (p *pgxSeriesSet) Warnings() storage.Warnings { return nil }
 
+// pgxCursorSeriesSet implements storage.SeriesSet on top of a database
+// cursor. The query is only sent once Next() is first called, and rows are
+// fetched pageSize series at a time, so the set itself never holds more than
+// one page of results in memory. The cursor, and with it the connection, is
+// released as soon as the set is exhausted, on the first error, or when Close
+// is called.
+type pgxCursorSeriesSet struct {
+	ctx      context.Context
+	conn     pgxConn
+	sql      string
+	args     []interface{}
+	pageSize int
+	querier  labelQuerier
+	// maxSeries, if set, is the maximum number of series of the set.
+	maxSeries int
+
+	cursor     pgxCursor
+	page       pgx.Rows
+	rowsInPage int
+	series     int
+	done       bool
+	hasRow     bool
+	row        timescaleRow
+	err        error
+	warnings   storage.Warnings
+}
+
+// pgxCursorSeriesSet must implement storage.SeriesSet
+var _ storage.SeriesSet = (*pgxCursorSeriesSet)(nil)
+
+func buildCursorSeriesSet(ctx context.Context, conn pgxConn, sql string, args []interface{}, pageSize int, querier labelQuerier) *pgxCursorSeriesSet {
+	if pageSize < 1 {
+		pageSize = DefaultCursorPageSize
+	}
+	return &pgxCursorSeriesSet{
+		ctx:      ctx,
+		conn:     conn,
+		sql:      sql,
+		args:     args,
+		pageSize: pageSize,
+		querier:  querier,
+	}
+}
+
+// Next forwards the internal cursor to next storage.Series, fetching the next
+// page of rows from the database if needed.
+func (p *pgxCursorSeriesSet) Next() bool {
+	if p.done {
+		return false
+	}
+
+	if p.cursor == nil {
+		cursor, err := p.conn.DeclareCursor(p.ctx, p.sql, p.args...)
+		if err != nil {
+			// If we are getting undefined table error, it means the query
+			// is looking for a metric which doesn't exist in the system.
+			if e, ok := err.(*pgconn.PgError); !ok || e.Code != pgerrcode.UndefinedTable {
+				p.err = err
+			}
+			p.Close()
+			return false
+		}
+		p.cursor = cursor
+	}
+
+	for {
+		if p.page == nil {
+			page, err := p.cursor.Fetch(p.ctx, p.pageSize)
+			if err != nil {
+				p.err = err
+				p.Close()
+				return false
+			}
+			p.page = page
+			p.rowsInPage = 0
+		}
+
+		if p.page.Next() {
+			p.rowsInPage++
+			p.series++
+			if p.maxSeries > 0 && p.series > p.maxSeries {
+				p.err = errTooManySeries(p.maxSeries)
+				p.Close()
+				return false
+			}
+			p.hasRow = true
+			p.row = timescaleRow{}
+			p.row.err = p.page.Scan(&p.row.labelIds, &p.row.times, &p.row.values)
+			if p.err == nil {
+				p.err = p.row.err
+			}
+			return true
+		}
+
+		err := p.page.Err()
+		lastPage := p.rowsInPage < p.pageSize
+		p.page.Close()
+		p.page = nil
+		if err != nil || lastPage {
+			p.err = err
+			p.Close()
+			return false
+		}
+	}
+}
+
+// At returns the current storage.Series.
+func (p *pgxCursorSeriesSet) At() storage.Series {
+	if !p.hasRow {
+		return nil
+	}
+
+	ps, err := rowToSeries(&p.row, p.querier)
+	if err == errInvalidData {
+		p.err = err
+	}
+	if ps == nil {
+		return nil
+	}
+	return ps
+}
+
+// Err implements storage.SeriesSet.
+func (p *pgxCursorSeriesSet) Err() error {
+	if p.err != nil {
+		return fmt.Errorf("Error retrieving series set: %w", p.err)
+	}
+	return nil
+}
+
+func (p *pgxCursorSeriesSet) Warnings() storage.Warnings { return p.warnings }
+
+// Close releases the cursor. It is safe to call Close multiple times.
+func (p *pgxCursorSeriesSet) Close() {
+	p.done = true
+	p.hasRow = false
+	p.row = timescaleRow{}
+	if p.page != nil {
+		p.page.Close()
+		p.page = nil
+	}
+	if p.cursor != nil {
+		if err := p.cursor.Close(context.Background()); err != nil {
+			log.Warn("msg", "error closing query cursor", "err", err)
+		}
+		p.cursor = nil
+	}
+}
+
+// chainedSeriesSet implements storage.SeriesSet over the series of several
+// sets, one after the other. Selectors matching several metrics read each
+// metric through its own cursor, and only one of them is open at a time.
+type chainedSeriesSet struct {
+	sets []*pgxCursorSeriesSet
+	cur  int
+	// maxSeries, if set, is the maximum number of series of all the sets.
+	maxSeries int
+	series    int
+	err       error
+}
+
+// chainedSeriesSet must implement storage.SeriesSet
+var _ storage.SeriesSet = (*chainedSeriesSet)(nil)
+
+// Next forwards to the next series of the current set, moving on to the next
+// set once it is exhausted.
+func (c *chainedSeriesSet) Next() bool {
+	for c.cur < len(c.sets) {
+		set := c.sets[c.cur]
+		if set.Next() {
+			c.series++
+			if c.maxSeries > 0 && c.series > c.maxSeries {
+				c.err = errTooManySeries(c.maxSeries)
+				c.Close()
+				return false
+			}
+			return true
+		}
+		if set.err != nil {
+			c.err = set.err
+			c.Close()
+			return false
+		}
+		c.cur++
+	}
+	return false
+}
+
+// At returns the current storage.Series.
+func (c *chainedSeriesSet) At() storage.Series {
+	if c.cur >= len(c.sets) {
+		return nil
+	}
+	return c.sets[c.cur].At()
+}
+
+// Err implements storage.SeriesSet.
+func (c *chainedSeriesSet) Err() error {
+	err := c.err
+	if err == nil && c.cur < len(c.sets) {
+		err = c.sets[c.cur].err
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving series set: %w", err)
+	}
+	return nil
+}
+
+func (c *chainedSeriesSet) Warnings() storage.Warnings { return nil }
+
+// Close releases the cursors of the sets not exhausted yet. It is safe to
+// call Close multiple times.
+func (c *chainedSeriesSet) Close() {
+	for _, set := range c.sets[c.cur:] {
+		set.Close()
+	}
+	c.cur = len(c.sets)
+}
+
 // pgxSeries implements storage.Series.
 type pgxSeries struct {
 	labels labels.Labels
@@ -2,6 +2,7 @@
 package pgmodel
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgproto3/v2"
 	"github.com/jackc/pgtype"
 	"github.com/prometheus/prometheus/pkg/labels"
@@ -451,3 +453,206 @@ func genSeries(labels []int64, ts []pgtype.Timestamptz, vs []pgtype.Float8) seri
 		values:     vs,
 	}
 }
+
+type cursorRecorder struct {
+	*sqlRecorder
+	cursor   *mockCursor
+	err      error
+	declared int
+}
+
+func (c *cursorRecorder) DeclareCursor(ctx context.Context, sql string, args ...interface{}) (pgxCursor, error) {
+	c.declared++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.cursor, nil
+}
+
+func TestPgxCursorSeriesSet(t *testing.T) {
+	testCases := []struct {
+		name       string
+		numRows    int
+		pageSize   int
+		declareErr error
+		expFetches int
+		expErr     bool
+	}{
+		{
+			name:       "no rows",
+			pageSize:   2,
+			expFetches: 1,
+		},
+		{
+			name:       "partial last page",
+			numRows:    3,
+			pageSize:   2,
+			expFetches: 2,
+		},
+		{
+			name:       "full pages",
+			numRows:    4,
+			pageSize:   2,
+			expFetches: 3,
+		},
+		{
+			name:       "default page size",
+			numRows:    4,
+			expFetches: 1,
+		},
+		{
+			name:       "declare error",
+			pageSize:   2,
+			declareErr: arbitraryErr,
+			expErr:     true,
+		},
+		{
+			name:       "undefined table",
+			pageSize:   2,
+			declareErr: &pgconn.PgError{Code: pgerrcode.UndefinedTable},
+		},
+	}
+
+	querier := mapQuerier{
+		mapping: map[int64]struct {
+			k string
+			v string
+		}{1: {k: MetricNameLabelName, v: "foo"}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			results := make(rowResults, c.numRows)
+			for i := range results {
+				results[i] = []interface{}{
+					[]int64{1},
+					[]time.Time{time.Unix(int64(i), 0)},
+					[]float64{float64(i)},
+				}
+			}
+			conn := &cursorRecorder{
+				sqlRecorder: &sqlRecorder{t: t},
+				cursor:      &mockCursor{results: results},
+				err:         c.declareErr,
+			}
+
+			p := buildCursorSeriesSet(context.Background(), conn, "SELECT 1", nil, c.pageSize, querier)
+			if conn.declared != 0 {
+				t.Fatal("cursor declared before iteration started")
+			}
+
+			count := 0
+			for p.Next() {
+				s := p.At()
+				if s == nil {
+					t.Fatalf("unexpected nil series at %d: %v", count, p.Err())
+				}
+				if s.Labels().Get(MetricNameLabelName) != "foo" {
+					t.Errorf("unexpected labels %v", s.Labels())
+				}
+				count++
+			}
+
+			if count != c.numRows {
+				t.Errorf("unexpected number of series: got %d wanted %d", count, c.numRows)
+			}
+			if p.At() != nil {
+				t.Error("unexpected series after the set was exhausted")
+			}
+			if p.Next() {
+				t.Error("unexpected next after the set was exhausted")
+			}
+			if (p.Err() != nil) != c.expErr {
+				t.Errorf("unexpected error: %v", p.Err())
+			}
+			if conn.cursor.fetches != c.expFetches {
+				t.Errorf("unexpected number of fetches: got %d wanted %d", conn.cursor.fetches, c.expFetches)
+			}
+			if c.declareErr == nil && !conn.cursor.closed {
+				t.Error("cursor was not closed")
+			}
+		})
+	}
+}
+
+func TestPgxCursorSeriesSetClose(t *testing.T) {
+	conn := &cursorRecorder{
+		sqlRecorder: &sqlRecorder{t: t},
+		cursor: &mockCursor{results: rowResults{
+			{[]int64{}, []time.Time{}, []float64{}},
+			{[]int64{}, []time.Time{}, []float64{}},
+		}},
+	}
+
+	p := buildCursorSeriesSet(context.Background(), conn, "SELECT 1", nil, 1, mapQuerier{})
+	if !p.Next() {
+		t.Fatal("expected a series")
+	}
+	p.Close()
+	if !conn.cursor.closed {
+		t.Error("cursor was not closed")
+	}
+	if p.Next() {
+		t.Error("unexpected next after close")
+	}
+}
+
+func TestChainedSeriesSet(t *testing.T) {
+	querier := mapQuerier{
+		mapping: map[int64]struct {
+			k string
+			v string
+		}{1: {k: MetricNameLabelName, v: "bar"}, 2: {k: MetricNameLabelName, v: "foo"}},
+	}
+	row := func(labelID int64) []interface{} {
+		return []interface{}{[]int64{labelID}, []time.Time{time.Unix(1, 0)}, []float64{1}}
+	}
+	newSets := func() ([]*cursorRecorder, []*pgxCursorSeriesSet) {
+		conns := []*cursorRecorder{
+			{sqlRecorder: &sqlRecorder{t: t}, cursor: &mockCursor{results: rowResults{row(1)}}},
+			{sqlRecorder: &sqlRecorder{t: t}, cursor: &mockCursor{results: rowResults{row(2), row(2)}}},
+		}
+		sets := make([]*pgxCursorSeriesSet, len(conns))
+		for i, conn := range conns {
+			sets[i] = buildCursorSeriesSet(context.Background(), conn, "SELECT 1", nil, 10, querier)
+		}
+		return conns, sets
+	}
+
+	conns, sets := newSets()
+	c := &chainedSeriesSet{sets: sets}
+	var metrics []string
+	for c.Next() {
+		metrics = append(metrics, c.At().Labels().Get(MetricNameLabelName))
+		// the next cursor is only declared once the previous one is done
+		if len(metrics) == 1 && conns[1].declared != 0 {
+			t.Error("the second cursor was declared before the first one was exhausted")
+		}
+	}
+	if c.Err() != nil {
+		t.Fatal(c.Err())
+	}
+	if !reflect.DeepEqual(metrics, []string{"bar", "foo", "foo"}) {
+		t.Errorf("unexpected series: %v", metrics)
+	}
+	for i, conn := range conns {
+		if !conn.cursor.closed {
+			t.Errorf("cursor %d was not closed", i)
+		}
+	}
+
+	// the series limit applies to all the sets together
+	conns, sets = newSets()
+	c = &chainedSeriesSet{sets: sets, maxSeries: 2}
+	count := 0
+	for c.Next() {
+		count++
+	}
+	var limitErr ErrQueryLimit
+	if count != 2 || !errors.As(c.Err(), &limitErr) {
+		t.Errorf("unexpected result: %d series, error %v", count, c.Err())
+	}
+	if !conns[1].cursor.closed {
+		t.Error("the cursor was not closed at the limit")
+	}
+}
@@ -0,0 +1,122 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// DefaultCopyMinSamples is the number of samples of a batch from which
+	// InsertModeAuto copies it through the staging table.
+	DefaultCopyMinSamples = 5000
+
+	insertSamplesSQLFormat     = "INSERT INTO %s(time, value, series_id) SELECT * FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) a(t,v,s) ORDER BY s,t ON CONFLICT DO NOTHING"
+	insertFromStagingSQLFormat = "INSERT INTO %s(time, value, series_id) SELECT time, value, series_id FROM " + stagingTable + " ORDER BY series_id, time ON CONFLICT DO NOTHING"
+)
+
+// dataColumns are the columns of the data tables, in the order of the rows
+// copied into them.
+var dataColumns = []string{"time", "value", "series_id"}
+
+// InsertMode is the way the samples are written to the data tables.
+type InsertMode int
+
+const (
+	// InsertModeInsert sends the samples of a batch as arrays, in a single
+	// INSERT skipping the duplicates.
+	InsertModeInsert InsertMode = iota
+	// InsertModeCopy copies the samples of a batch into a staging table
+	// with a binary COPY, then inserts them skipping the duplicates.
+	InsertModeCopy
+	// InsertModeCopyDirect copies the samples of a batch right into their
+	// table with a binary COPY. The batches holding duplicates fail to copy
+	// and are copied through the staging table instead, so it is only worth
+	// it when duplicates are rare.
+	InsertModeCopyDirect
+	// InsertModeAuto uses InsertModeCopy for the batches of at least the
+	// copy min samples, and InsertModeInsert for the smaller ones.
+	InsertModeAuto
+)
+
+var insertModeNames = map[InsertMode]string{
+	InsertModeInsert:     "insert",
+	InsertModeCopy:       "copy",
+	InsertModeCopyDirect: "copy-direct",
+	InsertModeAuto:       "auto",
+}
+
+func (m InsertMode) String() string {
+	if name, ok := insertModeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("InsertMode(%d)", int(m))
+}
+
+// ParseInsertMode returns the insert mode of the given name.
+func ParseInsertMode(name string) (InsertMode, error) {
+	for m, n := range insertModeNames {
+		if n == name {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid insert mode %q, must be one of insert, copy, copy-direct or auto", name)
+}
+
+// insertMethod chooses the insert mode of every batch.
+type insertMethod struct {
+	mode InsertMode
+	// copyMinSamples is the number of samples from which a batch is copied
+	// in InsertModeAuto.
+	copyMinSamples int
+}
+
+func (m insertMethod) modeFor(numRows int) InsertMode {
+	if m.mode != InsertModeAuto {
+		return m.mode
+	}
+	minSamples := m.copyMinSamples
+	if minSamples <= 0 {
+		minSamples = DefaultCopyMinSamples
+	}
+	if numRows >= minSamples {
+		return InsertModeCopy
+	}
+	return InsertModeInsert
+}
+
+// sampleRows is the pgx.CopyFromSource of the data table rows of a batch.
+type sampleRows struct {
+	*SampleInfoIterator
+}
+
+func (r sampleRows) Values() ([]interface{}, error) {
+	t, v, id := r.SampleInfoIterator.Values()
+	return []interface{}{t, v, int64(id)}, nil
+}
+
+// copySamples copies the samples of req right into their table, and returns
+// the number of samples copied.
+func copySamples(conn pgxConn, req copyRequest) (int64, error) {
+	return conn.CopyFrom(context.Background(), pgx.Identifier{dataSchema, req.table}, dataColumns, sampleRows{&req.data.batch})
+}
+
+// copySamplesThroughStaging copies the samples of req into the staging table
+// and moves them to their table according to the duplicate policy, and
+// returns the number of samples inserted, which excludes the duplicates.
+func copySamplesThroughStaging(conn pgxConn, req copyRequest) (int64, error) {
+	return conn.CopyFromStaging(context.Background(), sampleRows{&req.data.batch}, req.policy.insertFromStagingSQL(req.table))
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
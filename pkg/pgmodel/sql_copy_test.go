@@ -0,0 +1,121 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package pgmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/prometheus/common/model"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestParseInsertMode(t *testing.T) {
+	for _, m := range []InsertMode{InsertModeInsert, InsertModeCopy, InsertModeCopyDirect, InsertModeAuto} {
+		parsed, err := ParseInsertMode(m.String())
+		if err != nil || parsed != m {
+			t.Errorf("unexpected mode parsed from %s: %v, %v", m, parsed, err)
+		}
+	}
+	if _, err := ParseInsertMode("foo"); err == nil {
+		t.Errorf("expected an error for an invalid mode")
+	}
+}
+
+func TestInsertMethodModeFor(t *testing.T) {
+	testCases := []struct {
+		method  insertMethod
+		numRows int
+		mode    InsertMode
+	}{
+		{method: insertMethod{mode: InsertModeInsert}, numRows: 1 << 20, mode: InsertModeInsert},
+		{method: insertMethod{mode: InsertModeCopyDirect}, numRows: 1, mode: InsertModeCopyDirect},
+		{method: insertMethod{mode: InsertModeAuto}, numRows: DefaultCopyMinSamples - 1, mode: InsertModeInsert},
+		{method: insertMethod{mode: InsertModeAuto}, numRows: DefaultCopyMinSamples, mode: InsertModeCopy},
+		{method: insertMethod{mode: InsertModeAuto, copyMinSamples: 10}, numRows: 10, mode: InsertModeCopy},
+	}
+	for _, c := range testCases {
+		if mode := c.method.modeFor(c.numRows); mode != c.mode {
+			t.Errorf("unexpected mode for %d rows with %+v: got %s, wanted %s", c.numRows, c.method, mode, c.mode)
+		}
+	}
+}
+
+func TestDoInsertModes(t *testing.T) {
+	insertSQL := `INSERT INTO "prom_data"."foo"(time, value, series_id) SELECT * FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) a(t,v,s) ORDER BY s,t ON CONFLICT DO NOTHING`
+	copyStagingSQL := `COPY "promscale_staging"(time, value, series_id)`
+	insertFromStagingSQL := `INSERT INTO "prom_data"."foo"(time, value, series_id) SELECT time, value, series_id FROM promscale_staging ORDER BY series_id, time ON CONFLICT DO NOTHING`
+	copyDirectSQL := `COPY "prom_data"."foo"(time, value, series_id)`
+
+	t1, t2 := model.Time(1000).Time(), model.Time(2000).Time()
+	rows := []interface{}{
+		[]interface{}{t1, 0.1, int64(1)},
+		[]interface{}{t2, 0.2, int64(1)},
+		[]interface{}{t1, 0.3, int64(2)},
+	}
+
+	testCases := []struct {
+		name    string
+		method  insertMethod
+		queries []sqlQuery
+	}{
+		{
+			name:   "insert",
+			method: insertMethod{mode: InsertModeInsert},
+			queries: []sqlQuery{{
+				sql:  insertSQL,
+				args: []interface{}{[]time.Time{t1, t2, t1}, []float64{0.1, 0.2, 0.3}, []int64{1, 1, 2}},
+			}},
+		},
+		{
+			name:   "copy",
+			method: insertMethod{mode: InsertModeCopy},
+			queries: []sqlQuery{
+				{sql: copyStagingSQL, args: rows},
+				{sql: insertFromStagingSQL, args: nil, results: rowResults{{int64(3)}}},
+			},
+		},
+		{
+			name:   "auto copies large batches",
+			method: insertMethod{mode: InsertModeAuto, copyMinSamples: 3},
+			queries: []sqlQuery{
+				{sql: copyStagingSQL, args: rows},
+				{sql: insertFromStagingSQL, args: nil, results: rowResults{{int64(3)}}},
+			},
+		},
+		{
+			name:    "copy direct",
+			method:  insertMethod{mode: InsertModeCopyDirect},
+			queries: []sqlQuery{{sql: copyDirectSQL, args: rows}},
+		},
+		{
+			name:   "copy direct with duplicates",
+			method: insertMethod{mode: InsertModeCopyDirect},
+			queries: []sqlQuery{
+				{sql: copyDirectSQL, args: rows, err: &pgconn.PgError{Code: pgerrcode.UniqueViolation}},
+				{sql: copyStagingSQL, args: rows},
+				{sql: insertFromStagingSQL, args: nil, results: rowResults{{int64(2)}}},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := &sqlRecorder{t: t, queries: c.queries}
+			pending := &pendingBuffer{batch: NewSampleInfoIterator()}
+			pending.batch.Append(samplesInfo{seriesID: 1, samples: []prompb.Sample{{Timestamp: 1000, Value: 0.1}, {Timestamp: 2000, Value: 0.2}}})
+			pending.batch.Append(samplesInfo{seriesID: 2, samples: []prompb.Sample{{Timestamp: 1000, Value: 0.3}}})
+
+			if err := doInsert(mock, copyRequest{data: pending, table: "foo"}, c.method); err != nil {
+				t.Fatal(err)
+			}
+			if mock.nextQuery != len(c.queries) {
+				t.Errorf("only %d of the %d queries were run", mock.nextQuery, len(c.queries))
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ package pgmodel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -21,12 +22,29 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/timescale/promscale/pkg/clockcache"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/wal"
 )
 
 const (
+	// size of the request queue of every metric's inserter
+	inserterQueueSize = 1000
+
 	getCreateMetricsTableSQL = "SELECT table_name FROM " + catalogSchema + ".get_or_create_metric_table_name($1)"
 	finalizeMetricCreation   = "CALL " + catalogSchema + ".finalize_metric_creation()"
 	getSeriesIDForLabelSQL   = "SELECT * FROM " + catalogSchema + ".get_or_create_series_id_for_kv_array($1, $2, $3)"
+	// getExistingSeriesIDForLabelSQL returns a series id of 0 for series
+	// that don't exist yet, without creating them.
+	getExistingSeriesIDForLabelSQL = "SELECT table_name, COALESCE(series_id, 0) FROM " + catalogSchema + ".get_series_id_for_kv_array($1, $2, $3)"
+	countSeriesSQLFormat           = "SELECT count(*) FROM %s"
+
+	// number of times the insert of asynchronously acked data is retried,
+	// and the delay before the first retry, doubled on every retry
+	asyncInsertRetries      = 5
+	asyncInsertRetryBackoff = time.Second
+
+	// how long the number of series of a metric with a series limit is
+	// trusted before counting them again
+	seriesCountRefreshInterval = time.Minute
 )
 
 type Cfg struct {
@@ -34,31 +52,56 @@ type Cfg struct {
 	ReportInterval  int
 	SeriesCacheSize uint64
 	NumCopiers      int
+	// WALDir is the directory of the WAL recording data acked before it is
+	// inserted. Only used with AsyncAcks, no WAL is kept if empty.
+	WALDir string
+	// MaxInFlightSamples is the number of samples waiting to be inserted
+	// after which new data is rejected with ErrIngestSaturated, 0 means no
+	// limit.
+	MaxInFlightSamples int64
+	// MaxInsertQueueDepth is the number of requests queued for a metric's
+	// inserter after which new data for that metric is rejected with
+	// ErrIngestSaturated, 0 means no limit.
+	MaxInsertQueueDepth int
+	// Limits bounds the data accepted for ingestion.
+	Limits Limits
+	// Relabeler applies relabel rules to the ingested series, none are
+	// applied if nil.
+	Relabeler *Relabeler
+	// InsertMode is the way samples are written, and CopyMinSamples the
+	// number of samples from which InsertModeAuto copies a batch,
+	// DefaultCopyMinSamples if 0.
+	InsertMode     InsertMode
+	CopyMinSamples int
+	// CacheInvalidator drops the cached query results the samples
+	// overwritten under DuplicatePolicyOverwrite contribute to, if not nil.
+	CacheInvalidator CacheInvalidator
 }
 
-// NewPgxIngestorWithMetricCache returns a new Ingestor that uses connection pool and a metrics cache
-// for caching metric table names.
-func NewPgxIngestorWithMetricCache(c *pgxpool.Pool, cache MetricCache, cfg *Cfg) (*DBIngestor, error) {
+// NewPgxIngestorWithMetricCache returns a new Ingestor that uses connection pool, a metrics cache
+// for caching metric table names and a series cache for caching series ids. If
+// no series cache is passed, one of cfg.SeriesCacheSize elements is created.
+func NewPgxIngestorWithMetricCache(c *pgxpool.Pool, cache MetricCache, sCache SeriesCache, cfg *Cfg) (*DBIngestor, error) {
 
 	conn := &pgxConnImpl{
 		conn: c,
 	}
 
-	pi, err := newPgxInserter(conn, cache, cfg)
+	pi, err := newPgxInserter(conn, cache, sCache, cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return &DBIngestor{db: pi}, nil
+	return &DBIngestor{db: pi, limiter: newIngestLimiter(cfg.Limits), relabeler: cfg.Relabeler}, nil
 }
 
 // NewPgxIngestor returns a new Ingestor that write to PostgreSQL using PGX
 func NewPgxIngestor(c *pgxpool.Pool) (*DBIngestor, error) {
 	cache := &MetricNameCache{clockcache.WithMax(DefaultMetricCacheSize)}
-	return NewPgxIngestorWithMetricCache(c, cache, &Cfg{})
+	return NewPgxIngestorWithMetricCache(c, cache, nil, &Cfg{})
 }
 
-func newPgxInserter(conn pgxConn, cache MetricCache, cfg *Cfg) (*pgxInserter, error) {
+func newPgxInserter(conn pgxConn, cache MetricCache, sCache SeriesCache, cfg *Cfg) (*pgxInserter, error) {
 	cmc := make(chan struct{}, 1)
 
 	numCopiers := cfg.NumCopiers
@@ -72,16 +115,32 @@ func newPgxInserter(conn pgxConn, cache MetricCache, cfg *Cfg) (*pgxInserter, er
 	// and balancing: if an inserter is awake and has little work, it'll be more
 	// likely to win the race, while one that's busy or asleep won't.
 	toCopiers := make(chan copyRequest, numCopiers)
+	method := insertMethod{mode: cfg.InsertMode, copyMinSamples: cfg.CopyMinSamples}
 	for i := 0; i < numCopiers; i++ {
-		go runInserter(conn, toCopiers)
+		go runInserter(conn, toCopiers, method)
+	}
+
+	if sCache == nil {
+		size := cfg.SeriesCacheSize
+		if size == 0 {
+			size = DefaultSeriesCacheSize
+		}
+		sCache = NewSeriesCache(size)
 	}
 
 	inserter := &pgxInserter{
+		maxInFlightSamples:     cfg.MaxInFlightSamples,
+		maxInsertQueueDepth:    cfg.MaxInsertQueueDepth,
+		maxSeriesPerMetric:     cfg.Limits.MaxSeriesPerMetric,
 		conn:                   conn,
 		metricTableNames:       cache,
+		seriesCache:            sCache,
 		completeMetricCreation: cmc,
 		asyncAcks:              cfg.AsyncAcks,
 		toCopiers:              toCopiers,
+		duplicatePolicies:      newDuplicatePolicies(conn),
+		cacheInvalidator:       cfg.CacheInvalidator,
+		closing:                make(chan struct{}),
 	}
 	if cfg.AsyncAcks && cfg.ReportInterval > 0 {
 		inserter.insertedDatapoints = new(int64)
@@ -104,17 +163,47 @@ func newPgxInserter(conn pgxConn, cache MetricCache, cfg *Cfg) (*pgxInserter, er
 
 	go inserter.runCompleteMetricCreationWorker()
 
+	if cfg.AsyncAcks && cfg.WALDir != "" {
+		if inserter.wal, err = wal.Open(cfg.WALDir, wal.DefaultSegmentSize); err != nil {
+			inserter.Close()
+			return nil, err
+		}
+		// replay before accepting new data so that the leftovers of the
+		// previous run get inserted first
+		if err = inserter.replayWAL(); err != nil {
+			inserter.Close()
+			return nil, err
+		}
+	}
+
 	return inserter, nil
 }
 
 type pgxInserter struct {
+	// number of samples sent to the inserters and not yet inserted. Must be
+	// the first word in the struct to ensure proper alignment in 32-bit
+	// systems.
+	// Reference: https://golang.org/pkg/sync/atomic/#pkg-note-BUG
+	inFlightSamples        int64
+	maxInFlightSamples     int64
+	maxInsertQueueDepth    int
+	maxSeriesPerMetric     int64
 	conn                   pgxConn
 	metricTableNames       MetricCache
+	seriesCache            SeriesCache
 	inserters              sync.Map
 	completeMetricCreation chan struct{}
 	asyncAcks              bool
 	insertedDatapoints     *int64
 	toCopiers              chan copyRequest
+	duplicatePolicies      *duplicatePolicies
+	cacheInvalidator       CacheInvalidator
+	wal                    *wal.WAL
+	// closing is closed when the inserter is closed, stopping the retries
+	closing chan struct{}
+	// asyncInserts tracks the running completeAsyncInsert calls, which
+	// Close waits for before closing the inserters and the WAL they use
+	asyncInserts sync.WaitGroup
 }
 
 func (p *pgxInserter) CompleteMetricCreation() error {
@@ -135,23 +224,45 @@ func (p *pgxInserter) runCompleteMetricCreationWorker() {
 }
 
 func (p *pgxInserter) Close() {
+	close(p.closing)
+	p.asyncInserts.Wait()
 	close(p.completeMetricCreation)
 	p.inserters.Range(func(key, value interface{}) bool {
 		close(value.(chan insertDataRequest))
 		return true
 	})
 	close(p.toCopiers)
+	if p.wal != nil {
+		if err := p.wal.Close(); err != nil {
+			log.Warn("msg", "error closing the WAL", "err", err)
+		}
+	}
 }
 
 func (p *pgxInserter) InsertNewData(rows map[string][]samplesInfo) (uint64, error) {
 	return p.InsertData(rows)
 }
 
+// InsertResentData inserts data sent again after an attempt that may have
+// inserted some of it, waiting until all the insert attempts have completed
+// whether asyncAcks is set or not. The samples already stored are ignored
+// under DuplicatePolicyReject.
+func (p *pgxInserter) InsertResentData(rows map[string][]samplesInfo) (uint64, error) {
+	numRows, err := p.admit(rows)
+	if err != nil {
+		return 0, err
+	}
+	workFinished, errChan := p.sendInsertRequests(rows, true)
+	return numRows, p.waitForInsert(numRows, workFinished, errChan)
+}
+
 type insertDataRequest struct {
 	metric   string
 	data     []samplesInfo
 	finished *sync.WaitGroup
 	errChan  chan error
+	// resent is set for data sent again, see InsertResentData
+	resent bool
 }
 
 func (idr *insertDataRequest) reportResult(err error) {
@@ -167,6 +278,17 @@ func (idr *insertDataRequest) reportResult(err error) {
 type insertDataTask struct {
 	finished *sync.WaitGroup
 	errChan  chan error
+	// number of samplesInfos the request added to the pending batch
+	numSeries int
+}
+
+// Report an error for this task without completing it. Like reportResult it
+// must never block.
+func (idt *insertDataTask) reportError(err error) {
+	select {
+	case idt.errChan <- err:
+	default:
+	}
 }
 
 // Report that this task is completed, along with any error that may have
@@ -188,53 +310,178 @@ func (idt *insertDataTask) reportResult(err error) {
 // actually inserted) and any error.
 // Though we may insert data to multiple tables concurrently, if asyncAcks is
 // unset this function will wait until _all_ the insert attempts have completed.
+// If asyncAcks is set and we have a WAL the data is written to it before we
+// return, and only dropped from it once it is inserted.
+// If the ingest pipeline is saturated the data is rejected with
+// ErrIngestSaturated without attempting to insert it.
 func (p *pgxInserter) InsertData(rows map[string][]samplesInfo) (uint64, error) {
+	numRows, err := p.admit(rows)
+	if err != nil {
+		return 0, err
+	}
+
+	if !p.asyncAcks {
+		workFinished, errChan := p.sendInsertRequests(rows, false)
+		return numRows, p.waitForInsert(numRows, workFinished, errChan)
+	}
+
+	walSegment, err := p.appendToWAL(rows)
+	if err != nil {
+		p.release(numRows)
+		return 0, err
+	}
+
+	workFinished, errChan := p.sendInsertRequests(rows, false)
+	p.asyncInserts.Add(1)
+	go func() {
+		defer p.asyncInserts.Done()
+		p.completeAsyncInsert(rows, numRows, walSegment, workFinished, errChan)
+	}()
+
+	return numRows, nil
+}
+
+// completeAsyncInsert waits for data acked before it was inserted, retrying
+// the insert with an exponential backoff if it fails. The data of a failed
+// attempt may have been partially inserted, so the retry inserts it as resent
+// data, as a replay of the WAL does.
+// The WAL segment is acknowledged once the data is inserted or rejected by the
+// limits, so that data failing every attempt is replayed on the next start.
+func (p *pgxInserter) completeAsyncInsert(rows map[string][]samplesInfo, numRows uint64, walSegment int, workFinished *sync.WaitGroup, errChan chan error) {
+	err := p.waitForInsert(numRows, workFinished, errChan)
+	backoff := asyncInsertRetryBackoff
+	var limitErr *LimitError
+retries:
+	for retry := 1; err != nil && !errors.As(err, &limitErr) && retry <= asyncInsertRetries; retry++ {
+		log.Warn("msg", fmt.Sprintf("error on async send, retrying %d datapoints in %v", numRows, backoff), "err", err)
+		select {
+		case <-time.After(backoff):
+		case <-p.closing:
+			break retries
+		}
+		// the timer may fire as the inserter is closing
+		select {
+		case <-p.closing:
+			break retries
+		default:
+		}
+		backoff *= 2
+		_, err = p.InsertResentData(rows)
+	}
+
+	if errors.As(err, &limitErr) {
+		// the rest of the data was inserted, and the rejected samples
+		// would be rejected again on replay
+		log.Warn("msg", "some datapoints were rejected on async send", "err", err)
+		if walSegment >= 0 {
+			p.wal.Ack(walSegment)
+		}
+		if p.insertedDatapoints != nil {
+			atomic.AddInt64(p.insertedDatapoints, int64(numRows)-int64(limitErr.Samples))
+		}
+		return
+	}
+	if err != nil {
+		asyncInsertFailedSamples.Add(float64(numRows))
+		if walSegment >= 0 {
+			log.Error("msg", fmt.Sprintf("error on async send, keeping %d datapoints in the WAL", numRows), "err", err)
+		} else {
+			log.Error("msg", fmt.Sprintf("error on async send, dropping %d datapoints", numRows), "err", err)
+		}
+		return
+	}
+	if walSegment >= 0 {
+		p.wal.Ack(walSegment)
+	}
+	if p.insertedDatapoints != nil {
+		atomic.AddInt64(p.insertedDatapoints, int64(numRows))
+	}
+}
+
+// admit checks that the ingest pipeline has room for the data, accounting
+// for its samples as in-flight if so. The samples must be released once they
+// are no longer in-flight. Returns the number of samples in the data.
+func (p *pgxInserter) admit(rows map[string][]samplesInfo) (uint64, error) {
 	var numRows uint64
-	workFinished := &sync.WaitGroup{}
-	workFinished.Add(len(rows))
-	// we only allocate enough space for a single error message here as we only
-	// report one error back upstream. The inserter should not block on this
-	// channel, but only insert if it's empty, anything else can deadlock.
-	errChan := make(chan error, 1)
 	for metricName, data := range rows {
 		for _, si := range data {
 			numRows += uint64(len(si.samples))
 		}
+		if p.maxInsertQueueDepth > 0 {
+			inserter, ok := p.inserters.Load(metricName)
+			if ok && len(inserter.(chan insertDataRequest)) >= p.maxInsertQueueDepth {
+				ingestRejectedRequests.Inc()
+				return 0, ErrIngestSaturated
+			}
+		}
+	}
+
+	inFlight := atomic.AddInt64(&p.inFlightSamples, int64(numRows))
+	// a request larger than the limit is let through if nothing else is
+	// in-flight, as it could never be inserted otherwise
+	if p.maxInFlightSamples > 0 && inFlight > p.maxInFlightSamples && inFlight != int64(numRows) {
+		atomic.AddInt64(&p.inFlightSamples, -int64(numRows))
+		ingestRejectedRequests.Inc()
+		return 0, ErrIngestSaturated
+	}
+	inFlightSamples.Add(float64(numRows))
+	return numRows, nil
+}
+
+// release removes the samples accounted by admit from the in-flight ones.
+func (p *pgxInserter) release(numRows uint64) {
+	atomic.AddInt64(&p.inFlightSamples, -int64(numRows))
+	inFlightSamples.Sub(float64(numRows))
+}
+
+// sendInsertRequests hands the data off to the per-metric inserters, returning
+// the WaitGroup and error channel the inserters report their results on.
+func (p *pgxInserter) sendInsertRequests(rows map[string][]samplesInfo, resent bool) (*sync.WaitGroup, chan error) {
+	workFinished := &sync.WaitGroup{}
+	workFinished.Add(len(rows))
+	// every metric reports at most one error for the series it rejected and
+	// one for its insert, so that none of them is lost. The inserter should
+	// not block on this channel, but only insert if it's not full, anything
+	// else can deadlock.
+	errChan := make(chan error, 2*len(rows))
+	for metricName, data := range rows {
 		// insertMetricData() is expected to be non-blocking,
 		// just a channel insert
-		p.insertMetricData(metricName, data, workFinished, errChan)
+		p.insertMetricData(metricName, data, resent, workFinished, errChan)
 	}
+	return workFinished, errChan
+}
+
+// waitForInsert waits for the inserters to finish with the data sent by
+// sendInsertRequests, releasing its samples, and returns the first error
+// they reported. Samples rejected for exceeding the limits are only reported
+// if no other error occurred.
+func (p *pgxInserter) waitForInsert(numRows uint64, workFinished *sync.WaitGroup, errChan chan error) error {
+	workFinished.Wait()
+	p.release(numRows)
+	close(errChan)
 
 	var err error
-	if !p.asyncAcks {
-		workFinished.Wait()
-		select {
-		case err = <-errChan:
-		default:
+	var limitErr *LimitError
+	for e := range errChan {
+		var le *LimitError
+		if errors.As(e, &le) {
+			limitErr = mergeLimitErrors(limitErr, le)
+			continue
+		}
+		if err == nil {
+			err = e
 		}
-		close(errChan)
-	} else {
-		go func() {
-			workFinished.Wait()
-			select {
-			case err = <-errChan:
-			default:
-			}
-			close(errChan)
-			if err != nil {
-				log.Error("msg", fmt.Sprintf("error on async send, dropping %d datapoints", numRows), "err", err)
-			} else if p.insertedDatapoints != nil {
-				atomic.AddInt64(p.insertedDatapoints, int64(numRows))
-			}
-		}()
 	}
-
-	return numRows, err
+	if err == nil && limitErr != nil {
+		return limitErr
+	}
+	return err
 }
 
-func (p *pgxInserter) insertMetricData(metric string, data []samplesInfo, finished *sync.WaitGroup, errChan chan error) {
+func (p *pgxInserter) insertMetricData(metric string, data []samplesInfo, resent bool, finished *sync.WaitGroup, errChan chan error) {
 	inserter := p.getMetricInserter(metric)
-	inserter <- insertDataRequest{metric: metric, data: data, finished: finished, errChan: errChan}
+	inserter <- insertDataRequest{metric: metric, data: data, finished: finished, errChan: errChan, resent: resent}
 }
 
 //nolint
@@ -301,11 +548,11 @@ func (p *pgxInserter) getMetricInserter(metric string) chan insertDataRequest {
 		// only start up the inserter routine if we know that we won the race
 		// to create the inserter, anything else will leave a zombie inserter
 		// lying around.
-		c := make(chan insertDataRequest, 1000)
+		c := make(chan insertDataRequest, inserterQueueSize)
 		actual, old := p.inserters.LoadOrStore(metric, c)
 		inserter = actual
 		if !old {
-			go runInserterRoutine(p.conn, c, metric, p.completeMetricCreation, p.metricTableNames, p.toCopiers)
+			go runInserterRoutine(p.conn, c, metric, p.completeMetricCreation, p.metricTableNames, p.seriesCache, p.toCopiers, p.maxSeriesPerMetric, p.duplicatePolicies, p.cacheInvalidator)
 		}
 	}
 	return inserter.(chan insertDataRequest)
@@ -315,14 +562,26 @@ type insertHandler struct {
 	conn            pgxConn
 	input           chan insertDataRequest
 	pending         *pendingBuffer
-	seriesCache     map[string]SeriesID
+	seriesCache     SeriesCache
+	metricName      string
 	metricTableName string
 	toCopiers       chan copyRequest
+	// maximum number of series of the metric, 0 means no limit
+	maxSeries int64
+	// number of series of the metric as of the last count, plus the ones
+	// created since
+	numSeries          int64
+	numSeriesCountedAt time.Time
+	duplicatePolicies  *duplicatePolicies
+	cacheInvalidator   CacheInvalidator
 }
 
 type pendingBuffer struct {
 	needsResponse []insertDataTask
 	batch         SampleInfoIterator
+	// resent is set if the batch holds resent data, which is never batched
+	// with new data
+	resent bool
 }
 
 const (
@@ -343,11 +602,15 @@ var pendingBuffers = sync.Pool{
 }
 
 type copyRequest struct {
-	data  *pendingBuffer
-	table string
+	data   *pendingBuffer
+	table  string
+	metric string
+	policy DuplicatePolicy
+	// invalidator is told about the samples overwritten by the request
+	invalidator CacheInvalidator
 }
 
-func runInserterRoutine(conn pgxConn, input chan insertDataRequest, metricName string, completeMetricCreationSignal chan struct{}, metricTableNames MetricCache, toCopiers chan copyRequest) {
+func runInserterRoutine(conn pgxConn, input chan insertDataRequest, metricName string, completeMetricCreationSignal chan struct{}, metricTableNames MetricCache, seriesCache SeriesCache, toCopiers chan copyRequest, maxSeries int64, duplicatePolicies *duplicatePolicies, cacheInvalidator CacheInvalidator) {
 	var tableName string
 	var firstReq insertDataRequest
 	firstReqSet := false
@@ -368,12 +631,16 @@ func runInserterRoutine(conn pgxConn, input chan insertDataRequest, metricName s
 	}
 
 	handler := insertHandler{
-		conn:            conn,
-		input:           input,
-		pending:         pendingBuffers.Get().(*pendingBuffer),
-		seriesCache:     make(map[string]SeriesID),
-		metricTableName: tableName,
-		toCopiers:       toCopiers,
+		conn:              conn,
+		input:             input,
+		pending:           pendingBuffers.Get().(*pendingBuffer),
+		seriesCache:       seriesCache,
+		metricName:        metricName,
+		metricTableName:   tableName,
+		toCopiers:         toCopiers,
+		maxSeries:         maxSeries,
+		duplicatePolicies: duplicatePolicies,
+		cacheInvalidator:  cacheInvalidator,
 	}
 
 	handler.handleReq(firstReq)
@@ -464,6 +731,9 @@ func (h *insertHandler) handleReq(req insertDataRequest) bool {
 	// that are no longer needed, and because the SeriesIds might get flushed.
 	// (neither of these are that critical at the moment)
 	h.fillKnowSeriesIds(req.data)
+	if h.hasPendingReqs() && req.resent != h.pending.resent {
+		h.flushPending()
+	}
 	needsFlush := h.pending.addReq(req)
 	if needsFlush {
 		h.flushPending()
@@ -483,8 +753,8 @@ func (h *insertHandler) fillKnowSeriesIds(sampleInfos []samplesInfo) (numMissing
 		if series.seriesID > -1 {
 			continue
 		}
-		id, ok := h.seriesCache[series.labels.String()]
-		if ok {
+		id, err := h.seriesCache.GetSeries(*series.labels)
+		if err == nil {
 			sampleInfos[i].seriesID = id
 			series.labels = nil
 		} else {
@@ -508,25 +778,38 @@ func (h *insertHandler) flushPending() {
 		h.pending.reportResults(err)
 		return
 	}
+	if h.maxSeries > 0 {
+		h.pending.dropRejectedSeries(fmt.Sprintf("metric %s reached the limit of %d series", h.metricName, h.maxSeries))
+	}
 
-	h.toCopiers <- copyRequest{h.pending, h.metricTableName}
+	policy, err := h.duplicatePolicies.get(h.metricName)
+	if err != nil {
+		h.pending.reportResults(err)
+		return
+	}
+	if h.pending.resent && policy == DuplicatePolicyReject {
+		// the samples stored by the previous attempt are not duplicates
+		// of other writes
+		policy = DuplicatePolicyIgnore
+	}
+
+	h.toCopiers <- copyRequest{data: h.pending, table: h.metricTableName, metric: h.metricName, policy: policy, invalidator: h.cacheInvalidator}
 	h.pending = pendingBuffers.Get().(*pendingBuffer)
 }
 
 // Handles actual insertion into the DB.
 // We have one of these per connection reserved for insertion.
-func runInserter(conn pgxConn, in chan copyRequest) {
-	// We grab copyRequests off the channel one at a time. This, and the name is
-	// a legacy from when we used CopyFrom to perform the insetions, and may
-	// change in the future.
+func runInserter(conn pgxConn, in chan copyRequest, method insertMethod) {
+	// We grab copyRequests off the channel one at a time. Depending on the
+	// insert method, their samples are inserted or copied.
 	for {
 		req, ok := <-in
 		if !ok {
 			return
 		}
-		err := doInsert(conn, req)
+		err := doInsert(conn, req, method)
 		if err != nil {
-			err = insertErrorFallback(conn, req, err)
+			err = insertErrorFallback(conn, req, err, method)
 		}
 
 		req.data.reportResults(err)
@@ -535,15 +818,27 @@ func runInserter(conn pgxConn, in chan copyRequest) {
 }
 
 // certain errors are recoverable, handle those we can
-//   1. if the table is compressed, decompress and retry the insertion
-func insertErrorFallback(conn pgxConn, req copyRequest, err error) error {
+//  1. if the table is compressed, decompress and retry the insertion
+//  2. if the metric rejects duplicates, insert each write on its own and
+//     reject the ones holding duplicates
+func insertErrorFallback(conn pgxConn, req copyRequest, err error, method insertMethod) error {
+	if req.policy == DuplicatePolicyReject && isUniqueViolation(err) {
+		insertRejectingDuplicates(conn, req, method)
+		return nil
+	}
+
 	err = tryRecovery(conn, req, err)
 	if err != nil {
 		log.Warn("msg", fmt.Sprintf("time out while processing error for %s", req.table), "err", err.Error())
 		return err
 	}
 
-	return doInsert(conn, req)
+	err = doInsert(conn, req, method)
+	if req.policy == DuplicatePolicyReject && isUniqueViolation(err) {
+		insertRejectingDuplicates(conn, req, method)
+		return nil
+	}
+	return err
 }
 
 // we can currently recover from one error:
@@ -575,11 +870,49 @@ func tryRecovery(conn pgxConn, req copyRequest, err error) error {
 }
 
 // Perform the actual insertion into the DB.
-func doInsert(conn pgxConn, req copyRequest) (err error) {
+func doInsert(conn pgxConn, req copyRequest, method insertMethod) (err error) {
 	numRows := 0
 	for i := range req.data.batch.sampleInfos {
 		numRows += len(req.data.batch.sampleInfos[i].samples)
 	}
+	if numRows == 0 {
+		return insertExemplars(conn, req)
+	}
+
+	var inserted int64
+	switch method.modeFor(numRows) {
+	case InsertModeCopy:
+		inserted, err = copySamplesThroughStaging(conn, req)
+	case InsertModeCopyDirect:
+		inserted, err = copySamples(conn, req)
+		if isUniqueViolation(err) && req.policy != DuplicatePolicyReject {
+			// the batch holds duplicates, which the staging table handles
+			req.data.batch.ResetPosition()
+			inserted, err = copySamplesThroughStaging(conn, req)
+		}
+	default:
+		inserted, err = insertSamples(conn, req, numRows)
+	}
+	if err != nil {
+		return
+	}
+
+	if int64(numRows) != inserted {
+		log.Warn("msg", "duplicate data in sample", "table", req.table, "duplicate_count", int64(numRows)-inserted, "row_count", numRows, "duplicate_policy", req.policy)
+		duplicateSamples.Add(float64(int64(numRows) - inserted))
+		duplicateWrites.Inc()
+		metricDuplicateSamples.WithLabelValues(req.metric, req.policy.String()).Add(float64(int64(numRows) - inserted))
+		if req.policy == DuplicatePolicyOverwrite {
+			invalidateModified(conn, req.invalidator, req.data.tenants(), req.data.batch.minSeen)
+		}
+	}
+	return insertExemplars(conn, req)
+}
+
+// insertSamples inserts the numRows samples of req according to the duplicate
+// policy, and returns the number of samples inserted, which excludes the
+// duplicates.
+func insertSamples(conn pgxConn, req copyRequest, numRows int) (int64, error) {
 	// flatten the various series into arrays.
 	// there are four main bottlenecks for insertion:
 	//   1. The round trip time.
@@ -590,8 +923,8 @@ func doInsert(conn pgxConn, req copyRequest) (err error) {
 	// two we need to actually reduce the work done. It turns out that simply
 	// collecting all the data into a postgres array and performing a single
 	// INSERT using that overcomes most of the performance issues for sending
-	// multiple data, and brings INSERT nearly on par with CopyFrom. In the
-	// future we may wish to send compressed data instead.
+	// multiple data, and brings INSERT nearly on par with CopyFrom for small
+	// batches. Larger batches are better off copied, see InsertModeCopy.
 	times := make([]time.Time, 0, numRows)
 	vals := make([]float64, 0, numRows)
 	series := make([]int64, 0, numRows)
@@ -604,19 +937,11 @@ func doInsert(conn pgxConn, req copyRequest) (err error) {
 	if len(times) != numRows {
 		panic("invalid insert request")
 	}
-	queryString := fmt.Sprintf("INSERT INTO %s(time, value, series_id) SELECT * FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) a(t,v,s) ORDER BY s,t ON CONFLICT DO NOTHING", pgx.Identifier{dataSchema, req.table}.Sanitize())
-	var ct pgconn.CommandTag
-	ct, err = conn.Exec(context.Background(), queryString, times, vals, series)
+	ct, err := conn.Exec(context.Background(), req.policy.insertSamplesSQL(req.table), times, vals, series)
 	if err != nil {
-		return
+		return 0, err
 	}
-
-	if int64(numRows) != ct.RowsAffected() {
-		log.Warn("msg", "duplicate data in sample", "table", req.table, "duplicate_count", int64(numRows)-ct.RowsAffected(), "row_count", numRows)
-		duplicateSamples.Add(float64(int64(numRows) - ct.RowsAffected()))
-		duplicateWrites.Inc()
-	}
-	return nil
+	return ct.RowsAffected(), nil
 }
 
 // In the event we filling in old data and the chunk we want to INSERT into has
@@ -669,10 +994,92 @@ func (pending *pendingBuffer) reportResults(err error) {
 	}
 	pending.batch = SampleInfoIterator{sampleInfos: pending.batch.sampleInfos[:0]}
 	pending.batch.ResetPosition()
+	pending.resent = false
+}
+
+// tenants returns the tenants of the series of the pending buffer.
+func (pending *pendingBuffer) tenants() map[string]struct{} {
+	tenants := make(map[string]struct{})
+	for i := range pending.batch.sampleInfos {
+		var tenant string
+		if ls := pending.batch.sampleInfos[i].labels; ls != nil {
+			for j, name := range ls.names {
+				if name == TenantLabelName {
+					tenant = ls.values[j]
+					break
+				}
+			}
+		}
+		tenants[tenant] = struct{}{}
+	}
+	return tenants
+}
+
+// dropRejectedSeries removes the samples and exemplars of the series
+// setSeriesIds rejected for exceeding the series limit, so that they are
+// skipped on insert, and reports them to the requests they came from.
+func (pending *pendingBuffer) dropRejectedSeries(msg string) {
+	start := 0
+	for i := range pending.needsResponse {
+		task := &pending.needsResponse[i]
+		infos := pending.batch.sampleInfos[start : start+task.numSeries]
+		start += task.numSeries
+
+		var limitErr *LimitError
+		for j := range infos {
+			if infos[j].seriesID != 0 {
+				continue
+			}
+			if limitErr == nil {
+				limitErr = &LimitError{}
+			}
+			limitErr.add(reasonSeriesLimit, len(infos[j].samples), msg)
+			infos[j].samples = nil
+			infos[j].exemplars = nil
+		}
+		if limitErr != nil {
+			task.reportError(limitErr)
+		}
+	}
+}
+
+// seriesCount returns the number of series the metric has, counting them
+// in the database only if the last count is older than
+// seriesCountRefreshInterval.
+func (h *insertHandler) seriesCount() (int64, error) {
+	if !h.numSeriesCountedAt.IsZero() && time.Since(h.numSeriesCountedAt) < seriesCountRefreshInterval {
+		return h.numSeries, nil
+	}
+	numSeries, err := h.countSeries()
+	if err != nil {
+		return 0, err
+	}
+	h.numSeries = numSeries
+	h.numSeriesCountedAt = time.Now()
+	return numSeries, nil
+}
+
+// countSeries returns the number of series the metric has in the database.
+func (h *insertHandler) countSeries() (int64, error) {
+	rows, err := h.conn.Query(context.Background(), fmt.Sprintf(countSeriesSQLFormat, pgx.Identifier{dataSeriesSchema, h.metricTableName}.Sanitize()))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var count int64
+	if rows.Next() {
+		if err = rows.Scan(&count); err != nil {
+			return 0, err
+		}
+	}
+	return count, rows.Err()
 }
 
 // Set all seriesIds for a samplesInfo, fetching any missing ones from the DB,
 // and repopulating the cache accordingly.
+// If the metric has a series limit, new series over the limit are not
+// created and their seriesId is set to 0.
 // returns: the tableName for the metric being inserted into
 // TODO move up to the rest of insertHandler
 func (h *insertHandler) setSeriesIds(sampleInfos []samplesInfo) (string, error) {
@@ -690,6 +1097,19 @@ func (h *insertHandler) setSeriesIds(sampleInfos []samplesInfo) (string, error)
 	}
 	var lastSeenLabel *Labels
 
+	// number of series that can still be created, -1 if unlimited
+	available := int64(-1)
+	if h.maxSeries > 0 {
+		numSeries, err := h.seriesCount()
+		if err != nil {
+			return "", err
+		}
+		available = h.maxSeries - numSeries
+		if available < 0 {
+			available = 0
+		}
+	}
+
 	batch := h.conn.NewBatch()
 	numSQLFunctionCalls := 0
 	// Sort and remove duplicates. The sort is needed to remove duplicates. Each series is inserted
@@ -706,8 +1126,20 @@ func (h *insertHandler) setSeriesIds(sampleInfos []samplesInfo) (string, error)
 			continue
 		}
 
+		seriesIDSQL := getSeriesIDForLabelSQL
+		switch {
+		case available == 0:
+			// we reached the limit, only series that already exist
+			// are let through
+			seriesIDSQL = getExistingSeriesIDForLabelSQL
+		case available > 0:
+			// existing series use up the budget too, which errs on the
+			// side of creating fewer series until the next count
+			available--
+			h.numSeries++
+		}
 		batch.Queue("BEGIN;")
-		batch.Queue(getSeriesIDForLabelSQL, curr.labels.metricName, curr.labels.names, curr.labels.values)
+		batch.Queue(seriesIDSQL, curr.labels.metricName, curr.labels.names, curr.labels.values)
 		batch.Queue("COMMIT;")
 		numSQLFunctionCalls++
 		batchSeries = append(batchSeries, []*samplesInfo{curr})
@@ -738,7 +1170,14 @@ func (h *insertHandler) setSeriesIds(sampleInfos []samplesInfo) (string, error)
 		if err != nil {
 			return "", err
 		}
-		h.seriesCache[batchSeries[i][0].labels.String()] = id
+		// a series rejected by the series limit is not cached, so that it
+		// gets created once there is room
+		if id != 0 {
+			err = h.seriesCache.SetSeries(*batchSeries[i][0].labels, id)
+			if err != nil {
+				return "", err
+			}
+		}
 		for _, lsi := range batchSeries[i] {
 			lsi.seriesID = id
 		}
@@ -752,7 +1191,8 @@ func (h *insertHandler) setSeriesIds(sampleInfos []samplesInfo) (string, error)
 }
 
 func (p *pendingBuffer) addReq(req insertDataRequest) bool {
-	p.needsResponse = append(p.needsResponse, insertDataTask{finished: req.finished, errChan: req.errChan})
+	p.needsResponse = append(p.needsResponse, insertDataTask{finished: req.finished, errChan: req.errChan, numSeries: len(req.data)})
 	p.batch.sampleInfos = append(p.batch.sampleInfos, req.data...)
+	p.resent = req.resent
 	return len(p.batch.sampleInfos) > flushSize
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
@@ -24,20 +25,57 @@ import (
 
 const (
 	getMetricsTableSQL = "SELECT table_name FROM " + catalogSchema + ".get_metric_table_name_if_exists($1)"
-	getLabelNamesSQL   = "SELECT distinct key from " + catalogSchema + ".label"
-	getLabelValuesSQL  = "SELECT value from " + catalogSchema + ".label WHERE key = $1"
+	// getMetricsTablesSQL selects the metric names and table names of the
+	// metrics named in $1.
+	getMetricsTablesSQL = "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), " + catalogSchema + ".get_metric_table_name_if_exists(m.name) AS t"
+	getLabelNamesSQL    = "SELECT distinct key from " + catalogSchema + ".label"
+	getLabelValuesSQL   = "SELECT value from " + catalogSchema + ".label WHERE key = $1"
+
+	// tenantLabelIDsSQL selects the ids of all labels used by the series of
+	// the tenant given in $1.
+	tenantLabelIDsSQL = "SELECT DISTINCT unnest(s.labels) FROM " + catalogSchema + ".series s " +
+		"WHERE s.labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM " + catalogSchema + ".label l WHERE l.key = '" + TenantLabelName + "' AND l.value = $1)"
+	getTenantLabelNamesSQL  = "SELECT distinct key from " + catalogSchema + ".label WHERE key != '" + TenantLabelName + "' AND id IN (" + tenantLabelIDsSQL + ")"
+	getTenantLabelValuesSQL = "SELECT value from " + catalogSchema + ".label WHERE key = $2 AND id IN (" + tenantLabelIDsSQL + ")"
 )
 
+const (
+	DefaultCursorPageSize         = 100
+	DefaultMetricQueryParallelism = 4
+
+	// metricRowsBufferSize is the number of rows of a metric read ahead of
+	// the rows being visited, when querying several metrics in parallel.
+	metricRowsBufferSize = 100
+)
+
+type ReaderCfg struct {
+	LabelsCacheSize uint64
+	// CursorPageSize is the number of series fetched from the database at a
+	// time when feeding the PromQL engine.
+	CursorPageSize int
+	// MetricQueryParallelism is the maximum number of metrics queried at once
+	// by a remote read selector matching several metrics. PromQL selectors
+	// read them one at a time, through cursors.
+	MetricQueryParallelism int
+	Limits                 QueryLimits
+}
+
 // NewPgxReaderWithMetricCache returns a new DBReader that reads from PostgreSQL using PGX
 // and caches metric table names using the supplied cacher.
-func NewPgxReaderWithMetricCache(c *pgxpool.Pool, cache MetricCache, labelsCacheSize uint64) *DBReader {
+func NewPgxReaderWithMetricCache(c *pgxpool.Pool, cache MetricCache, cfg *ReaderCfg) *DBReader {
 	pi := &pgxQuerier{
 		conn: &pgxConnImpl{
-			conn: c,
+			conn:             c,
+			statementTimeout: cfg.Limits.StatementTimeout,
+			maxRows:          cfg.Limits.MaxRowsPerQuery,
 		},
-		metricTableNames: cache,
-		labels:           clockcache.WithMax(labelsCacheSize),
+		metricTableNames:       cache,
+		labels:                 clockcache.WithMax(cfg.LabelsCacheSize),
+		cursorPageSize:         cfg.CursorPageSize,
+		metricQueryParallelism: cfg.MetricQueryParallelism,
+		maxSeriesPerSelector:   cfg.Limits.MaxSeriesPerSelector,
 	}
+	pi.rollups = newRollupCache(pi.conn)
 
 	return &DBReader{
 		db: pi,
@@ -47,24 +85,102 @@ func NewPgxReaderWithMetricCache(c *pgxpool.Pool, cache MetricCache, labelsCache
 // NewPgxReader returns a new DBReader that reads that from PostgreSQL using PGX.
 func NewPgxReader(c *pgxpool.Pool, readHist prometheus.ObserverVec, labelsCacheSize uint64) *DBReader {
 	cache := &MetricNameCache{clockcache.WithMax(DefaultMetricCacheSize)}
-	return NewPgxReaderWithMetricCache(c, cache, labelsCacheSize)
+	return NewPgxReaderWithMetricCache(c, cache, &ReaderCfg{LabelsCacheSize: labelsCacheSize, CursorPageSize: DefaultCursorPageSize, MetricQueryParallelism: DefaultMetricQueryParallelism})
 }
 
 type metricTimeRangeFilter struct {
 	metric    string
 	startTime string
 	endTime   string
+	// rollupView, if set, is the continuous aggregate the samples are read
+	// from instead of the data table of the metric, with buckets of
+	// rollupResolution milliseconds.
+	rollupView       string
+	rollupResolution int64
+}
+
+// samplesTable returns the sanitized name of the table the samples are read
+// from, or the subquery reading the buckets of the rollup within the time
+// range.
+func (f metricTimeRangeFilter) samplesTable() string {
+	if f.rollupView != "" {
+		return fmt.Sprintf(rollupSamplesSQLFormat, pgx.Identifier{dataRollupSchema, f.rollupView}.Sanitize(), f.startTime, f.endTime, f.rollupResolution)
+	}
+	return pgx.Identifier{dataSchema, f.metric}.Sanitize()
 }
 
 type pgxQuerier struct {
 	conn             pgxConn
 	metricTableNames MetricCache
 	// contains [int64]labels.Label
-	labels *clockcache.Cache
+	labels         *clockcache.Cache
+	cursorPageSize int
+	// metricQueryParallelism is the maximum number of metrics queried at
+	// once, by remote read selectors matching several metrics.
+	metricQueryParallelism int
+	// maxSeriesPerSelector, if set, is the maximum number of series a
+	// selector may return.
+	maxSeriesPerSelector int
+	// rollups, if set, lets range queries read downsampled data.
+	rollups *rollupCache
+	// tenant, if set, restricts every query to the series of that tenant.
+	tenant string
+	// ctx, if set, is the context the queries are run with.
+	ctx context.Context
 }
 
 var _ Querier = (*pgxQuerier)(nil)
 
+// ForTenant returns a querier sharing the connection and caches of q that
+// only sees the series written by tenant.
+func (q *pgxQuerier) ForTenant(tenant string) Querier {
+	scoped := *q
+	scoped.tenant = tenant
+	return &scoped
+}
+
+// WithStats returns a querier sharing the caches of q that records the SQL
+// statements it runs, with their row counts and durations, in stats.
+func (q *pgxQuerier) WithStats(stats *QueryStats) Querier {
+	recording := *q
+	recording.conn = &statsConn{pgxConn: q.conn, stats: stats}
+	return &recording
+}
+
+// WithContext returns a querier sharing the connection and caches of q that
+// runs its queries with ctx, so that they are canceled along with it. The
+// queries of the returned querier share a single row limit.
+func (q *pgxQuerier) WithContext(ctx context.Context) Querier {
+	scoped := *q
+	scoped.ctx = withRowCount(ctx)
+	return &scoped
+}
+
+// queryContext returns the context the queries of q are run with.
+func (q *pgxQuerier) queryContext() context.Context {
+	if q.ctx == nil {
+		return context.Background()
+	}
+	return q.ctx
+}
+
+// scopeMatchers adds the tenant matcher to ms, if the querier is scoped to a
+// tenant. Since matchers are ANDed, a client-supplied matcher on the tenant
+// label can only narrow the result further.
+func (q *pgxQuerier) scopeMatchers(ms []*labels.Matcher) []*labels.Matcher {
+	return scopeToTenant(ms, q.tenant)
+}
+
+// scopeToTenant adds the matcher on tenant to ms, if tenant is set.
+func scopeToTenant(ms []*labels.Matcher, tenant string) []*labels.Matcher {
+	if tenant == "" {
+		return ms
+	}
+	scoped := make([]*labels.Matcher, len(ms), len(ms)+1)
+	copy(scoped, ms)
+	return append(scoped, labels.MustNewMatcher(labels.MatchEqual, TenantLabelName, tenant))
+}
+
 // HealthCheck implements the healtchecker interface
 func (q *pgxQuerier) HealthCheck() error {
 	rows, err := q.conn.Query(context.Background(), "SELECT")
@@ -86,13 +202,45 @@ func (q *pgxQuerier) LabelsCacheCapacity() int {
 }
 
 // entry point from our own version of the prometheus engine
+// Queries for a single metric are answered lazily through a database cursor:
+// no data is read until the engine starts iterating over the returned set.
 func (q *pgxQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
-	rows, topNode, err := q.getResultRows(mint, maxt, hints, path, ms)
+	metric, cases, values, err := buildSubQueries(q.scopeMatchers(ms))
 	if err != nil {
 		return errorSeriesSet{err: err}, nil
 	}
 
-	ss := buildSeriesSet(rows, q)
+	filter := metricTimeRangeFilter{
+		metric:    metric,
+		startTime: toRFC3339Nano(mint),
+		endTime:   toRFC3339Nano(maxt),
+	}
+
+	if metric == "" {
+		// every metric is read through its own cursor, one after the other
+		queries, err := q.buildMultipleMetricQueries(filter, cases, values)
+		if err != nil {
+			return errorSeriesSet{err: err}, nil
+		}
+		sets := make([]*pgxCursorSeriesSet, len(queries))
+		for i, sqlQuery := range queries {
+			sets[i] = buildCursorSeriesSet(q.queryContext(), q.conn, sqlQuery, nil, q.cursorPageSize, q)
+		}
+		return &chainedSeriesSet{sets: sets, maxSeries: q.maxSeriesPerSelector}, nil
+	}
+
+	sqlQuery, values, topNode, warnings, err := q.buildSingleMetricQuery(metric, filter, cases, values, hints, path)
+	if err != nil {
+		// If the metric table is missing, there are no results for this query.
+		if err == errMissingTableName {
+			return buildSeriesSet(nil, q), nil
+		}
+		return errorSeriesSet{err: err}, nil
+	}
+
+	ss := buildCursorSeriesSet(q.queryContext(), q.conn, sqlQuery, values, q.cursorPageSize, q)
+	ss.warnings = warnings
+	ss.maxSeries = q.maxSeriesPerSelector
 	return ss, topNode
 }
 
@@ -119,8 +267,39 @@ func (q *pgxQuerier) Query(query *prompb.Query) ([]*prompb.TimeSeries, error) {
 	return results, err
 }
 
+// entry point from streamed remote-storage queries
+func (q *pgxQuerier) StreamQuery(query *prompb.Query, fn func(*prompb.TimeSeries) error) error {
+	if query == nil {
+		return nil
+	}
+
+	matchers, err := FromLabelMatchers(query.Matchers)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = q.visitResultRows(query.StartTimestampMs, query.EndTimestampMs, nil, nil, matchers, func(row timescaleRow) error {
+		ts, err := rowToTimeSeries(row, q)
+		if err != nil {
+			return err
+		}
+		return fn(ts)
+	})
+
+	return err
+}
+
 func (q *pgxQuerier) LabelNames() ([]string, error) {
-	rows, err := q.conn.Query(context.Background(), getLabelNamesSQL)
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	if q.tenant != "" {
+		rows, err = q.conn.Query(q.queryContext(), getTenantLabelNamesSQL, q.tenant)
+	} else {
+		rows, err = q.conn.Query(q.queryContext(), getLabelNamesSQL)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +322,19 @@ func (q *pgxQuerier) LabelNames() ([]string, error) {
 }
 
 func (q *pgxQuerier) LabelValues(labelName string) ([]string, error) {
-	rows, err := q.conn.Query(context.Background(), getLabelValuesSQL, labelName)
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	switch {
+	case q.tenant == "":
+		rows, err = q.conn.Query(q.queryContext(), getLabelValuesSQL, labelName)
+	case labelName == TenantLabelName:
+		// tenants only ever see their own name here, so hide it altogether
+		return []string{}, nil
+	default:
+		rows, err = q.conn.Query(q.queryContext(), getTenantLabelValuesSQL, q.tenant, labelName)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +393,12 @@ func (q *pgxQuerier) getLabelsForIds(ids []int64) (lls labels.Labels, err error)
 
 	lls = make([]labels.Label, 0, len(values))
 	for i := range values {
-		lls = append(lls, values[i].(labels.Label))
+		l := values[i].(labels.Label)
+		// the tenant label is an implementation detail of a scoped querier
+		if q.tenant != "" && l.Name == TenantLabelName {
+			continue
+		}
+		lls = append(lls, l)
 	}
 
 	return
@@ -212,7 +408,7 @@ func (q *pgxQuerier) fetchMissingLabels(misses []interface{}, missedIds []int64,
 	for i := range misses {
 		missedIds[i] = misses[i].(int64)
 	}
-	rows, err := q.conn.Query(context.Background(), GetLabelsSQL, missedIds)
+	rows, err := q.conn.Query(q.queryContext(), GetLabelsSQL, missedIds)
 	if err != nil {
 		return 0, err
 	}
@@ -259,11 +455,28 @@ type timescaleRow struct {
 	err      error
 }
 
+// rowVisitor is called once for every row read off the database cursor. If it
+// returns an error the iteration stops and the error is propagated.
+type rowVisitor func(row timescaleRow) error
+
 func (q *pgxQuerier) getResultRows(startTimestamp int64, endTimestamp int64, hints *storage.SelectHints, path []parser.Node, matchers []*labels.Matcher) ([]timescaleRow, parser.Node, error) {
+	// TODO this allocation assumes we usually have 1 row, if not, refactor
+	results := make([]timescaleRow, 0, 1)
+	topNode, err := q.visitResultRows(startTimestamp, endTimestamp, hints, path, matchers, func(row timescaleRow) error {
+		results = append(results, row)
+		return row.err
+	})
+	return results, topNode, err
+}
 
-	metric, cases, values, err := buildSubQueries(matchers)
+// visitResultRows runs the queries needed to answer the matchers and hands
+// each resulting row to visit as soon as it is read, without buffering the
+// full result set.
+func (q *pgxQuerier) visitResultRows(startTimestamp int64, endTimestamp int64, hints *storage.SelectHints, path []parser.Node, matchers []*labels.Matcher, visit rowVisitor) (parser.Node, error) {
+
+	metric, cases, values, err := buildSubQueries(q.scopeMatchers(matchers))
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	filter := metricTimeRangeFilter{
@@ -272,114 +485,225 @@ func (q *pgxQuerier) getResultRows(startTimestamp int64, endTimestamp int64, hin
 		endTime:   toRFC3339Nano(endTimestamp),
 	}
 
+	visit = limitSeries(q.maxSeriesPerSelector, visit)
 	if metric != "" {
-		return q.querySingleMetric(metric, filter, cases, values, hints, path)
+		return q.querySingleMetric(metric, filter, cases, values, hints, path, visit)
 	}
 
+	return nil, q.queryMultipleMetrics(filter, cases, values, visit)
+}
+
+// queryMultipleMetrics runs one query per metric matching the matchers,
+// spread over at most metricQueryParallelism connections at once. The rows are
+// still visited one metric after the other, in the order of the metric names.
+func (q *pgxQuerier) queryMultipleMetrics(filter metricTimeRangeFilter, cases []string, values []interface{}, visit rowVisitor) error {
+	queries, err := q.buildMultipleMetricQueries(filter, cases, values)
+	if err != nil {
+		return err
+	}
+	return q.runParallelQueries(q.queryContext(), queries, visit)
+}
+
+// buildMultipleMetricQueries looks up the series matching the matchers and
+// returns the SQL fetching them, one query per metric.
+func (q *pgxQuerier) buildMultipleMetricQueries(filter metricTimeRangeFilter, cases []string, values []interface{}) ([]string, error) {
 	sqlQuery := buildMetricNameSeriesIDQuery(cases)
-	rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
+	rows, err := q.conn.Query(q.queryContext(), sqlQuery, values...)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	defer rows.Close()
 
 	metrics, series, err := getSeriesPerMetric(rows)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	rows.Close()
 
-	// TODO this assume on average on row per-metric. Is this right?
-	results := make([]timescaleRow, 0, len(metrics))
+	tableNames, err := q.getMetricTableNames(metrics)
+	if err != nil {
+		return nil, err
+	}
 
-	numQueries := 0
-	batch := q.conn.NewBatch()
+	queries := make([]string, 0, len(metrics))
 	for i, metric := range metrics {
-		//TODO batch getMetricTableName
-		tableName, err := q.getMetricTableName(metric)
-		if err != nil {
-			// If the metric table is missing, there are no results for this query.
-			if err == errMissingTableName {
-				continue
-			}
-			return nil, nil, err
+		tableName, ok := tableNames[metric]
+		// If the metric table is missing, there are no results for this metric.
+		if !ok {
+			continue
 		}
 		filter.metric = tableName
-		sqlQuery = buildTimeseriesBySeriesIDQuery(filter, series[i])
-		batch.Queue(sqlQuery)
-		numQueries += 1
+		queries = append(queries, buildTimeseriesBySeriesIDQuery(filter, series[i]))
 	}
+	return queries, nil
+}
 
-	batchResults, err := q.conn.SendBatch(context.Background(), batch)
-	if err != nil {
-		return nil, nil, err
-	}
-	defer batchResults.Close()
+// metricRows streams the rows of one of the queries run in parallel.
+type metricRows struct {
+	rows chan timescaleRow
+	// err is set before rows is closed
+	err error
+}
 
-	for i := 0; i < numQueries; i++ {
-		rows, err = batchResults.Query()
-		if err != nil {
-			rows.Close()
-			return nil, nil, err
+// runParallelQueries runs the queries with ctx, at most
+// metricQueryParallelism at a time, and visits their rows in the order of the
+// queries. A query only starts when a previous one has finished, so with a
+// parallelism of 1 the queries are run one after the other. The queries still
+// running are canceled as soon as a visit fails.
+func (q *pgxQuerier) runParallelQueries(ctx context.Context, queries []string, visit rowVisitor) error {
+	parallelism := q.metricQueryParallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var (
+		results = make([]*metricRows, len(queries))
+		slots   = make(chan struct{}, parallelism)
+		wg      sync.WaitGroup
+	)
+	for i := range results {
+		results[i] = &metricRows{rows: make(chan timescaleRow, metricRowsBufferSize)}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer wg.Wait()
+	defer cancel()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i, sqlQuery := range queries {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				for _, r := range results[i:] {
+					r.err = ctx.Err()
+					close(r.rows)
+				}
+				return
+			}
+			wg.Add(1)
+			go func(sqlQuery string, r *metricRows) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				defer close(r.rows)
+				r.err = q.streamQuery(ctx, sqlQuery, r.rows)
+			}(sqlQuery, results[i])
 		}
-		results, err = appendTsRows(results, rows)
-		// can't defer because we need to Close before the next loop iteration
-		rows.Close()
-		if err != nil {
-			rows.Close()
-			return nil, nil, err
+	}()
+
+	for _, r := range results {
+		for row := range r.rows {
+			if err := visit(row); err != nil {
+				return err
+			}
+		}
+		if r.err != nil {
+			return r.err
 		}
 	}
-
-	return results, nil, nil
+	return nil
 }
 
-func (q *pgxQuerier) querySingleMetric(metric string, filter metricTimeRangeFilter, cases []string, values []interface{}, hints *storage.SelectHints, path []parser.Node) ([]timescaleRow, parser.Node, error) {
-	tableName, err := q.getMetricTableName(metric)
+// streamQuery runs the query with ctx and sends its rows to out until ctx is
+// done.
+func (q *pgxQuerier) streamQuery(ctx context.Context, sqlQuery string, out chan<- timescaleRow) error {
+	rows, err := q.conn.Query(ctx, sqlQuery)
 	if err != nil {
-		// If the metric table is missing, there are no results for this query.
-		if err == errMissingTableName {
-			return nil, nil, nil
+		// If we are getting undefined table error, it means the metric
+		// was dropped since we looked its table up.
+		if e, ok := err.(*pgconn.PgError); ok && e.Code == pgerrcode.UndefinedTable {
+			return nil
 		}
+		return err
+	}
+	defer rows.Close()
+
+	return visitTsRows(rows, func(row timescaleRow) error {
+		select {
+		case out <- row:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
 
-		return nil, nil, err
+// buildSingleMetricQuery returns the SQL fetching the series of a single
+// metric, along with its arguments and the top node of any pushdown applied.
+// The samples are read from the coarsest rollup of the metric fitting the
+// hints, if any, in which case a warning is returned.
+func (q *pgxQuerier) buildSingleMetricQuery(metric string, filter metricTimeRangeFilter, cases []string, values []interface{}, hints *storage.SelectHints, path []parser.Node) (string, []interface{}, parser.Node, storage.Warnings, error) {
+	tableName, err := q.getMetricTableName(metric)
+	if err != nil {
+		return "", nil, nil, nil, err
 	}
 	filter.metric = tableName
 
+	var warnings storage.Warnings
+	if r, ok := q.chooseRollup(tableName, hints, path); ok {
+		filter.rollupView = r.view
+		filter.rollupResolution = r.resolution
+		warnings = append(warnings, rollupWarning(metric, r))
+	}
+
 	sqlQuery, values, topNode, err := buildTimeseriesByLabelClausesQuery(filter, cases, values, hints, path)
+	return sqlQuery, values, topNode, warnings, err
+}
+
+// chooseRollup returns the rollup of the metric table the select described by
+// hints and path should read from, if any.
+func (q *pgxQuerier) chooseRollup(tableName string, hints *storage.SelectHints, path []parser.Node) (rollup, bool) {
+	if q.rollups == nil || hints == nil || hints.Step <= 0 {
+		return rollup{}, false
+	}
+	rollups, err := q.rollups.get(tableName)
 	if err != nil {
-		return nil, nil, err
+		log.Warn("msg", "error reading the rollups, using raw data", "err", err)
+		return rollup{}, false
 	}
+	return chooseRollup(rollups, hints, path)
+}
 
-	rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
+func (q *pgxQuerier) querySingleMetric(metric string, filter metricTimeRangeFilter, cases []string, values []interface{}, hints *storage.SelectHints, path []parser.Node, visit rowVisitor) (parser.Node, error) {
+	sqlQuery, values, topNode, _, err := q.buildSingleMetricQuery(metric, filter, cases, values, hints, path)
+	if err != nil {
+		// If the metric table is missing, there are no results for this query.
+		if err == errMissingTableName {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	rows, err := q.conn.Query(q.queryContext(), sqlQuery, values...)
 	if err != nil {
 		// If we are getting undefined table error, it means the query
 		// is looking for a metric which doesn't exist in the system.
 		if e, ok := err.(*pgconn.PgError); !ok || e.Code != pgerrcode.UndefinedTable {
-			return nil, nil, err
+			return nil, err
 		}
 	}
 
 	defer rows.Close()
 
-	// TODO this allocation assumes we usually have 1 row, if not, refactor
-	tsRows, err := appendTsRows(make([]timescaleRow, 0, 1), rows)
-	return tsRows, topNode, err
+	return topNode, visitTsRows(rows, visit)
 }
 
-func appendTsRows(out []timescaleRow, in pgx.Rows) ([]timescaleRow, error) {
+func visitTsRows(in pgx.Rows, visit rowVisitor) error {
 	if in.Err() != nil {
-		return out, in.Err()
+		return in.Err()
 	}
 	for in.Next() {
 		var row timescaleRow
 		row.err = in.Scan(&row.labelIds, &row.times, &row.values)
-		out = append(out, row)
 		if row.err != nil {
 			log.Error("err", row.err)
-			return out, row.err
+		}
+		if err := visit(row); err != nil {
+			return err
 		}
 	}
-	return out, in.Err()
+	return in.Err()
 }
 
 func (q *pgxQuerier) getMetricTableName(metric string) (string, error) {
@@ -409,7 +733,7 @@ func (q *pgxQuerier) getMetricTableName(metric string) (string, error) {
 
 func (q *pgxQuerier) queryMetricTableName(metric string) (string, error) {
 	res, err := q.conn.Query(
-		context.Background(),
+		q.queryContext(),
 		getMetricsTableSQL,
 		metric,
 	)
@@ -431,6 +755,45 @@ func (q *pgxQuerier) queryMetricTableName(metric string) (string, error) {
 	return tableName, nil
 }
 
+// getMetricTableNames returns the table names of the metrics, looking the
+// ones missing from the cache up in a single query. Metrics without a table
+// are left out.
+func (q *pgxQuerier) getMetricTableNames(metrics []string) (map[string]string, error) {
+	tableNames := make(map[string]string, len(metrics))
+	misses := make([]string, 0)
+	for _, metric := range metrics {
+		tableName, err := q.metricTableNames.Get(metric)
+		switch err {
+		case nil:
+			tableNames[metric] = tableName
+		case ErrEntryNotFound:
+			misses = append(misses, metric)
+		default:
+			return nil, err
+		}
+	}
+	if len(misses) == 0 {
+		return tableNames, nil
+	}
+
+	rows, err := q.conn.Query(q.queryContext(), getMetricsTablesSQL, misses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var metric, tableName string
+		if err = rows.Scan(&metric, &tableName); err != nil {
+			return nil, err
+		}
+		tableNames[metric] = tableName
+		if err = q.metricTableNames.Set(metric, tableName); err != nil {
+			return nil, err
+		}
+	}
+	return tableNames, rows.Err()
+}
+
 type errorSeriesSet struct {
 	err error
 }
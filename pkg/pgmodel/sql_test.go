@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -89,6 +90,10 @@ func (m *mockPGXConn) Exec(ctx context.Context, sql string, arguments ...interfa
 func (m *mockPGXConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
 	m.queryLock.Lock()
 	defer m.queryLock.Unlock()
+	if sql == getDuplicatePoliciesSQL {
+		// no overrides, every metric uses the default duplicate policy
+		return &mockRows{}, nil
+	}
 	defer func() {
 		m.QueryResultsIndex++
 	}()
@@ -102,7 +107,34 @@ func (m *mockPGXConn) Query(ctx context.Context, sql string, args ...interface{}
 }
 
 func (m *mockPGXConn) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
-	panic("should never be called")
+	return m.recordCopy(tableName.Sanitize(), rowSrc)
+}
+
+func (m *mockPGXConn) CopyFromStaging(ctx context.Context, rowSrc pgx.CopyFromSource, insertSQL string) (int64, error) {
+	end := strings.IndexByte(insertSQL, '(')
+	return m.recordCopy(insertSQL[len("INSERT INTO "):end], rowSrc)
+}
+
+// recordCopy records the copied rows like the inserted ones
+func (m *mockPGXConn) recordCopy(tableName string, rowSrc pgx.CopyFromSource) (int64, error) {
+	m.insertLock.Lock()
+	defer m.insertLock.Unlock()
+	m.CopyFromTableName = append(m.CopyFromTableName, tableName)
+	var copied int64
+	for rowSrc.Next() {
+		values, err := rowSrc.Values()
+		if err != nil {
+			return copied, err
+		}
+		m.Times = append(m.Times, values[0].(time.Time))
+		m.Vals = append(m.Vals, values[1].(float64))
+		m.Series = append(m.Series, values[2].(int64))
+		copied++
+	}
+	if m.CopyFromError != nil {
+		return 0, m.CopyFromError
+	}
+	return copied, nil
 }
 
 func (m *mockPGXConn) CopyFromRows(rows [][]interface{}) pgx.CopyFromSource {
@@ -123,6 +155,10 @@ func (m *mockPGXConn) SendBatch(ctx context.Context, b pgxBatch) (pgx.BatchResul
 	return &mockBatchResult{results: m.QueryResults}, m.QueryErr[m.QueryResultsIndex]
 }
 
+func (m *mockPGXConn) DeclareCursor(ctx context.Context, sql string, args ...interface{}) (pgxCursor, error) {
+	panic("should never be called")
+}
+
 type mockMetricCache struct {
 	metricCache  map[string]string
 	getMetricErr error
@@ -168,10 +204,16 @@ func (b *mockBatch) Queue(query string, arguments ...interface{}) {
 type mockBatchResult struct {
 	idx     int
 	results []rowResults
+	// perQuery is set when results holds an entry for every queued query,
+	// Exec included, as recorded by sqlRecorder.
+	perQuery bool
 }
 
 // Exec reads the results from the next query in the batch as if the query has been sent with Conn.Exec.
 func (m *mockBatchResult) Exec() (pgconn.CommandTag, error) {
+	if m.perQuery {
+		m.idx++
+	}
 	return nil, nil
 }
 
@@ -295,6 +337,8 @@ func (m *mockRows) Scan(dest ...interface{}) error {
 		case time.Time:
 			if d, ok := dest[i].(*time.Time); ok {
 				*d = s
+			} else if d, ok := dest[i].(*pgtype.Timestamptz); ok {
+				*d = pgtype.Timestamptz{Time: s, Status: pgtype.Present}
 			}
 		case float64:
 			if _, ok := dest[i].(float64); !ok {
@@ -325,7 +369,7 @@ func (m *mockRows) Scan(dest ...interface{}) error {
 			dvp := reflect.Indirect(dv)
 			dvp.SetUint(m.results[m.idx][i].(uint64))
 		case int64:
-			_, ok1 := dest[i].(int64)
+			_, ok1 := dest[i].(*int64)
 			_, ok2 := dest[i].(*SeriesID)
 			if !ok1 && !ok2 {
 				return fmt.Errorf("wrong value type int64")
@@ -433,7 +477,7 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 				QueryResults: c.queryResults,
 			}
 
-			inserter := insertHandler{conn: mock, seriesCache: make(map[string]SeriesID)}
+			inserter := insertHandler{conn: mock, seriesCache: NewSeriesCache(DefaultSeriesCacheSize)}
 
 			lsi := make([]samplesInfo, 0)
 			for _, ser := range c.series {
@@ -472,6 +516,100 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 	}
 }
 
+func TestPGXInserterSeriesLimit(t *testing.T) {
+	var lsi []samplesInfo
+	for _, value := range []string{"a", "b"} {
+		ls, err := LabelsFromSlice(labels.Labels{
+			{Name: MetricNameLabelName, Value: "metric_1"},
+			{Name: "foo", Value: value},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		lsi = append(lsi, samplesInfo{labels: ls, seriesID: -1, samples: []prompb.Sample{{Timestamp: 1, Value: 1}}})
+	}
+
+	// one series already exists, so only one more can be created
+	mock := &sqlRecorder{
+		queries: []sqlQuery{
+			{
+				sql:     `SELECT count(*) FROM "prom_data_series"."metric_1"`,
+				results: rowResults{{int64(1)}},
+			},
+			{sql: "BEGIN;"},
+			{
+				sql:     getSeriesIDForLabelSQL,
+				args:    []interface{}{"metric_1", []string{MetricNameLabelName, "foo"}, []string{"metric_1", "a"}},
+				results: rowResults{{"metric_1", int64(2)}},
+			},
+			{sql: "COMMIT;"},
+			{sql: "BEGIN;"},
+			{
+				sql:     getExistingSeriesIDForLabelSQL,
+				args:    []interface{}{"metric_1", []string{MetricNameLabelName, "foo"}, []string{"metric_1", "b"}},
+				results: rowResults{{"metric_1", int64(0)}},
+			},
+			{sql: "COMMIT;"},
+			// the count is not refreshed on the next flush
+			{sql: "BEGIN;"},
+			{
+				sql:     getExistingSeriesIDForLabelSQL,
+				args:    []interface{}{"metric_1", []string{MetricNameLabelName, "foo"}, []string{"metric_1", "c"}},
+				results: rowResults{{"metric_1", int64(0)}},
+			},
+			{sql: "COMMIT;"},
+		},
+		t: t,
+	}
+	cache := NewSeriesCache(DefaultSeriesCacheSize)
+	handler := insertHandler{
+		conn:            mock,
+		seriesCache:     cache,
+		metricName:      "metric_1",
+		metricTableName: "metric_1",
+		maxSeries:       2,
+	}
+
+	if _, err := handler.setSeriesIds(lsi); err != nil {
+		t.Fatal(err)
+	}
+	if lsi[0].seriesID != 2 || lsi[1].seriesID != 0 {
+		t.Fatalf("unexpected series ids: %d, %d", lsi[0].seriesID, lsi[1].seriesID)
+	}
+	if _, err := cache.GetSeries(*lsi[1].labels); err != ErrEntryNotFound {
+		t.Errorf("rejected series should not be cached, got %v", err)
+	}
+
+	ls, err := LabelsFromSlice(labels.Labels{
+		{Name: MetricNameLabelName, Value: "metric_1"},
+		{Name: "foo", Value: "c"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := []samplesInfo{{labels: ls, seriesID: -1, samples: []prompb.Sample{{Timestamp: 1, Value: 1}}}}
+	if _, err := handler.setSeriesIds(next); err != nil {
+		t.Fatal(err)
+	}
+	if next[0].seriesID != 0 {
+		t.Fatalf("unexpected series id: %d", next[0].seriesID)
+	}
+
+	errChan := make(chan error, 1)
+	pending := &pendingBuffer{
+		needsResponse: []insertDataTask{{finished: &sync.WaitGroup{}, errChan: errChan, numSeries: len(lsi)}},
+		batch:         SampleInfoIterator{sampleInfos: lsi},
+	}
+	pending.dropRejectedSeries("metric metric_1 reached the limit of 2 series")
+	if lsi[0].samples == nil || lsi[1].samples != nil {
+		t.Errorf("only the samples of the rejected series should be dropped")
+	}
+	var limitErr *LimitError
+	if err := <-errChan; !errors.As(err, &limitErr) || limitErr.Samples != 1 || limitErr.Reason != reasonSeriesLimit {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 func createRows(x int) map[string][]samplesInfo {
 	return createRowsByMetric(x, 1)
 }
@@ -577,7 +715,7 @@ func TestPGXInserterInsertData(t *testing.T) {
 				getMetricErr: c.metricsGetErr,
 				setMetricErr: c.metricsSetErr,
 			}
-			inserter, err := newPgxInserter(mock, mockMetrics, &Cfg{})
+			inserter, err := newPgxInserter(mock, mockMetrics, nil, &Cfg{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -675,8 +813,46 @@ func (r *sqlRecorder) Query(ctx context.Context, sql string, args ...interface{}
 	return &mockRows{results: rows}, err
 }
 
-func (m *sqlRecorder) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
-	panic("should never be called")
+// CopyFrom records the copy as a COPY query with the copied rows as its args
+func (r *sqlRecorder) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	rows, err := copiedRows(rowSrc)
+	if err != nil {
+		return 0, err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err = r.checkQuery(fmt.Sprintf("COPY %s(%s)", tableName.Sanitize(), strings.Join(columnNames, ", ")), rows...)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// CopyFromStaging records the copy into the staging table like CopyFrom, then
+// insertSQL, which affects the rows of its first result
+func (r *sqlRecorder) CopyFromStaging(ctx context.Context, rowSrc pgx.CopyFromSource, insertSQL string) (int64, error) {
+	if _, err := r.CopyFrom(ctx, pgx.Identifier{stagingTable}, dataColumns, rowSrc); err != nil {
+		return 0, err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	results, err := r.checkQuery(insertSQL)
+	if err != nil || len(results) == 0 {
+		return 0, err
+	}
+	return results[0][0].(int64), nil
+}
+
+func copiedRows(rowSrc pgx.CopyFromSource) ([]interface{}, error) {
+	var rows []interface{}
+	for rowSrc.Next() {
+		values, err := rowSrc.Values()
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, values)
+	}
+	return rows, rowSrc.Err()
 }
 
 func (m *sqlRecorder) CopyFromRows(rows [][]interface{}) pgx.CopyFromSource {
@@ -698,7 +874,39 @@ func (r *sqlRecorder) SendBatch(ctx context.Context, b pgxBatch) (pgx.BatchResul
 		rows, _ := r.checkQuery(q.query, q.arguments...)
 		results = append(results, rows)
 	}
-	return &mockBatchResult{results: results}, nil
+	return &mockBatchResult{results: results, perQuery: true}, nil
+}
+
+func (r *sqlRecorder) DeclareCursor(ctx context.Context, sql string, args ...interface{}) (pgxCursor, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	rows, err := r.checkQuery(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &mockCursor{results: rows}, nil
+}
+
+// mockCursor hands out the rows of a single query page by page
+type mockCursor struct {
+	results rowResults
+	fetches int
+	closed  bool
+}
+
+func (c *mockCursor) Fetch(ctx context.Context, n int) (pgx.Rows, error) {
+	c.fetches++
+	if n > len(c.results) {
+		n = len(c.results)
+	}
+	page := c.results[:n]
+	c.results = c.results[n:]
+	return &mockRows{results: page}, nil
+}
+
+func (c *mockCursor) Close(ctx context.Context) error {
+	c.closed = true
+	return nil
 }
 
 func (r *sqlRecorder) checkQuery(sql string, args ...interface{}) (rowResults, error) {
@@ -798,9 +1006,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"foo"},
-					results: rowResults{{"foo"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"foo"}},
+					results: rowResults{{"foo", "foo"}},
 					err:     fmt.Errorf("some error 2"),
 				},
 			},
@@ -814,7 +1022,6 @@ func TestPGXQuerierQuery(t *testing.T) {
 					{Type: prompb.LabelMatcher_NEQ, Name: "foo", Value: "bar"},
 				},
 			},
-			result: []*prompb.TimeSeries{},
 			sqlQueries: []sqlQuery{
 				{
 					sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
@@ -829,9 +1036,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"foo"},
-					results: rowResults{{"foo"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"foo"}},
+					results: rowResults{{"foo", "foo"}},
 					err:     error(nil),
 				},
 				{
@@ -949,9 +1156,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"foo"},
-					results: rowResults{{"foo"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"foo"}},
+					results: rowResults{{"foo", "foo"}},
 					err:     error(nil),
 				},
 				{
@@ -1051,15 +1258,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"foo"},
-					results: rowResults{{"foo"}},
-					err:     error(nil),
-				},
-				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"bar"},
-					results: rowResults{{"bar"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"foo", "bar"}},
+					results: rowResults{{"foo", "foo"}, {"bar", "bar"}},
 					err:     error(nil),
 				},
 				{
@@ -1136,15 +1337,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"foo"},
-					results: rowResults{{"foo"}},
-					err:     error(nil),
-				},
-				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"bar"},
-					results: rowResults{{"bar"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"foo", "bar"}},
+					results: rowResults{{"foo", "foo"}, {"bar", "bar"}},
 					err:     error(nil),
 				},
 				{
@@ -1216,9 +1411,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"metric"},
-					results: rowResults{{"metric"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"metric"}},
+					results: rowResults{{"metric", "metric"}},
 					err:     error(nil),
 				},
 				{
@@ -1277,9 +1472,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"metric"},
-					results: rowResults{{"metric"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"metric"}},
+					results: rowResults{{"metric", "metric"}},
 					err:     error(nil),
 				},
 				{
@@ -1337,9 +1532,9 @@ func TestPGXQuerierQuery(t *testing.T) {
 					err:     error(nil),
 				},
 				{
-					sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					args:    []interface{}{"metric"},
-					results: rowResults{{"metric"}},
+					sql:     "SELECT m.name, t.table_name FROM unnest($1::text[]) AS m(name), _prom_catalog.get_metric_table_name_if_exists(m.name) AS t",
+					args:    []interface{}{[]string{"metric"}},
+					results: rowResults{{"metric", "metric"}},
 					err:     error(nil),
 				},
 				{
@@ -1478,6 +1673,103 @@ func testLabelMethods(t *testing.T, f func(*pgxQuerier) ([]string, error)) {
 		})
 	}
 }
+func TestPGXQuerierForTenant(t *testing.T) {
+	mock := &sqlRecorder{
+		queries: []sqlQuery{
+			{
+				sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
+				args:    []interface{}{"bar"},
+				results: rowResults{{"bar"}},
+			},
+			{
+				sql: "SELECT s.labels, array_agg(m.time ORDER BY time) as time_array, array_agg(m.value ORDER BY time)\n\t" +
+					"FROM \"prom_data\".\"bar\" m\n\t" +
+					"INNER JOIN \"prom_data_series\".\"bar\" s\n\t" +
+					"ON m.series_id = s.id\n\t" +
+					"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2) AND labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4)\n\t" +
+					"AND time >= '1970-01-01T00:00:01Z'\n\t" +
+					"AND time <= '1970-01-01T00:00:02Z'\n\t" +
+					"GROUP BY s.id",
+				args:    []interface{}{"__name__", "bar", "__tenant__", "team-a"},
+				results: rowResults{{[]int64{2, 3}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
+			},
+			{
+				sql:     "SELECT (labels_info($1::int[])).*",
+				args:    []interface{}{[]int64{3, 2}},
+				results: rowResults{{[]int64{3, 2}, []string{"__tenant__", "__name__"}, []string{"team-a", "bar"}}},
+			},
+			{
+				sql: "SELECT distinct key from _prom_catalog.label WHERE key != '__tenant__' AND id IN (" +
+					"SELECT DISTINCT unnest(s.labels) FROM _prom_catalog.series s " +
+					"WHERE s.labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = '__tenant__' AND l.value = $1))",
+				args:    []interface{}{"team-a"},
+				results: rowResults{{"__name__"}},
+			},
+			{
+				sql: "SELECT value from _prom_catalog.label WHERE key = $2 AND id IN (" +
+					"SELECT DISTINCT unnest(s.labels) FROM _prom_catalog.series s " +
+					"WHERE s.labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = '__tenant__' AND l.value = $1))",
+				args:    []interface{}{"team-a", "__name__"},
+				results: rowResults{{"bar"}},
+			},
+		},
+		t: t,
+	}
+	unscoped := &pgxQuerier{conn: mock, metricTableNames: &mockMetricCache{metricCache: map[string]string{}}, labels: clockcache.WithMax(0)}
+	querier := unscoped.ForTenant("team-a")
+
+	result, err := querier.Query(&prompb.Query{
+		StartTimestampMs: 1000,
+		EndTimestampMs:   2000,
+		Matchers: []*prompb.LabelMatcher{
+			{Type: prompb.LabelMatcher_EQ, Name: MetricNameLabelName, Value: "bar"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the tenant label is not returned to the tenant
+	expected := []*prompb.TimeSeries{{
+		Labels:  []prompb.Label{{Name: MetricNameLabelName, Value: "bar"}},
+		Samples: []prompb.Sample{{Timestamp: toMilis(time.Unix(0, 0)), Value: 1}},
+	}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("unexpected result:\ngot\n%#v\nwanted\n%+v", result, expected)
+	}
+
+	names, err := querier.LabelNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{MetricNameLabelName}) {
+		t.Errorf("unexpected label names: %v", names)
+	}
+
+	values, err := querier.LabelValues(MetricNameLabelName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []string{"bar"}) {
+		t.Errorf("unexpected label values: %v", values)
+	}
+
+	// the values of the tenant label are hidden without querying
+	values, err = querier.LabelValues(TenantLabelName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 0 {
+		t.Errorf("unexpected tenant label values: %v", values)
+	}
+
+	if mock.nextQuery != len(mock.queries) {
+		t.Errorf("missing queries: got %d wanted %d", mock.nextQuery, len(mock.queries))
+	}
+	if unscoped.tenant != "" {
+		t.Errorf("scoping a querier changed the original querier")
+	}
+}
+
 func toRowResults(labelNames []string, convertImproperly bool) []rowResults {
 	toReturn := make([]rowResults, 1)
 	toReturn[0] = make(rowResults, len(labelNames))
@@ -1490,3 +1782,120 @@ func toRowResults(labelNames []string, convertImproperly bool) []rowResults {
 	}
 	return toReturn
 }
+
+// parallelQueryConn answers queries named after the label id of their only
+// row. The first `parallel` queries only return once they are all running.
+type parallelQueryConn struct {
+	pgxConn
+	parallel int32
+	started  int32
+	t        *testing.T
+}
+
+func (c *parallelQueryConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	started := atomic.AddInt32(&c.started, 1)
+	if started <= c.parallel {
+		deadline := time.Now().Add(5 * time.Second)
+		for atomic.LoadInt32(&c.started) < c.parallel {
+			if time.Now().After(deadline) {
+				c.t.Errorf("queries not run in parallel: %d running", atomic.LoadInt32(&c.started))
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	var id int64
+	if _, err := fmt.Sscanf(sql, "%d", &id); err != nil {
+		return nil, err
+	}
+	return &mockRows{results: rowResults{
+		{[]int64{id}, []time.Time{time.Unix(id, 0)}, []float64{float64(id)}},
+		{[]int64{id * 10}, []time.Time{time.Unix(id, 0)}, []float64{float64(id)}},
+	}}, nil
+}
+
+func TestPGXQuerierRunParallelQueries(t *testing.T) {
+	conn := &parallelQueryConn{parallel: 3, t: t}
+	querier := pgxQuerier{conn: conn, metricQueryParallelism: 3}
+	queries := []string{"1", "2", "3", "4", "5"}
+
+	var visited []int64
+	err := querier.runParallelQueries(context.Background(), queries, func(row timescaleRow) error {
+		visited = append(visited, row.labelIds[0])
+		return row.err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the rows are visited in the order of the queries
+	expected := []int64{1, 10, 2, 20, 3, 30, 4, 40, 5, 50}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Errorf("unexpected rows visited: got %v wanted %v", visited, expected)
+	}
+
+	// the queries left are abandoned once a visit fails
+	visitErr := fmt.Errorf("visit error")
+	visited = nil
+	err = querier.runParallelQueries(context.Background(), queries, func(row timescaleRow) error {
+		visited = append(visited, row.labelIds[0])
+		return visitErr
+	})
+	if err != visitErr || !reflect.DeepEqual(visited, []int64{1}) {
+		t.Errorf("unexpected result of a failed visit: %v %v", err, visited)
+	}
+
+	if err = querier.runParallelQueries(context.Background(), []string{"1", "not a query"}, func(timescaleRow) error { return nil }); err == nil {
+		t.Errorf("expected an error for a failed query")
+	}
+
+	// the queries are canceled along with the context they are run with
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err = querier.runParallelQueries(ctx, queries, func(timescaleRow) error { return nil }); err != context.Canceled {
+		t.Errorf("unexpected error for a canceled query: %v", err)
+	}
+}
+
+func TestPGXInserterAdmit(t *testing.T) {
+	inserter := &pgxInserter{maxInFlightSamples: 3, maxInsertQueueDepth: 2}
+
+	// a request over the limit is let through if nothing is in-flight
+	numRows, err := inserter.admit(createRows(5))
+	if err != nil {
+		t.Fatalf("unexpected error admitting data: %v", err)
+	}
+	if numRows != 5 {
+		t.Fatalf("unexpected number of rows: got %d wanted 5", numRows)
+	}
+	if _, err = inserter.admit(createRows(1)); err != ErrIngestSaturated {
+		t.Fatalf("unexpected error: got %v wanted %v", err, ErrIngestSaturated)
+	}
+	inserter.release(numRows)
+
+	if _, err = inserter.admit(createRows(2)); err != nil {
+		t.Fatalf("unexpected error admitting data: %v", err)
+	}
+	if _, err = inserter.admit(createRows(1)); err != nil {
+		t.Fatalf("unexpected error admitting data: %v", err)
+	}
+	if _, err = inserter.admit(createRows(1)); err != ErrIngestSaturated {
+		t.Fatalf("unexpected error: got %v wanted %v", err, ErrIngestSaturated)
+	}
+	inserter.release(3)
+
+	// fill up the queue of metric_0's inserter without running it
+	queue := make(chan insertDataRequest, inserterQueueSize)
+	queue <- insertDataRequest{}
+	queue <- insertDataRequest{}
+	inserter.inserters.Store("metric_0", queue)
+	if _, err = inserter.admit(createRows(1)); err != ErrIngestSaturated {
+		t.Fatalf("unexpected error: got %v wanted %v", err, ErrIngestSaturated)
+	}
+	<-queue
+	if _, err = inserter.admit(createRows(1)); err != nil {
+		t.Fatalf("unexpected error admitting data: %v", err)
+	}
+}
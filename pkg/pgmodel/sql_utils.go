@@ -18,16 +18,30 @@ import (
 )
 
 const (
-	promSchema       = "prom_api"
-	seriesViewSchema = "prom_series"
-	metricViewSchema = "prom_metric"
-	dataSchema       = "prom_data"
-	dataSeriesSchema = "prom_data_series"
-	infoSchema       = "prom_info"
-	catalogSchema    = "_prom_catalog"
-	extSchema        = "_prom_ext"
+	promSchema         = "prom_api"
+	seriesViewSchema   = "prom_series"
+	metricViewSchema   = "prom_metric"
+	dataSchema         = "prom_data"
+	dataSeriesSchema   = "prom_data_series"
+	dataExemplarSchema = "prom_data_exemplar"
+	dataRollupSchema   = "prom_data_rollup"
+	infoSchema         = "prom_info"
+	catalogSchema      = "_prom_catalog"
+	extSchema          = "_prom_ext"
 
 	getCreateMetricsTableWithNewSQL = "SELECT table_name, possibly_new FROM " + catalogSchema + ".get_or_create_metric_table_name($1)"
+
+	// Cursors only live within their transaction, and every cursor gets its
+	// own transaction, so the name does not need to be unique.
+	cursorName           = "promscale_cursor"
+	declareCursorSQL     = "DECLARE " + cursorName + " NO SCROLL CURSOR FOR "
+	fetchCursorSQLFormat = "FETCH FORWARD %d FROM " + cursorName
+
+	// The staging table is created once per connection, see
+	// CreateStagingTable, and is emptied at the end of every transaction
+	// copying into it.
+	stagingTable          = "promscale_staging"
+	createStagingTableSQL = "CREATE TEMPORARY TABLE IF NOT EXISTS " + stagingTable + " (time TIMESTAMPTZ NOT NULL, value DOUBLE PRECISION NOT NULL, series_id BIGINT NOT NULL) ON COMMIT DELETE ROWS"
 )
 
 var (
@@ -44,13 +58,33 @@ type pgxConn interface {
 	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
 	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
 	CopyFromRows(rows [][]interface{}) pgx.CopyFromSource
+	// CopyFromStaging copies the data table rows of rowSrc into the
+	// staging table, then runs insertSQL to move them to their table, in a
+	// single transaction. It returns the number of rows insertSQL affected.
+	CopyFromStaging(ctx context.Context, rowSrc pgx.CopyFromSource, insertSQL string) (int64, error)
 	NewBatch() pgxBatch
 	SendBatch(ctx context.Context, b pgxBatch) (pgx.BatchResults, error)
+	DeclareCursor(ctx context.Context, sql string, args ...interface{}) (pgxCursor, error)
+}
+
+// pgxCursor is a server-side cursor over the results of a query. It holds on
+// to a connection until it is closed.
+type pgxCursor interface {
+	// Fetch returns at most n of the next rows of the cursor. The returned
+	// rows must be closed before Fetch is called again.
+	Fetch(ctx context.Context, n int) (pgx.Rows, error)
+	Close(ctx context.Context) error
 }
 
 type pgxConnImpl struct {
 	conn     *pgxpool.Pool
 	readHist prometheus.ObserverVec
+	// statementTimeout, if set, is the statement_timeout of the queries and
+	// cursors, each run in their own transaction.
+	statementTimeout time.Duration
+	// maxRows, if set, is the maximum number of rows returned by the queries
+	// and cursors sharing the row count of their context.
+	maxRows int64
 }
 
 func (p *pgxConnImpl) getConn() *pgxpool.Pool {
@@ -78,7 +112,28 @@ func (p *pgxConnImpl) Query(ctx context.Context, sql string, args ...interface{}
 		}(time.Now(), p.readHist, sql[0:6])
 	}
 
-	return conn.Query(ctx, sql, args...)
+	if p.statementTimeout <= 0 {
+		rows, err := conn.Query(ctx, sql, args...)
+		if err != nil {
+			return rows, err
+		}
+		return newLimitedRows(rows, rowCount(ctx), p.maxRows), nil
+	}
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	if err = setStatementTimeout(ctx, tx, p.statementTimeout); err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return rows, err
+	}
+	return newLimitedRows(&txRows{Rows: rows, tx: tx}, rowCount(ctx), p.maxRows), nil
 }
 
 func (p *pgxConnImpl) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
@@ -87,6 +142,35 @@ func (p *pgxConnImpl) CopyFrom(ctx context.Context, tableName pgx.Identifier, co
 	return conn.CopyFrom(ctx, tableName, columnNames, rowSrc)
 }
 
+// CreateStagingTable creates the temporary table the copy insert modes copy
+// samples into before inserting them, which lives as long as conn. It must be
+// run on every connection of the pool of an ingestor using them, from the
+// AfterConnect hook of the pool.
+func CreateStagingTable(ctx context.Context, conn *pgx.Conn) error {
+	_, err := conn.Exec(ctx, createStagingTableSQL)
+	return err
+}
+
+func (p *pgxConnImpl) CopyFromStaging(ctx context.Context, rowSrc pgx.CopyFromSource, insertSQL string) (int64, error) {
+	conn := p.getConn()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// rolling back after the commit does nothing
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{stagingTable}, dataColumns, rowSrc); err != nil {
+		return 0, err
+	}
+	ct, err := tx.Exec(ctx, insertSQL)
+	if err != nil {
+		return 0, err
+	}
+	return ct.RowsAffected(), tx.Commit(ctx)
+}
+
 func (p *pgxConnImpl) CopyFromRows(rows [][]interface{}) pgx.CopyFromSource {
 	return pgx.CopyFromRows(rows)
 }
@@ -101,6 +185,54 @@ func (p *pgxConnImpl) SendBatch(ctx context.Context, b pgxBatch) (pgx.BatchResul
 	return conn.SendBatch(ctx, b.(*pgx.Batch)), nil
 }
 
+// DeclareCursor opens a read-only transaction and declares a cursor for sql
+// inside of it.
+func (p *pgxConnImpl) DeclareCursor(ctx context.Context, sql string, args ...interface{}) (pgxCursor, error) {
+	conn := p.getConn()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+
+	if p.statementTimeout > 0 {
+		if err = setStatementTimeout(ctx, tx, p.statementTimeout); err != nil {
+			_ = tx.Rollback(ctx)
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, declareCursorSQL+sql, args...)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	return &pgxCursorImpl{tx: tx, maxRows: p.maxRows, fetched: rowCount(ctx)}, nil
+}
+
+type pgxCursorImpl struct {
+	tx pgx.Tx
+	// maxRows, if set, is the maximum number of rows fetched over all pages,
+	// counted in fetched.
+	maxRows int64
+	fetched *int64
+}
+
+func (c *pgxCursorImpl) Fetch(ctx context.Context, n int) (pgx.Rows, error) {
+	rows, err := c.tx.Query(ctx, fmt.Sprintf(fetchCursorSQLFormat, n))
+	if err != nil {
+		return nil, err
+	}
+	return newLimitedRows(rows, c.fetched, c.maxRows), nil
+}
+
+// Close ends the transaction, which also closes the cursor. Since the
+// transaction is read-only there is nothing to commit.
+func (c *pgxCursorImpl) Close(ctx context.Context) error {
+	return c.tx.Rollback(ctx)
+}
+
 // SampleInfoIterator is an iterator over a collection of sampleInfos that returns
 // data in the format expected for the data table row.
 type SampleInfoIterator struct {
@@ -117,12 +249,12 @@ func NewSampleInfoIterator() SampleInfoIterator {
 	return si
 }
 
-//Append adds a sample info to the back of the iterator
+// Append adds a sample info to the back of the iterator
 func (t *SampleInfoIterator) Append(s samplesInfo) {
 	t.sampleInfos = append(t.sampleInfos, s)
 }
 
-//ResetPosition resets the iteration position to the beginning
+// ResetPosition resets the iteration position to the beginning
 func (t *SampleInfoIterator) ResetPosition() {
 	t.sampleIndex = -1
 	t.sampleInfoIndex = 0
@@ -134,7 +266,8 @@ func (t *SampleInfoIterator) ResetPosition() {
 // has occurred it returns false.
 func (t *SampleInfoIterator) Next() bool {
 	t.sampleIndex++
-	if t.sampleInfoIndex < len(t.sampleInfos) && t.sampleIndex >= len(t.sampleInfos[t.sampleInfoIndex].samples) {
+	// series carrying only exemplars have no samples to skip over
+	for t.sampleInfoIndex < len(t.sampleInfos) && t.sampleIndex >= len(t.sampleInfos[t.sampleInfoIndex].samples) {
 		t.sampleInfoIndex++
 		t.sampleIndex = 0
 	}
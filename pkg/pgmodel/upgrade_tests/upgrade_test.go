@@ -491,6 +491,8 @@ var schemas []string = []string{
 	"pg_toast_temp_1",
 	"prom_api",
 	"prom_data",
+	"prom_data_exemplar",
+	"prom_data_rollup",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",
@@ -505,6 +507,8 @@ var ourSchemas []string = []string{
 	"_prom_ext",
 	"prom_api",
 	"prom_data",
+	"prom_data_exemplar",
+	"prom_data_rollup",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",
@@ -62,10 +62,11 @@ func (ReadRequest_ResponseType) EnumDescriptor() ([]byte, []int) {
 }
 
 type WriteRequest struct {
-	Timeseries           []TimeSeries `protobuf:"bytes,1,rep,name=timeseries,proto3" json:"timeseries"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Timeseries           []TimeSeries     `protobuf:"bytes,1,rep,name=timeseries,proto3" json:"timeseries"`
+	Metadata             []MetricMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *WriteRequest) String() string { return proto.CompactTextString(m) }
@@ -107,6 +108,13 @@ func (m *WriteRequest) GetTimeseries() []TimeSeries {
 	return nil
 }
 
+func (m *WriteRequest) GetMetadata() []MetricMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // ReadRequest represents a remote read request.
 type ReadRequest struct {
 	Queries []*Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
@@ -409,37 +417,38 @@ func init() {
 func init() { proto.RegisterFile("remote.proto", fileDescriptor_eefc82927d57d89b) }
 
 var fileDescriptor_eefc82927d57d89b = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xbb, 0x4d, 0xdb, 0xa0, 0x71, 0x88, 0xc2, 0xb6, 0x25, 0xa6, 0x87, 0x34, 0xb2, 0x38,
-	0x58, 0x2a, 0x0a, 0x22, 0x54, 0x9c, 0x38, 0x90, 0x96, 0x48, 0x45, 0x24, 0xfc, 0x59, 0x07, 0x81,
-	0x10, 0x92, 0xe5, 0xd8, 0xa3, 0xc6, 0xa2, 0xfe, 0xd3, 0xdd, 0xb5, 0xd4, 0xbc, 0x1e, 0xa7, 0x9e,
-	0x10, 0x4f, 0x80, 0x50, 0x9e, 0x04, 0xed, 0xda, 0x0e, 0x1b, 0xb8, 0x70, 0x5b, 0x7f, 0xdf, 0x37,
-	0x3f, 0xef, 0x8c, 0xc7, 0xd0, 0xe2, 0x98, 0x64, 0x12, 0x07, 0x39, 0xcf, 0x64, 0x46, 0x21, 0xe7,
-	0x59, 0x82, 0x72, 0x81, 0x85, 0x38, 0xb2, 0xe4, 0x32, 0x47, 0x51, 0x1a, 0x47, 0x07, 0x97, 0xd9,
-	0x65, 0xa6, 0x8f, 0x8f, 0xd5, 0xa9, 0x54, 0x9d, 0x09, 0xb4, 0x3e, 0xf2, 0x58, 0x22, 0xc3, 0xeb,
-	0x02, 0x85, 0xa4, 0xcf, 0x01, 0x64, 0x9c, 0xa0, 0x40, 0x1e, 0xa3, 0xb0, 0x49, 0xbf, 0xe1, 0x5a,
-	0xc3, 0xfb, 0x83, 0x3f, 0xcc, 0xc1, 0x2c, 0x4e, 0xd0, 0xd3, 0xee, 0xd9, 0xce, 0xed, 0xcf, 0xe3,
-	0x2d, 0x66, 0xe4, 0x9d, 0xef, 0x04, 0x2c, 0x86, 0x41, 0x54, 0xd3, 0x4e, 0xa0, 0x79, 0x5d, 0x98,
-	0xa8, 0x7b, 0x26, 0xea, 0x7d, 0x81, 0x7c, 0xc9, 0xea, 0x04, 0xfd, 0x02, 0xdd, 0x20, 0x0c, 0x31,
-	0x97, 0x18, 0xf9, 0x1c, 0x45, 0x9e, 0xa5, 0x02, 0x7d, 0xdd, 0x81, 0xbd, 0xdd, 0x6f, 0xb8, 0xed,
-	0xe1, 0x43, 0xb3, 0xd8, 0x78, 0xcd, 0x80, 0x55, 0xe9, 0xd9, 0x32, 0x47, 0x76, 0x58, 0x43, 0x4c,
-	0x55, 0x38, 0xa7, 0xd0, 0x32, 0x05, 0x6a, 0x41, 0xd3, 0x1b, 0x4d, 0xdf, 0x4d, 0xc6, 0x5e, 0x67,
-	0x8b, 0x76, 0x61, 0xdf, 0x9b, 0xb1, 0xf1, 0x68, 0x3a, 0x7e, 0xe9, 0x7f, 0x7a, 0xcb, 0xfc, 0xf3,
-	0x8b, 0x0f, 0x6f, 0x5e, 0x7b, 0x1d, 0xe2, 0x8c, 0x54, 0x55, 0xb0, 0x46, 0xd1, 0x27, 0xd0, 0xe4,
-	0x28, 0x8a, 0x2b, 0x59, 0x37, 0xd4, 0xfd, 0xb7, 0x21, 0xed, 0xb3, 0x3a, 0xe7, 0x7c, 0x23, 0xb0,
-	0xab, 0x0d, 0xfa, 0x08, 0xa8, 0x90, 0x01, 0x97, 0xbe, 0x9e, 0x98, 0x0c, 0x92, 0xdc, 0x4f, 0x14,
-	0x87, 0xb8, 0x0d, 0xd6, 0xd1, 0xce, 0xac, 0x36, 0xa6, 0x82, 0xba, 0xd0, 0xc1, 0x34, 0xda, 0xcc,
-	0x6e, 0xeb, 0x6c, 0x1b, 0xd3, 0xc8, 0x4c, 0x9e, 0xc2, 0x9d, 0x24, 0x90, 0xe1, 0x02, 0xb9, 0xb0,
-	0x1b, 0xfa, 0x56, 0xb6, 0x79, 0xab, 0x49, 0x30, 0xc7, 0xab, 0x69, 0x19, 0x60, 0xeb, 0x24, 0x3d,
-	0x81, 0xdd, 0x45, 0x9c, 0x4a, 0x61, 0xef, 0xf4, 0x89, 0x6b, 0x0d, 0x0f, 0xff, 0x1e, 0xee, 0x85,
-	0x32, 0x59, 0x99, 0x71, 0xc6, 0x60, 0x19, 0xcd, 0xd1, 0x67, 0xff, 0xbf, 0x25, 0x1b, 0xfb, 0x71,
-	0x03, 0xfb, 0xe7, 0x8b, 0x22, 0xfd, 0xaa, 0x3e, 0x8e, 0x31, 0xd5, 0x17, 0xd0, 0x0e, 0x4b, 0xd9,
-	0xdf, 0x40, 0x3e, 0x30, 0x91, 0x55, 0x61, 0x45, 0xbd, 0x1b, 0x9a, 0x8f, 0xf4, 0x18, 0x2c, 0xb5,
-	0x46, 0x4b, 0x3f, 0x4e, 0x23, 0xbc, 0xa9, 0xe6, 0x04, 0x5a, 0x7a, 0xa5, 0x94, 0xb3, 0x83, 0xdb,
-	0x55, 0x8f, 0xfc, 0x58, 0xf5, 0xc8, 0xaf, 0x55, 0x8f, 0x7c, 0xde, 0x53, 0xdc, 0x7c, 0x3e, 0xdf,
-	0xd3, 0x3f, 0xc1, 0xd3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xb6, 0x6b, 0xcd, 0x43, 0x03,
-	0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0x36, 0x6d, 0x53, 0x8d, 0x43, 0x14, 0xb6, 0x2d, 0x31, 0x39, 0xa4, 0x91, 0xc5, 0xc1,
+	0x52, 0x51, 0x10, 0xa1, 0xe2, 0xd4, 0x03, 0x69, 0x89, 0x54, 0x44, 0xcd, 0xcf, 0x3a, 0x08, 0x84,
+	0x90, 0x2c, 0xc7, 0x1e, 0x35, 0x16, 0xf5, 0x4f, 0x77, 0xd7, 0x52, 0xf3, 0x0a, 0x3c, 0x16, 0xa7,
+	0x9e, 0x10, 0x4f, 0x80, 0x50, 0x9e, 0x04, 0x79, 0x6d, 0x87, 0x0d, 0x5c, 0x7a, 0x5b, 0x7f, 0x7f,
+	0x3b, 0x33, 0x9e, 0x85, 0x16, 0xc7, 0x38, 0x95, 0x38, 0xcc, 0x78, 0x2a, 0x53, 0x0a, 0x19, 0x4f,
+	0x63, 0x94, 0x73, 0xcc, 0x45, 0xcf, 0x90, 0x8b, 0x0c, 0x45, 0x49, 0xf4, 0xf6, 0x2f, 0xd3, 0xcb,
+	0x54, 0x1d, 0x9f, 0x14, 0xa7, 0x12, 0xb5, 0xbe, 0x11, 0x68, 0x7d, 0xe4, 0x91, 0x44, 0x86, 0xd7,
+	0x39, 0x0a, 0x49, 0x4f, 0x00, 0x64, 0x14, 0xa3, 0x40, 0x1e, 0xa1, 0x30, 0xc9, 0xa0, 0x61, 0x1b,
+	0xa3, 0x07, 0xc3, 0xbf, 0xa1, 0xc3, 0x69, 0x14, 0xa3, 0xab, 0xd8, 0xd3, 0xad, 0xdb, 0x5f, 0x87,
+	0x1b, 0x4c, 0xd3, 0xd3, 0x13, 0xd8, 0x8d, 0x51, 0xfa, 0xa1, 0x2f, 0x7d, 0xb3, 0xa1, 0xbc, 0x3d,
+	0xdd, 0xeb, 0xa0, 0xe4, 0x51, 0xe0, 0x54, 0x8a, 0xca, 0xbf, 0x72, 0x58, 0x3f, 0x08, 0x18, 0x0c,
+	0xfd, 0xb0, 0xae, 0xe5, 0x08, 0x9a, 0xd7, 0xb9, 0x5e, 0xc8, 0x7d, 0x3d, 0xec, 0x7d, 0x8e, 0x7c,
+	0xc1, 0x6a, 0x05, 0xfd, 0x02, 0x5d, 0x3f, 0x08, 0x30, 0x93, 0x18, 0x7a, 0x1c, 0x45, 0x96, 0x26,
+	0x02, 0x3d, 0x35, 0x00, 0x73, 0x73, 0xd0, 0xb0, 0xdb, 0xa3, 0x47, 0xba, 0x59, 0xbb, 0x66, 0xc8,
+	0x2a, 0xf5, 0x74, 0x91, 0x21, 0x3b, 0xa8, 0x43, 0x74, 0x54, 0x58, 0xc7, 0xd0, 0xd2, 0x01, 0x6a,
+	0x40, 0xd3, 0x1d, 0x3b, 0xef, 0x2e, 0x26, 0x6e, 0x67, 0x83, 0x76, 0x61, 0xcf, 0x9d, 0xb2, 0xc9,
+	0xd8, 0x99, 0xbc, 0xf4, 0x3e, 0xbd, 0x65, 0xde, 0xd9, 0xf9, 0x87, 0x37, 0xaf, 0xdd, 0x0e, 0xb1,
+	0xc6, 0xd0, 0x2a, 0x2f, 0x2a, 0x9d, 0xf4, 0x29, 0x34, 0x39, 0x8a, 0xfc, 0x4a, 0xd6, 0x0d, 0x75,
+	0xff, 0x6f, 0x48, 0xf1, 0xac, 0xd6, 0x59, 0xdf, 0x09, 0x6c, 0x2b, 0x82, 0x3e, 0x06, 0x2a, 0xa4,
+	0xcf, 0xa5, 0xa7, 0xe6, 0x2d, 0xfd, 0x38, 0xf3, 0xe2, 0x22, 0x87, 0xd8, 0x0d, 0xd6, 0x51, 0xcc,
+	0xb4, 0x26, 0x1c, 0x41, 0x6d, 0xe8, 0x60, 0x12, 0xae, 0x6b, 0x37, 0x95, 0xb6, 0x8d, 0x49, 0xa8,
+	0x2b, 0x8f, 0x61, 0x37, 0xf6, 0x65, 0x30, 0x47, 0x2e, 0xaa, 0x7f, 0x66, 0xea, 0x55, 0x5d, 0xf8,
+	0x33, 0xbc, 0x72, 0x4a, 0x01, 0x5b, 0x29, 0xe9, 0x11, 0x6c, 0xcf, 0xa3, 0x44, 0x0a, 0x73, 0x6b,
+	0x40, 0x6c, 0x63, 0x74, 0xf0, 0xef, 0x70, 0xcf, 0x0b, 0x92, 0x95, 0x1a, 0x6b, 0x02, 0x86, 0xd6,
+	0x1c, 0x7d, 0x7e, 0xf7, 0x1d, 0xd3, 0xb7, 0xcb, 0xba, 0x81, 0xbd, 0xb3, 0x79, 0x9e, 0x7c, 0xc5,
+	0x70, 0x6d, 0xaa, 0x2f, 0xa0, 0x1d, 0x94, 0xb0, 0xb7, 0x16, 0xf9, 0x50, 0x8f, 0xac, 0x8c, 0x55,
+	0xea, 0xbd, 0x40, 0xff, 0xa4, 0x87, 0x60, 0x14, 0x6b, 0xb4, 0xf0, 0xa2, 0x24, 0xc4, 0x9b, 0x6a,
+	0x4e, 0xa0, 0xa0, 0x57, 0x05, 0x72, 0xba, 0x7f, 0xbb, 0xec, 0x93, 0x9f, 0xcb, 0x3e, 0xf9, 0xbd,
+	0xec, 0x93, 0xcf, 0x3b, 0x45, 0x6e, 0x36, 0x9b, 0xed, 0xa8, 0x37, 0xf4, 0xec, 0xcf, 0x00, 0xa1,
+	0xc6, 0x28, 0x58, 0x82, 0x03, 0x00, 0x00,
 }
 
 func (m *WriteRequest) Marshal() (dAtA []byte, err error) {
@@ -466,6 +475,20 @@ func (m *WriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRemote(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Timeseries) > 0 {
 		for iNdEx := len(m.Timeseries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -756,6 +779,12 @@ func (m *WriteRequest) Size() (n int) {
 			n += 1 + l + sovRemote(uint64(l))
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovRemote(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -946,6 +975,40 @@ func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, MetricMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRemote(dAtA[iNdEx:])
@@ -243,6 +243,11 @@ type EngineOpts struct {
 	MaxSamples         int
 	Timeout            time.Duration
 	ActiveQueryTracker *ActiveQueryTracker
+	// MaxConcurrentQueries is the maximum number of queries executed at
+	// once, no limit is enforced if it is 0. Queries over the limit wait in a
+	// queue of at most MaxQueuedQueries queries, unbounded if negative.
+	MaxConcurrentQueries int
+	MaxQueuedQueries     int
 	// LookbackDelta determines the time since the last sample after which a time
 	// series is considered stale.
 	LookbackDelta time.Duration
@@ -260,6 +265,7 @@ type Engine struct {
 	timeout                  time.Duration
 	maxSamplesPerQuery       int
 	activeQueryTracker       *ActiveQueryTracker
+	gate                     *queryGate
 	queryLogger              QueryLogger
 	queryLoggerLock          sync.RWMutex
 	lookbackDelta            time.Duration
@@ -313,7 +319,11 @@ func NewEngine(opts EngineOpts) *Engine {
 		queryResultSort:  queryResultSummary.WithLabelValues("result_sort"),
 	}
 
-	if t := opts.ActiveQueryTracker; t != nil {
+	var gate *queryGate
+	if opts.MaxConcurrentQueries > 0 {
+		gate = newQueryGate(opts.MaxConcurrentQueries, opts.MaxQueuedQueries)
+		metrics.maxConcurrentQueries.Set(float64(opts.MaxConcurrentQueries))
+	} else if t := opts.ActiveQueryTracker; t != nil {
 		metrics.maxConcurrentQueries.Set(float64(t.GetMaxConcurrent()))
 	} else {
 		metrics.maxConcurrentQueries.Set(-1)
@@ -342,6 +352,7 @@ func NewEngine(opts EngineOpts) *Engine {
 		metrics:                  metrics,
 		maxSamplesPerQuery:       opts.MaxSamples,
 		activeQueryTracker:       opts.ActiveQueryTracker,
+		gate:                     gate,
 		lookbackDelta:            opts.LookbackDelta,
 		noStepSubqueryIntervalFn: opts.NoStepSubqueryIntervalFn,
 	}
@@ -397,6 +408,48 @@ func (ng *Engine) NewRangeQuery(q Queryable, qs string, start, end time.Time, in
 	return qry, nil
 }
 
+// DryRun selects the series of every selector of the query qs from q, with
+// the hints and path the query would use, without evaluating the query. The
+// query is a range query if interval isn't 0. Since the selects may run
+// statements, the dry run waits for a slot like queries do, and is bounded by
+// the same timeout.
+func (ng *Engine) DryRun(ctx context.Context, q Queryable, qs string, start, end time.Time, interval time.Duration) error {
+	var (
+		qry Query
+		err error
+	)
+	if interval == 0 {
+		qry, err = ng.NewInstantQuery(q, qs, start)
+	} else {
+		qry, err = ng.NewRangeQuery(q, qs, start, end, interval)
+	}
+	if err != nil {
+		return err
+	}
+	s := qry.Statement().(*parser.EvalStmt)
+	mint, err := ng.findMinTime(s)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ng.timeout)
+	defer cancel()
+	if ng.gate != nil {
+		if err = ng.gate.Start(ctx); err != nil {
+			return err
+		}
+		defer ng.gate.Done()
+	}
+	querier, err := q.Querier(ctx, timestamp.FromTime(mint), timestamp.FromTime(s.End))
+	if err != nil {
+		return err
+	}
+	defer querier.Close()
+
+	ng.populateSeries(querier, s)
+	return nil
+}
+
 func (ng *Engine) newQuery(q Queryable, expr parser.Expr, start, end time.Time, interval time.Duration) *query {
 	es := &parser.EvalStmt{
 		Expr:     expr,
@@ -472,8 +525,17 @@ func (ng *Engine) exec(ctx context.Context, q *query) (v parser.Value, ws storag
 	defer execSpanTimer.Finish()
 
 	queueSpanTimer, _ := q.stats.GetSpanTimer(ctx, stats.ExecQueueTime, ng.metrics.queryQueueTime)
-	// Log query in active log. The active log guarantees that we don't run over
-	// MaxConcurrent queries.
+	if ng.gate != nil {
+		if err := ng.gate.Start(ctx); err != nil {
+			queueSpanTimer.Finish()
+			return nil, nil, err
+		}
+		defer ng.gate.Done()
+	}
+	// Log query in active log. Queries are only logged once they passed the
+	// gate, so that the log holds the queries being executed rather than the
+	// queued ones. Without a gate, the active log guarantees that we don't run
+	// over MaxConcurrent queries.
 	if ng.activeQueryTracker != nil {
 		queryIndex, err := ng.activeQueryTracker.Insert(ctx, q.q)
 		if err != nil {
@@ -530,7 +592,7 @@ func (ng *Engine) execEvalStmt(ctx context.Context, query *query, s *parser.Eval
 	}
 	defer querier.Close()
 
-	topNode := ng.populateSeries(querier, s)
+	topNodes := ng.populateSeries(querier, s)
 	prepareSpanTimer.Finish()
 
 	evalSpanTimer, ctxInnerEval := query.stats.GetSpanTimer(ctx, stats.InnerEvalTime, ng.metrics.queryInnerEval)
@@ -546,7 +608,7 @@ func (ng *Engine) execEvalStmt(ctx context.Context, query *query, s *parser.Eval
 			defaultEvalInterval:      GetDefaultEvaluationInterval(),
 			logger:                   ng.logger,
 			lookbackDelta:            ng.lookbackDelta,
-			topNode:                  topNode,
+			topNodes:                 topNodes,
 			noStepSubqueryIntervalFn: ng.noStepSubqueryIntervalFn,
 		}
 
@@ -599,7 +661,7 @@ func (ng *Engine) execEvalStmt(ctx context.Context, query *query, s *parser.Eval
 		logger:                   ng.logger,
 		lookbackDelta:            ng.lookbackDelta,
 		noStepSubqueryIntervalFn: ng.noStepSubqueryIntervalFn,
-		topNode:                  topNode,
+		topNodes:                 topNodes,
 	}
 	val, warnings, err := evaluator.Eval(s.Expr)
 	if err != nil {
@@ -681,7 +743,10 @@ func (ng *Engine) findMinTime(s *parser.EvalStmt) (time.Time, error) {
 	return s.Start.Add(-maxOffset), err
 }
 
-func (ng *Engine) populateSeries(querier Querier, s *parser.EvalStmt) parser.Node {
+// populateSeries selects the series of every selector of the statement, and
+// returns the nodes the storage already evaluated, each holding a single
+// selector whose series are the results of the node.
+func (ng *Engine) populateSeries(querier Querier, s *parser.EvalStmt) map[parser.Node]struct{} {
 	var (
 		// Whenever a MatrixSelector is evaluated, evalRange is set to the corresponding range.
 		// The evaluation of the VectorSelector inside then evaluates the given range and unsets
@@ -689,6 +754,7 @@ func (ng *Engine) populateSeries(querier Querier, s *parser.EvalStmt) parser.Nod
 		set       storage.SeriesSet
 		evalRange time.Duration
 		topNode   parser.Node
+		topNodes  = make(map[parser.Node]struct{})
 	)
 
 	parser.Inspect(s.Expr, func(node parser.Node, path []parser.Node) error {
@@ -727,18 +793,18 @@ func (ng *Engine) populateSeries(querier Querier, s *parser.EvalStmt) parser.Nod
 				hints.End = hints.End - offsetMilliseconds
 			}
 
-			if topNode != nil {
-				return fmt.Errorf("assigning topNode twice")
-			}
 			set, topNode = querier.Select(false, hints, path, n.LabelMatchers...)
 			n.UnexpandedSeriesSet = set
+			if topNode != nil {
+				topNodes[topNode] = struct{}{}
+			}
 
 		case *parser.MatrixSelector:
 			evalRange = n.Range
 		}
 		return nil
 	})
-	return topNode
+	return topNodes
 }
 
 // extractFuncFromPath walks up the path and searches for the first instance of
@@ -821,7 +887,7 @@ type evaluator struct {
 	logger                   log.Logger
 	defaultEvalInterval      int64
 	lookbackDelta            time.Duration
-	topNode                  parser.Node
+	topNodes                 map[parser.Node]struct{}
 	noStepSubqueryIntervalFn func(rangeMillis int64) int64
 }
 
@@ -1103,7 +1169,7 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 	}
 	numSteps := int((ev.endTimestamp-ev.startTimestamp)/ev.interval) + 1
 
-	if expr == ev.topNode {
+	if _, ok := ev.topNodes[expr]; ok {
 		/* the storage layer has already processed this node. Just return
 		the result. */
 		var (
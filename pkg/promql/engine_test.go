@@ -30,6 +30,7 @@ import (
 	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/tsdbutil"
 	"github.com/prometheus/prometheus/util/testutil"
 )
 
@@ -1183,3 +1184,101 @@ func TestQueryLogger_error(t *testing.T) {
 		testutil.Equals(t, f1.logs[i], field)
 	}
 }
+
+type pushdownSample struct {
+	t int64
+	v float64
+}
+
+func (s pushdownSample) T() int64   { return s.t }
+func (s pushdownSample) V() float64 { return s.v }
+
+type listSeriesSet struct {
+	series []storage.Series
+	cur    int
+}
+
+func (s *listSeriesSet) Next() bool {
+	s.cur++
+	return s.cur <= len(s.series)
+}
+func (s *listSeriesSet) At() storage.Series         { return s.series[s.cur-1] }
+func (s *listSeriesSet) Err() error                 { return nil }
+func (s *listSeriesSet) Warnings() storage.Warnings { return nil }
+
+// pushdownQuerier evaluates the range functions applied directly to a matrix
+// selector like the storage pushing them down does, every step of the result
+// of a metric being its value. The other selectors return a sample of the
+// value of their metric every 10s.
+type pushdownQuerier struct {
+	errQuerier
+	values map[string]float64
+	steps  []int64
+}
+
+func (q *pushdownQuerier) Select(_ bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	var metric string
+	for _, m := range matchers {
+		if m.Name == labels.MetricName {
+			metric = m.Value
+		}
+	}
+	lset := labels.FromStrings(labels.MetricName, metric, "job", "x")
+	var samples []tsdbutil.Sample
+
+	if len(path) >= 2 && (hints.Func == "rate" || hints.Func == "avg_over_time") {
+		if _, ok := path[len(path)-1].(*parser.MatrixSelector); ok {
+			if call, ok := path[len(path)-2].(*parser.Call); ok {
+				for _, t := range q.steps {
+					samples = append(samples, pushdownSample{t: t, v: q.values[metric]})
+				}
+				series := storage.NewListSeries(lset, samples)
+				return &listSeriesSet{series: []storage.Series{series}}, call
+			}
+		}
+	}
+
+	for t := hints.Start - hints.Start%10000; t <= hints.End; t += 10000 {
+		samples = append(samples, pushdownSample{t: t, v: q.values[metric]})
+	}
+	return &listSeriesSet{series: []storage.Series{storage.NewListSeries(lset, samples)}}, nil
+}
+
+func TestPushdownWithSeveralSelectors(t *testing.T) {
+	engine := NewEngine(EngineOpts{MaxSamples: 1000, Timeout: 10 * time.Second})
+	steps := []int64{600000, 630000, 660000}
+	queryable := QueryableFunc(func(ctx context.Context, mint, maxt int64) (Querier, error) {
+		return &pushdownQuerier{values: map[string]float64{"a": 6, "b": 3}, steps: steps}, nil
+	})
+
+	for _, tc := range []struct {
+		query    string
+		expected float64
+		metric   labels.Labels
+	}{
+		{query: "avg_over_time(a[5m]) / avg_over_time(b[5m])", expected: 2, metric: labels.FromStrings("job", "x")},
+		{query: "rate(a[5m]) - rate(b[5m])", expected: 3, metric: labels.FromStrings("job", "x")},
+		{query: "rate(a[5m]) / b", expected: 2, metric: labels.FromStrings("job", "x")},
+		{query: "sum(rate(a[5m])) + sum(avg_over_time(b[5m]))", expected: 9, metric: labels.Labels{}},
+		{query: "max by (job) (rate(a[5m]) * on(job) avg_over_time(b[5m]))", expected: 18, metric: labels.FromStrings("job", "x")},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			query, err := engine.NewRangeQuery(queryable, tc.query, timestamp.Time(steps[0]), timestamp.Time(steps[len(steps)-1]), 30*time.Second)
+			testutil.Ok(t, err)
+			res := query.Exec(context.Background())
+			testutil.Ok(t, res.Err)
+
+			var points []Point
+			for _, t := range steps {
+				points = append(points, Point{T: t, V: tc.expected})
+			}
+			testutil.Equals(t, Matrix{Series{Metric: tc.metric, Points: points}}, res.Value)
+
+			instant, err := engine.NewInstantQuery(queryable, tc.query, timestamp.Time(steps[0]))
+			testutil.Ok(t, err)
+			res = instant.Exec(context.Background())
+			testutil.Ok(t, res.Err)
+			testutil.Equals(t, Vector{Sample{Metric: tc.metric, Point: Point{T: steps[0], V: tc.expected}}}, res.Value)
+		})
+	}
+}
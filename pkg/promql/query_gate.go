@@ -0,0 +1,71 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package promql
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrTooManyQueries is returned if a query can't be queued because too many
+// queries are already waiting to be executed.
+type ErrTooManyQueries string
+
+func (e ErrTooManyQueries) Error() string {
+	return fmt.Sprintf("too many queries waiting to be executed in %s", string(e))
+}
+
+// queryGate bounds the number of queries executed at once. Queries over the
+// limit wait in a queue of bounded length.
+type queryGate struct {
+	// queued is the number of queries waiting for a slot, must be the first
+	// word in the struct to ensure proper alignment in 32-bit systems.
+	queued    int64
+	maxQueued int64
+	slots     chan struct{}
+}
+
+// newQueryGate returns a gate executing at most maxConcurrent queries at once,
+// with at most maxQueued queries waiting. The queue is unbounded if maxQueued
+// is negative.
+func newQueryGate(maxConcurrent, maxQueued int) *queryGate {
+	return &queryGate{
+		maxQueued: int64(maxQueued),
+		slots:     make(chan struct{}, maxConcurrent),
+	}
+}
+
+// Start waits until the query can be executed. Done must be called once it
+// is finished if no error is returned.
+func (g *queryGate) Start(ctx context.Context) error {
+	select {
+	case g.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	queued := atomic.AddInt64(&g.queued, 1)
+	defer atomic.AddInt64(&g.queued, -1)
+	if g.maxQueued >= 0 && queued > g.maxQueued {
+		return ErrTooManyQueries("query queue")
+	}
+	select {
+	case g.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return contextErr(ctx.Err(), "query queue")
+	}
+}
+
+// queueLength returns the number of queries waiting to be executed.
+func (g *queryGate) queueLength() int64 {
+	return atomic.LoadInt64(&g.queued)
+}
+
+// Done frees the slot of a query.
+func (g *queryGate) Done() {
+	<-g.slots
+}
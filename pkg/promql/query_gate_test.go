@@ -0,0 +1,75 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package promql
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestQueryGate(t *testing.T) {
+	gate := newQueryGate(1, 1)
+	if err := gate.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the second query waits for the first one
+	started := make(chan error)
+	go func() {
+		started <- gate.Start(context.Background())
+	}()
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(time.Millisecond) {
+		if gate.queueLength() == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the second query isn't queued")
+		}
+	}
+
+	// the queue is full
+	if err := gate.Start(context.Background()); err != ErrTooManyQueries("query queue") {
+		t.Errorf("unexpected error for a full queue: %v", err)
+	}
+
+	gate.Done()
+	if err := <-started; err != nil {
+		t.Fatal(err)
+	}
+
+	// queued queries stop waiting when they are canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := gate.Start(ctx); err != ErrQueryCanceled("query queue") {
+		t.Errorf("unexpected error for a canceled query: %v", err)
+	}
+	gate.Done()
+	if gate.queueLength() != 0 {
+		t.Errorf("unexpected queue length: %d", gate.queueLength())
+	}
+}
+
+func TestEngineQueryGate(t *testing.T) {
+	engine := NewEngine(EngineOpts{
+		MaxSamples:           10,
+		Timeout:              10 * time.Second,
+		MaxConcurrentQueries: 1,
+		MaxQueuedQueries:     0,
+	})
+	// a query holding the only slot
+	if err := engine.gate.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer engine.gate.Done()
+
+	q, err := engine.NewInstantQuery(nil, "1", time.Unix(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := q.Exec(context.Background()); res.Err != ErrTooManyQueries("query queue") {
+		t.Errorf("unexpected result: %v", res.Err)
+	}
+}
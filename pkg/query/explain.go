@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+// Explain returns the SQL statements run for every selector of the query qs,
+// without evaluating it. The query is a range query if step isn't 0. With
+// analyze, the statements are run with EXPLAIN (ANALYZE, BUFFERS).
+func Explain(ctx context.Context, engine *promql.Engine, q *Queryable, qs string, start, end time.Time, step time.Duration, analyze bool) ([]pgmodel.SelectorPlan, error) {
+	explaining := &explainQueryable{q: q, analyze: analyze, plans: []pgmodel.SelectorPlan{}}
+	if err := engine.DryRun(ctx, explaining, qs, start, end, step); err != nil {
+		return nil, err
+	}
+	return explaining.plans, explaining.err
+}
+
+// explainQueryable hands out queriers recording the plan of every select
+// instead of selecting series.
+type explainQueryable struct {
+	q       *Queryable
+	analyze bool
+	plans   []pgmodel.SelectorPlan
+	// err is the first error explaining a select
+	err error
+}
+
+func (e *explainQueryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
+	explainer, ok := e.q.pgQuerier(ctx).(pgmodel.Explainer)
+	if !ok {
+		return nil, fmt.Errorf("the querier can't explain queries")
+	}
+	return &explainQuerier{ctx: ctx, explainer: explainer, queryable: e, mint: mint, maxt: maxt}, nil
+}
+
+type explainQuerier struct {
+	ctx        context.Context
+	explainer  pgmodel.Explainer
+	queryable  *explainQueryable
+	mint, maxt int64
+}
+
+func (q *explainQuerier) LabelValues(string) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (q *explainQuerier) LabelNames() ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (q *explainQuerier) Close() error {
+	return nil
+}
+
+func (q *explainQuerier) Select(_ bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	plan, topNode, err := q.explainer.ExplainSelect(q.ctx, q.mint, q.maxt, hints, path, q.queryable.analyze, matchers...)
+	if err != nil {
+		if q.queryable.err == nil {
+			q.queryable.err = err
+		}
+		return storage.EmptySeriesSet(), nil
+	}
+	q.queryable.plans = append(q.queryable.plans, plan)
+	return storage.EmptySeriesSet(), topNode
+}
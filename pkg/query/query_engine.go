@@ -1,7 +1,6 @@
 package query
 
 import (
-	"math"
 	"time"
 
 	"github.com/go-kit/kit/log"
@@ -9,16 +8,64 @@ import (
 	"github.com/timescale/promscale/pkg/promql"
 )
 
-func NewEngine(logger log.Logger, queryTimeout time.Duration) *promql.Engine {
-	return promql.NewEngine(
+const (
+	DefaultQueryTimeout  = time.Minute
+	DefaultMaxSamples    = 50000000
+	DefaultMaxConcurrent = 20
+	DefaultMaxQueued     = 100
+)
+
+// EngineConfig bounds the resources used by the PromQL queries.
+type EngineConfig struct {
+	// Timeout is the maximum time a query may take, including the time it
+	// waits in the queue.
+	Timeout time.Duration
+	// MaxSamples is the maximum number of samples a query may load into
+	// memory at once.
+	MaxSamples int
+	// MaxConcurrent is the maximum number of queries executed at once, no
+	// limit is enforced if it is 0. At most MaxQueued queries wait for their
+	// turn, the others are rejected.
+	MaxConcurrent int
+	MaxQueued     int
+	// ActiveQueryLogDir, if set, is the directory of the log of the queries
+	// being executed, which are reported on startup if the connector didn't
+	// stop cleanly. It requires MaxConcurrent to be set.
+	ActiveQueryLogDir string
+	// SlowQueryThreshold, if set, is the execution time over which queries
+	// are logged, with their SQL statements.
+	SlowQueryThreshold time.Duration
+}
+
+// NewEngine returns a PromQL engine enforcing the limits of cfg. The default
+// timeout and max samples are used if they aren't set.
+func NewEngine(logger log.Logger, cfg EngineConfig) *promql.Engine {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultQueryTimeout
+	}
+	if cfg.MaxSamples <= 0 {
+		cfg.MaxSamples = DefaultMaxSamples
+	}
+	var tracker *promql.ActiveQueryTracker
+	if cfg.ActiveQueryLogDir != "" && cfg.MaxConcurrent > 0 {
+		tracker = promql.NewActiveQueryTracker(cfg.ActiveQueryLogDir, cfg.MaxConcurrent, logger)
+	}
+	engine := promql.NewEngine(
 		promql.EngineOpts{
 			Logger:                   logger,
 			Reg:                      prometheus.NewRegistry(),
-			MaxSamples:               math.MaxInt32,
-			Timeout:                  queryTimeout,
+			MaxSamples:               cfg.MaxSamples,
+			Timeout:                  cfg.Timeout,
+			MaxConcurrentQueries:     cfg.MaxConcurrent,
+			MaxQueuedQueries:         cfg.MaxQueued,
+			ActiveQueryTracker:       tracker,
 			NoStepSubqueryIntervalFn: func(int64) int64 { return durationMilliseconds(1 * time.Minute) },
 		},
 	)
+	if cfg.SlowQueryThreshold > 0 {
+		engine.SetQueryLogger(newSlowQueryLogger(logger, cfg.SlowQueryThreshold))
+	}
+	return engine
 }
 
 func durationMilliseconds(d time.Duration) int64 {
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+func TestNewEngineActiveQueryLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "active_query_log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	engine := NewEngine(log.NewNopLogger(), EngineConfig{MaxConcurrent: 2, ActiveQueryLogDir: dir})
+	if _, err = os.Stat(filepath.Join(dir, "queries.active")); err != nil {
+		t.Fatalf("the active query log wasn't created: %v", err)
+	}
+
+	test, err := promql.NewTest(t, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+	q, err := engine.NewInstantQuery(test.Queryable(), "1", time.Unix(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := q.Exec(context.Background()); res.Err != nil {
+		t.Fatal(res.Err)
+	}
+}
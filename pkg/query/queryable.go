@@ -8,6 +8,7 @@ import (
 	"github.com/prometheus/prometheus/storage"
 	"github.com/timescale/promscale/pkg/pgmodel"
 	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
 )
 
 func NewQueryable(q pgmodel.Querier) *Queryable {
@@ -18,34 +19,67 @@ type Queryable struct {
 	q pgmodel.Querier
 }
 
+// Querier returns a querier for the time range, running its SQL statements
+// with ctx. If ctx carries a tenant, the querier only sees the series of that
+// tenant. If ctx carries query stats, the querier records its SQL statements
+// in them.
 func (q Queryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
-	return newQuerier(ctx, q.q, mint, maxt)
+	return newQuerier(ctx, q.pgQuerier(ctx), mint, maxt)
+}
+
+// pgQuerier returns the database querier for a query run with ctx.
+func (q Queryable) pgQuerier(ctx context.Context) pgmodel.Querier {
+	pgQuerier := q.q.WithContext(ctx)
+	if tenant := tenancy.FromContext(ctx); tenant != "" {
+		pgQuerier = pgQuerier.ForTenant(tenant)
+	}
+	if s := queryStatsFromContext(ctx); s != nil {
+		pgQuerier = pgQuerier.WithStats(s)
+	}
+	return pgQuerier
 }
 
 type querier struct {
 	ctx        context.Context
 	mint, maxt int64
 	pgQuerier  pgmodel.Querier
+	// series sets holding on to database resources, released on Close
+	openSets []closableSeriesSet
+}
+
+type closableSeriesSet interface {
+	storage.SeriesSet
+	Close()
 }
 
 func newQuerier(ctx context.Context, q pgmodel.Querier, mint, maxt int64) (*querier, error) {
-	return &querier{ctx, mint, maxt, q}, nil
+	return &querier{ctx: ctx, mint: mint, maxt: maxt, pgQuerier: q}, nil
 }
 
-func (q querier) LabelValues(name string) ([]string, storage.Warnings, error) {
+func (q *querier) LabelValues(name string) ([]string, storage.Warnings, error) {
 	lVals, err := q.pgQuerier.LabelValues(name)
 	return lVals, nil, err
 }
 
-func (q querier) LabelNames() ([]string, storage.Warnings, error) {
+func (q *querier) LabelNames() ([]string, storage.Warnings, error) {
 	lNames, err := q.pgQuerier.LabelNames()
 	return lNames, nil, err
 }
 
-func (q querier) Close() error {
+// Close releases any series set the engine did not iterate to the end,
+// e.g. because the query was canceled.
+func (q *querier) Close() error {
+	for _, s := range q.openSets {
+		s.Close()
+	}
+	q.openSets = nil
 	return nil
 }
 
-func (q querier) Select(sortSeries bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
-	return q.pgQuerier.Select(q.mint, q.maxt, sortSeries, hints, path, matchers...)
+func (q *querier) Select(sortSeries bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	ss, topNode := q.pgQuerier.Select(q.mint, q.maxt, sortSeries, hints, path, matchers...)
+	if c, ok := ss.(closableSeriesSet); ok {
+		q.openSets = append(q.openSets, c)
+	}
+	return ss, topNode
 }
@@ -0,0 +1,299 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+const (
+	DefaultResultsCacheSplitInterval = 24 * time.Hour
+	DefaultResultsCacheMaxFreshness  = 10 * time.Minute
+)
+
+var (
+	resultsCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "query_results_cache_hits_total",
+			Help:      "Total number of range query intervals read from the results cache.",
+		},
+	)
+	resultsCacheMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "query_results_cache_misses_total",
+			Help:      "Total number of cacheable range query intervals missing from the results cache.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(resultsCacheHits, resultsCacheMisses)
+}
+
+// CachedResult is the result of a range query over one interval.
+type CachedResult struct {
+	Matrix   promql.Matrix
+	Warnings storage.Warnings
+}
+
+// ResultsCacheBackend stores the cached results. The results it returns must
+// not be modified.
+type ResultsCacheBackend interface {
+	Get(key string) (*CachedResult, bool)
+	Set(key string, r *CachedResult)
+}
+
+// NewInProcessCacheBackend returns a backend keeping at most size results in
+// memory, evicting the least recently used ones.
+func NewInProcessCacheBackend(size uint64) ResultsCacheBackend {
+	return inProcessBackend{cache: clockcache.WithMax(size)}
+}
+
+type inProcessBackend struct {
+	cache *clockcache.Cache
+}
+
+func (b inProcessBackend) Get(key string) (*CachedResult, bool) {
+	r, ok := b.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return r.(*CachedResult), true
+}
+
+func (b inProcessBackend) Set(key string, r *CachedResult) {
+	b.cache.Insert(key, r)
+}
+
+// ResultsCache executes range queries split into step-aligned intervals, and
+// caches the results of the intervals that can't change anymore.
+type ResultsCache struct {
+	backend ResultsCacheBackend
+	// split is the length of the intervals, in milliseconds. The intervals
+	// are aligned on multiples of it.
+	split int64
+	// maxFreshness is how far back from now samples may still be ingested.
+	// Intervals ending within it are never cached.
+	maxFreshness time.Duration
+	now          func() time.Time
+
+	// the generations are part of the keys of the cached results, so that
+	// bumping them drops the results cached before
+	mu                sync.Mutex
+	generation        uint64
+	tenantGenerations map[string]uint64
+}
+
+func NewResultsCache(backend ResultsCacheBackend, splitInterval, maxFreshness time.Duration) *ResultsCache {
+	return &ResultsCache{
+		backend:           backend,
+		split:             durationMilliseconds(splitInterval),
+		maxFreshness:      maxFreshness,
+		now:               time.Now,
+		tenantGenerations: make(map[string]uint64),
+	}
+}
+
+// Invalidate drops the cached results of tenant that samples at or after
+// mint, in milliseconds, may contribute to, after such samples were changed
+// or removed. The results of every tenant are dropped if tenant is empty, and
+// the results cached for requests without a tenant, which read the series of
+// all the tenants, are dropped either way. Returns false if nothing was
+// dropped, as none of the results computed from such samples are cached.
+func (c *ResultsCache) Invalidate(tenant string, mint int64) bool {
+	// only the intervals ending before the max freshness are cached, and
+	// samples only contribute to results at or after their time
+	if mint > timestamp(c.now().Add(-c.maxFreshness)) {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if tenant == "" {
+		c.generation++
+		return true
+	}
+	c.tenantGenerations[tenant]++
+	c.tenantGenerations[""]++
+	return true
+}
+
+// keyPrefix returns the prefix of the keys of the results of the range
+// query qs with the given step, run for tenant.
+func (c *ResultsCache) keyPrefix(tenant, qs string, step int64) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return fmt.Sprintf("%d\xff%d\xff%s\xff%s\xff%d\xff", c.generation, c.tenantGenerations[tenant], tenant, qs, step)
+}
+
+// cacheInterval is a part of a range query: the evaluation timestamps from
+// first to last, in milliseconds.
+type cacheInterval struct {
+	first, last int64
+	// cacheable intervals hold all the evaluation timestamps of their split
+	// interval, and end before the max freshness.
+	cacheable bool
+	result    *CachedResult
+}
+
+// Exec executes the range query. The error is only set when the query can't
+// be created, execution errors are returned in the result like
+// promql.Query.Exec does.
+func (c *ResultsCache) Exec(ctx context.Context, engine *promql.Engine, q promql.Queryable, qs string, start, end time.Time, step time.Duration) (*promql.Result, error) {
+	stepMs := durationMilliseconds(step)
+	intervals := c.splitQuery(timestamp(start), timestamp(end), stepMs)
+	keyPrefix := c.keyPrefix(tenancy.FromContext(ctx), qs, stepMs)
+
+	for i := range intervals {
+		if !intervals[i].cacheable {
+			continue
+		}
+		key := fmt.Sprintf("%s%d\xff%d", keyPrefix, intervals[i].first, intervals[i].last)
+		if r, ok := c.backend.Get(key); ok {
+			resultsCacheHits.Inc()
+			intervals[i].result = r
+			continue
+		}
+		resultsCacheMisses.Inc()
+	}
+
+	// the consecutive intervals that weren't cached are executed at once
+	for i := 0; i < len(intervals); {
+		if intervals[i].result != nil {
+			i++
+			continue
+		}
+		j := i
+		for j < len(intervals) && intervals[j].result == nil {
+			j++
+		}
+		qry, err := engine.NewRangeQuery(q, qs, fromTimestamp(intervals[i].first), fromTimestamp(intervals[j-1].last), step)
+		if err != nil {
+			return nil, err
+		}
+		res := qry.Exec(ctx)
+		if res.Err != nil {
+			return res, nil
+		}
+		mat, ok := res.Value.(promql.Matrix)
+		if !ok {
+			return &promql.Result{Err: fmt.Errorf("unexpected range query result of type %s", res.Value.Type())}, nil
+		}
+		for k := i; k < j; k++ {
+			intervals[k].result = &CachedResult{
+				Matrix:   sliceMatrix(mat, intervals[k].first, intervals[k].last),
+				Warnings: res.Warnings,
+			}
+			if intervals[k].cacheable {
+				key := fmt.Sprintf("%s%d\xff%d", keyPrefix, intervals[k].first, intervals[k].last)
+				c.backend.Set(key, intervals[k].result)
+			}
+		}
+		i = j
+	}
+
+	return mergeResults(intervals), nil
+}
+
+// splitQuery splits the evaluation timestamps of a range query on the split
+// intervals.
+func (c *ResultsCache) splitQuery(start, end, step int64) []cacheInterval {
+	freshAfter := timestamp(c.now().Add(-c.maxFreshness))
+	var intervals []cacheInterval
+	for t := start; t <= end; {
+		splitStart := floorDiv(t, c.split) * c.split
+		splitEnd := splitStart + c.split
+		last := end
+		if splitEnd-1 < last {
+			last = splitEnd - 1
+		}
+		last = t + (last-t)/step*step
+		intervals = append(intervals, cacheInterval{
+			first:     t,
+			last:      last,
+			cacheable: t-step < splitStart && last+step >= splitEnd && last <= freshAfter,
+		})
+		t = last + step
+	}
+	return intervals
+}
+
+// sliceMatrix returns the points of mat from first to last, leaving out the
+// series without any.
+func sliceMatrix(mat promql.Matrix, first, last int64) promql.Matrix {
+	res := make(promql.Matrix, 0, len(mat))
+	for _, s := range mat {
+		from := sort.Search(len(s.Points), func(i int) bool { return s.Points[i].T >= first })
+		to := sort.Search(len(s.Points), func(i int) bool { return s.Points[i].T > last })
+		if from == to {
+			continue
+		}
+		points := make([]promql.Point, to-from)
+		copy(points, s.Points[from:to])
+		res = append(res, promql.Series{Metric: s.Metric, Points: points})
+	}
+	return res
+}
+
+// mergeResults concatenates the results of the intervals, in order.
+func mergeResults(intervals []cacheInterval) *promql.Result {
+	var (
+		series   = make(map[string]*promql.Series)
+		warnings storage.Warnings
+		seen     = make(map[string]bool)
+	)
+	for _, in := range intervals {
+		for _, s := range in.result.Matrix {
+			key := labelsKey(s.Metric)
+			merged, ok := series[key]
+			if !ok {
+				merged = &promql.Series{Metric: s.Metric}
+				series[key] = merged
+			}
+			merged.Points = append(merged.Points, s.Points...)
+		}
+		for _, w := range in.result.Warnings {
+			if !seen[w.Error()] {
+				seen[w.Error()] = true
+				warnings = append(warnings, w)
+			}
+		}
+	}
+
+	mat := make(promql.Matrix, 0, len(series))
+	for _, s := range series {
+		mat = append(mat, *s)
+	}
+	sort.Sort(mat)
+	return &promql.Result{Value: mat, Warnings: warnings}
+}
+
+func labelsKey(ls labels.Labels) string {
+	return string(ls.Bytes(nil))
+}
+
+func floorDiv(a, b int64) int64 {
+	if a < 0 && a%b != 0 {
+		return a/b - 1
+	}
+	return a / b
+}
+
+func timestamp(t time.Time) int64 {
+	return t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+}
+
+func fromTimestamp(t int64) time.Time {
+	return time.Unix(t/1000, (t%1000)*int64(time.Millisecond)).UTC()
+}
@@ -0,0 +1,169 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+// countingBackend counts the results read from and written to an in-process
+// backend.
+type countingBackend struct {
+	ResultsCacheBackend
+	hits, sets int
+}
+
+func (b *countingBackend) Get(key string) (*CachedResult, bool) {
+	r, ok := b.ResultsCacheBackend.Get(key)
+	if ok {
+		b.hits++
+	}
+	return r, ok
+}
+
+func (b *countingBackend) Set(key string, r *CachedResult) {
+	b.sets++
+	b.ResultsCacheBackend.Set(key, r)
+}
+
+func TestResultsCache(t *testing.T) {
+	test, err := promql.NewTest(t, `
+load 1m
+	foo{a="1"} 0+1x1000
+	foo{a="2"} 0+2x1000
+	bar{a="1"} 0+1x300
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+	if err = test.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &countingBackend{ResultsCacheBackend: NewInProcessCacheBackend(100)}
+	cache := NewResultsCache(backend, time.Hour, 10*time.Minute)
+	cache.now = func() time.Time { return time.Unix(0, 0).Add(10 * time.Hour) }
+
+	start := time.Unix(0, 0).Add(17 * time.Minute)
+	end := time.Unix(0, 0).Add(14 * time.Hour)
+	step := 7 * time.Minute
+
+	testCases := []struct {
+		name         string
+		query        string
+		ctx          context.Context
+		expectedHits int
+	}{
+		{name: "cold cache", query: "rate(foo[5m])", ctx: context.Background()},
+		{name: "warm cache", query: "rate(foo[5m])", ctx: context.Background(), expectedHits: 8},
+		{name: "series ending in the range", query: "bar + foo", ctx: context.Background()},
+		{name: "another tenant", query: "rate(foo[5m])", ctx: tenancy.WithTenant(context.Background(), "t1")},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			backend.hits, backend.sets = 0, 0
+			res, err := cache.Exec(c.ctx, test.QueryEngine(), test.Queryable(), c.query, start, end, step)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+
+			qry, err := test.QueryEngine().NewRangeQuery(test.Queryable(), c.query, start, end, step)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := qry.Exec(c.ctx)
+			if !reflect.DeepEqual(res.Value, expected.Value) {
+				t.Errorf("unexpected result:\ngot\n%v\nwanted\n%v", res.Value, expected.Value)
+			}
+
+			// hours 1 to 8 are complete and end before the max freshness
+			if backend.hits != c.expectedHits || backend.sets != 8-c.expectedHits {
+				t.Errorf("unexpected cache use: %d hits, %d sets", backend.hits, backend.sets)
+			}
+		})
+	}
+
+	if _, err = cache.Exec(context.Background(), test.QueryEngine(), test.Queryable(), "foo[5m]", start, end, step); err == nil {
+		t.Errorf("expected an error for an invalid range query")
+	}
+}
+
+func TestResultsCacheInvalidate(t *testing.T) {
+	test, err := promql.NewTest(t, `
+load 1m
+	foo{a="1"} 0+1x1000
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+	if err = test.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &countingBackend{ResultsCacheBackend: NewInProcessCacheBackend(100)}
+	cache := NewResultsCache(backend, time.Hour, 10*time.Minute)
+	now := time.Unix(0, 0).Add(10 * time.Hour)
+	cache.now = func() time.Time { return now }
+
+	start := time.Unix(0, 0)
+	end := time.Unix(0, 0).Add(14 * time.Hour)
+	noTenant := context.Background()
+	t1 := tenancy.WithTenant(context.Background(), "t1")
+	hits := func(ctx context.Context) int {
+		backend.hits = 0
+		if _, err := cache.Exec(ctx, test.QueryEngine(), test.Queryable(), "foo", start, end, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		return backend.hits
+	}
+	hits(noTenant)
+	hits(t1)
+
+	if cache.Invalidate("t1", timestamp(now.Add(-5*time.Minute))) {
+		t.Error("unexpected invalidation of samples newer than the max freshness")
+	}
+	if h := hits(t1); h != 9 {
+		t.Errorf("unexpected hits: %d", h)
+	}
+
+	// the results without a tenant hold the series of every tenant
+	if !cache.Invalidate("t2", 0) {
+		t.Error("expected an invalidation")
+	}
+	if h := hits(t1); h != 9 {
+		t.Errorf("unexpected hits of another tenant: %d", h)
+	}
+	if h := hits(noTenant); h != 0 {
+		t.Errorf("unexpected hits without a tenant: %d", h)
+	}
+
+	if !cache.Invalidate("", 0) {
+		t.Error("expected an invalidation")
+	}
+	if h := hits(t1); h != 0 {
+		t.Errorf("unexpected hits after invalidating every tenant: %d", h)
+	}
+}
+
+func TestSplitQuery(t *testing.T) {
+	cache := NewResultsCache(NewInProcessCacheBackend(0), 10*time.Millisecond, 0)
+	cache.now = func() time.Time { return time.Unix(0, 0).Add(time.Second) }
+
+	expected := []cacheInterval{
+		{first: 5, last: 8},
+		{first: 11, last: 17, cacheable: true},
+		{first: 20, last: 26},
+	}
+	if intervals := cache.splitQuery(5, 27, 3); !reflect.DeepEqual(intervals, expected) {
+		t.Errorf("unexpected intervals: %+v", intervals)
+	}
+}
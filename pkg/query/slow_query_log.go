@@ -0,0 +1,97 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/prometheus/util/stats"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+// sqlStatsField is the query log field holding the SQL statements of a query.
+const sqlStatsField = "sql"
+
+type queryStatsKey struct{}
+
+// WithQueryStats returns a context recording the SQL statements run by the
+// queries executed with it, so that they show in the slow query log.
+func WithQueryStats(ctx context.Context) context.Context {
+	s := &pgmodel.QueryStats{}
+	ctx = context.WithValue(ctx, queryStatsKey{}, s)
+	return promql.NewOriginContext(ctx, map[string]interface{}{sqlStatsField: s})
+}
+
+func queryStatsFromContext(ctx context.Context) *pgmodel.QueryStats {
+	s, _ := ctx.Value(queryStatsKey{}).(*pgmodel.QueryStats)
+	return s
+}
+
+// slowQueryLogger logs the queries taking at least threshold to execute,
+// with their SQL statements and the time spent in every phase.
+type slowQueryLogger struct {
+	logger    log.Logger
+	threshold time.Duration
+}
+
+func newSlowQueryLogger(logger log.Logger, threshold time.Duration) *slowQueryLogger {
+	return &slowQueryLogger{logger: logger, threshold: threshold}
+}
+
+// Log logs the fields of a query log entry of the engine, if the query was
+// slow.
+func (l *slowQueryLogger) Log(fields ...interface{}) error {
+	var (
+		queryStats *stats.QueryStats
+		sqlStats   *pgmodel.QueryStats
+		params     map[string]interface{}
+		rest       []interface{}
+	)
+	for i := 0; i+1 < len(fields); i += 2 {
+		switch v := fields[i+1].(type) {
+		case *stats.QueryStats:
+			queryStats = v
+		case *pgmodel.QueryStats:
+			sqlStats = v
+		case map[string]interface{}:
+			params = v
+		default:
+			rest = append(rest, fields[i], v)
+		}
+	}
+	if queryStats == nil || seconds(queryStats.Timings.ExecTotalTime) < l.threshold {
+		return nil
+	}
+
+	entry := []interface{}{"msg", "Slow query"}
+	for _, p := range []string{"query", "start", "end", "step"} {
+		if v, ok := params[p]; ok {
+			entry = append(entry, p, v)
+		}
+	}
+	t := queryStats.Timings
+	entry = append(entry,
+		"exec_total_time", seconds(t.ExecTotalTime),
+		"exec_queue_time", seconds(t.ExecQueueTime),
+		"eval_total_time", seconds(t.EvalTotalTime),
+		"query_preparation_time", seconds(t.QueryPreparationTime),
+		"inner_eval_time", seconds(t.InnerEvalTime),
+		"result_sort_time", seconds(t.ResultSortTime),
+	)
+	if sqlStats != nil {
+		statements := sqlStats.Statements()
+		entry = append(entry, "sql_statements", len(statements), "sql_rows", sqlStats.Rows(), "sql", statements)
+	}
+	entry = append(entry, rest...)
+	return level.Warn(l.logger).Log(entry...)
+}
+
+func (l *slowQueryLogger) Close() error {
+	return nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/prometheus/util/stats"
+)
+
+func TestSlowQueryLogger(t *testing.T) {
+	ctx := WithQueryStats(context.Background())
+	sqlStats := queryStatsFromContext(ctx)
+	if sqlStats == nil {
+		t.Fatal("the context holds no query stats")
+	}
+
+	queryStats := func(execTotal float64) *stats.QueryStats {
+		s := &stats.QueryStats{}
+		s.Timings.ExecTotalTime = execTotal
+		return s
+	}
+	params := map[string]interface{}{"query": "rate(foo[5m])", "start": "2020-01-01T00:00:00Z"}
+
+	var buf bytes.Buffer
+	logger := newSlowQueryLogger(log.NewLogfmtLogger(&buf), time.Second)
+	if err := logger.Log("params", params, "stats", queryStats(0.5), sqlStatsField, sqlStats); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("fast query logged: %s", buf.String())
+	}
+
+	if err := logger.Log("params", params, "stats", queryStats(2), sqlStatsField, sqlStats, "spanID", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	entry := buf.String()
+	for _, expected := range []string{
+		`msg="Slow query"`,
+		`query=rate(foo[5m])`,
+		`start=2020-01-01T00:00:00Z`,
+		`exec_total_time=2s`,
+		`sql_statements=0`,
+		`sql_rows=0`,
+		`spanID=abc`,
+	} {
+		if !strings.Contains(entry, expected) {
+			t.Errorf("%s missing from the log entry %s", expected, entry)
+		}
+	}
+}
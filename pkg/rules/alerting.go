@@ -0,0 +1,267 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+const (
+	// alertMetricName is the metric the state of active alerts is stored in.
+	alertMetricName = "ALERTS"
+	alertNameLabel  = "alertname"
+	alertStateLabel = "alertstate"
+
+	// resolvedRetention is how long resolved alerts are kept around.
+	resolvedRetention = 15 * time.Minute
+)
+
+// AlertState is the state of an alert.
+type AlertState int
+
+const (
+	// StateInactive is the state of a resolved alert.
+	StateInactive AlertState = iota
+	// StatePending is the state of an alert that has been active for less
+	// than the hold duration of its rule.
+	StatePending
+	// StateFiring is the state of an alert that has been active for longer
+	// than the hold duration of its rule.
+	StateFiring
+)
+
+func (s AlertState) String() string {
+	switch s {
+	case StateInactive:
+		return "inactive"
+	case StatePending:
+		return "pending"
+	case StateFiring:
+		return "firing"
+	}
+	panic(fmt.Errorf("unknown alert state: %d", s))
+}
+
+// Alert is an alert raised by an alerting rule for one of the series its
+// expression returned.
+type Alert struct {
+	State       AlertState
+	Labels      labels.Labels
+	Annotations labels.Labels
+	// Value is the value of the series when the rule was last evaluated.
+	Value      float64
+	ActiveAt   time.Time
+	FiredAt    time.Time
+	ResolvedAt time.Time
+	// LastSentAt is when the alert was last sent to Alertmanager, and
+	// ValidUntil when Alertmanager considers it resolved unless it is sent
+	// again.
+	LastSentAt time.Time
+	ValidUntil time.Time
+}
+
+// needsSending reports if a has to be sent to Alertmanager at ts: firing
+// alerts are resent every resendDelay, resolved ones once.
+func (a *Alert) needsSending(ts time.Time, resendDelay time.Duration) bool {
+	if a.State == StatePending {
+		return false
+	}
+	if a.ResolvedAt.After(a.LastSentAt) {
+		return true
+	}
+	return a.State == StateFiring && !a.LastSentAt.Add(resendDelay).After(ts)
+}
+
+// AlertingRule raises an alert for every series its expression returns for
+// longer than the hold duration.
+type AlertingRule struct {
+	ruleStatus
+	name         string
+	expr         parser.Expr
+	holdDuration time.Duration
+	labels       labels.Labels
+	annotations  labels.Labels
+
+	alertsMtx sync.Mutex
+	// active alerts by the hash of their labels
+	active map[uint64]*Alert
+}
+
+func newAlertingRule(name string, expr parser.Expr, hold time.Duration, lset, annotations labels.Labels) *AlertingRule {
+	return &AlertingRule{
+		name:         name,
+		expr:         expr,
+		holdDuration: hold,
+		labels:       lset,
+		annotations:  annotations,
+		active:       make(map[uint64]*Alert),
+	}
+}
+
+func (r *AlertingRule) Name() string                { return r.name }
+func (r *AlertingRule) Query() string               { return r.expr.String() }
+func (r *AlertingRule) Labels() labels.Labels       { return r.labels }
+func (r *AlertingRule) Annotations() labels.Labels  { return r.annotations }
+func (r *AlertingRule) HoldDuration() time.Duration { return r.holdDuration }
+
+// Eval evaluates the expression of the rule, updating the state of its
+// alerts. It returns the ALERTS series of the pending and firing alerts.
+func (r *AlertingRule) Eval(ctx context.Context, ts time.Time, query QueryFunc) (promql.Vector, error) {
+	res, err := query(ctx, r.Query(), ts)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make(map[uint64]*Alert, len(res))
+	for _, smpl := range res {
+		lb := labels.NewBuilder(smpl.Metric).Del(labels.MetricName)
+		for _, l := range r.labels {
+			v, err := expandTemplate(r.name, l.Value, smpl.Metric, smpl.V)
+			if err != nil {
+				log.Warn("msg", "error expanding alert label template", "rule", r.name, "label", l.Name, "err", err)
+			}
+			lb.Set(l.Name, v)
+		}
+		lb.Set(alertNameLabel, r.name)
+
+		annotations := make(labels.Labels, 0, len(r.annotations))
+		for _, a := range r.annotations {
+			v, err := expandTemplate(r.name, a.Value, smpl.Metric, smpl.V)
+			if err != nil {
+				log.Warn("msg", "error expanding alert annotation template", "rule", r.name, "annotation", a.Name, "err", err)
+			}
+			annotations = append(annotations, labels.Label{Name: a.Name, Value: v})
+		}
+
+		lset := lb.Labels()
+		h := lset.Hash()
+		if _, ok := alerts[h]; ok {
+			return nil, fmt.Errorf("vector contains metrics with the same labelset after applying alert labels")
+		}
+		alerts[h] = &Alert{
+			Labels:      lset,
+			Annotations: annotations,
+			Value:       smpl.V,
+			ActiveAt:    ts,
+			State:       StatePending,
+		}
+	}
+
+	r.alertsMtx.Lock()
+	defer r.alertsMtx.Unlock()
+
+	for h, a := range alerts {
+		if existing, ok := r.active[h]; ok && existing.State != StateInactive {
+			existing.Value = a.Value
+			existing.Annotations = a.Annotations
+			continue
+		}
+		r.active[h] = a
+	}
+
+	var vec promql.Vector
+	for h, a := range r.active {
+		if _, ok := alerts[h]; !ok {
+			// the alert is no longer active, pending alerts are forgotten
+			// right away while firing ones are kept as resolved for a while
+			if a.State == StatePending || (!a.ResolvedAt.IsZero() && ts.Sub(a.ResolvedAt) > resolvedRetention) {
+				delete(r.active, h)
+			}
+			if a.State != StateInactive {
+				a.State = StateInactive
+				a.ResolvedAt = ts
+			}
+			continue
+		}
+		if a.State == StatePending && ts.Sub(a.ActiveAt) >= r.holdDuration {
+			a.State = StateFiring
+			a.FiredAt = ts
+		}
+		vec = append(vec, r.alertSample(a, ts))
+	}
+	return vec, nil
+}
+
+// alertSample returns the sample of the ALERTS series of a.
+func (r *AlertingRule) alertSample(a *Alert, ts time.Time) promql.Sample {
+	lb := labels.NewBuilder(a.Labels)
+	lb.Set(labels.MetricName, alertMetricName)
+	lb.Set(alertStateLabel, a.State.String())
+	return promql.Sample{
+		Metric: lb.Labels(),
+		Point:  promql.Point{T: ts.UnixNano() / int64(time.Millisecond), V: 1},
+	}
+}
+
+// ActiveAlerts returns a copy of the pending and firing alerts of the rule.
+func (r *AlertingRule) ActiveAlerts() []*Alert {
+	r.alertsMtx.Lock()
+	defer r.alertsMtx.Unlock()
+	alerts := make([]*Alert, 0, len(r.active))
+	for _, a := range r.active {
+		if a.State != StateInactive {
+			c := *a
+			alerts = append(alerts, &c)
+		}
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		return labels.Compare(alerts[i].Labels, alerts[j].Labels) < 0
+	})
+	return alerts
+}
+
+// State returns the highest state of the alerts of the rule.
+func (r *AlertingRule) State() AlertState {
+	r.alertsMtx.Lock()
+	defer r.alertsMtx.Unlock()
+	state := StateInactive
+	for _, a := range r.active {
+		if a.State > state {
+			state = a.State
+		}
+	}
+	return state
+}
+
+// sendAlerts notifies the alerts of the rule that need sending at ts. Firing
+// alerts are valid until a few evaluations or resends are missed.
+func (r *AlertingRule) sendAlerts(ctx context.Context, ts time.Time, resendDelay, interval time.Duration, notify NotifyFunc) {
+	r.alertsMtx.Lock()
+	defer r.alertsMtx.Unlock()
+
+	delta := resendDelay
+	if interval > resendDelay {
+		delta = interval
+	}
+	var alerts []*Alert
+	for _, a := range r.active {
+		if !a.needsSending(ts, resendDelay) {
+			continue
+		}
+		a.LastSentAt = ts
+		a.ValidUntil = ts.Add(4 * delta)
+		c := *a
+		alerts = append(alerts, &c)
+	}
+	if len(alerts) > 0 {
+		notify(ctx, r.Query(), alerts...)
+	}
+}
+
+// reset forgets all the alerts of the rule.
+func (r *AlertingRule) reset() {
+	r.alertsMtx.Lock()
+	defer r.alertsMtx.Unlock()
+	r.active = make(map[uint64]*Alert)
+}
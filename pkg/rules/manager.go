@@ -0,0 +1,324 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package rules evaluates Prometheus recording and alerting rules against the
+// data stored in TimescaleDB.
+package rules
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/value"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+// DefaultEvaluationInterval is the evaluation interval of rule groups that
+// don't set one.
+const DefaultEvaluationInterval = time.Minute
+
+// Options configures a Manager.
+type Options struct {
+	// QueryFunc evaluates the expressions of the rules.
+	QueryFunc QueryFunc
+	// Writer stores the results of the rules.
+	Writer pgmodel.DBInserter
+	// Elector decides which connector evaluates the rules when several of
+	// them share a database. Every connector evaluates the rules if nil.
+	Elector *util.Elector
+	// EvaluationInterval is the default interval of the rule groups.
+	EvaluationInterval time.Duration
+	// NotifyFunc sends the firing and resolved alerts, alerts aren't sent
+	// anywhere if nil.
+	NotifyFunc NotifyFunc
+	// ResendDelay is the minimum delay before a firing alert is sent again.
+	ResendDelay time.Duration
+}
+
+// Manager evaluates groups of rules periodically.
+type Manager struct {
+	opts   Options
+	groups []*Group
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewManager returns a Manager for the rule groups of the files matching the
+// patterns.
+func NewManager(patterns []string, opts Options) (*Manager, error) {
+	if opts.EvaluationInterval <= 0 {
+		opts.EvaluationInterval = DefaultEvaluationInterval
+	}
+	if opts.ResendDelay <= 0 {
+		opts.ResendDelay = DefaultResendDelay
+	}
+	groups, err := loadGroups(patterns, opts.EvaluationInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{opts: opts, groups: groups}, nil
+}
+
+// Run starts evaluating the rule groups, until Stop is called.
+func (m *Manager) Run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	for _, g := range m.groups {
+		m.wg.Add(1)
+		go func(g *Group) {
+			defer m.wg.Done()
+			g.run(ctx, &m.opts)
+		}(g)
+	}
+	log.Info("msg", "Started rule evaluation", "groups", len(m.groups))
+}
+
+// Stop stops evaluating the rule groups, waiting for the ongoing evaluations
+// to finish. The series the rules returned on their last evaluation are
+// marked stale, as the rules may be gone when the connector starts again.
+func (m *Manager) Stop() {
+	if m.cancel == nil {
+		return
+	}
+	m.cancel()
+	m.wg.Wait()
+	if !shouldEvaluate(m.opts.Elector) {
+		return
+	}
+	now := time.Now()
+	for _, g := range m.groups {
+		g.markStale(now, &m.opts)
+	}
+}
+
+// RuleGroups returns the rule groups of the manager. A nil Manager has no
+// rules.
+func (m *Manager) RuleGroups() []*Group {
+	if m == nil {
+		return nil
+	}
+	return m.groups
+}
+
+// AlertingRules returns the alerting rules of all the groups.
+func (m *Manager) AlertingRules() []*AlertingRule {
+	if m == nil {
+		return nil
+	}
+	var rules []*AlertingRule
+	for _, g := range m.groups {
+		for _, r := range g.rules {
+			if ar, ok := r.(*AlertingRule); ok {
+				rules = append(rules, ar)
+			}
+		}
+	}
+	return rules
+}
+
+// Group is a set of rules evaluated in order at the same interval.
+type Group struct {
+	name     string
+	file     string
+	interval time.Duration
+	// tenant, if set, is the tenant whose series the rules are evaluated
+	// over and stored for.
+	tenant string
+	rules  []Rule
+	// the series returned by each rule on its last evaluation, by their
+	// labels, so that the ones that disappear are marked stale
+	seriesReturned []map[string]labels.Labels
+
+	mtx          sync.Mutex
+	lastEval     time.Time
+	evalDuration time.Duration
+}
+
+func (g *Group) Name() string            { return g.name }
+func (g *Group) File() string            { return g.file }
+func (g *Group) Interval() time.Duration { return g.interval }
+func (g *Group) Tenant() string          { return g.tenant }
+func (g *Group) Rules() []Rule           { return g.rules }
+
+func (g *Group) LastEvaluation() time.Time {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.lastEval
+}
+
+func (g *Group) EvaluationDuration() time.Duration {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.evalDuration
+}
+
+func (g *Group) run(ctx context.Context, opts *Options) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			if !shouldEvaluate(opts.Elector) {
+				// the new leader starts over, so that we don't report
+				// stale alerts
+				g.resetAlerts()
+				continue
+			}
+			g.eval(ctx, ts, opts)
+		}
+	}
+}
+
+func shouldEvaluate(elector *util.Elector) bool {
+	if elector == nil {
+		return true
+	}
+	isLeader, err := elector.IsLeader()
+	if err != nil {
+		log.Error("msg", "IsLeader check failed, skipping rule evaluation", "err", err)
+		return false
+	}
+	return isLeader
+}
+
+func (g *Group) resetAlerts() {
+	for _, r := range g.rules {
+		if ar, ok := r.(*AlertingRule); ok {
+			ar.reset()
+		}
+	}
+}
+
+// eval evaluates the rules of the group in order, storing the results of
+// each rule before evaluating the next so that later rules can use them.
+// The series a rule no longer returns are marked stale.
+func (g *Group) eval(ctx context.Context, ts time.Time, opts *Options) {
+	start := time.Now()
+	query := opts.QueryFunc
+	if g.tenant != "" {
+		query = tenantQueryFunc(g.tenant, query)
+	}
+	if g.seriesReturned == nil {
+		g.seriesReturned = make([]map[string]labels.Labels, len(g.rules))
+	}
+	for i, r := range g.rules {
+		ruleStart := time.Now()
+		ruleEvaluations.Inc()
+		vector, err := r.Eval(ctx, ts, query)
+		if ar, ok := r.(*AlertingRule); ok && err == nil && opts.NotifyFunc != nil {
+			ar.sendAlerts(ctx, ts, opts.ResendDelay, g.interval, opts.NotifyFunc)
+		}
+		if err == nil {
+			vector = append(vector, g.staleSamples(i, vector, ts)...)
+			if len(vector) > 0 {
+				err = store(opts.Writer, g.tenant, vector)
+			}
+		}
+		if err != nil {
+			ruleEvaluationFailures.Inc()
+			log.Warn("msg", "error evaluating rule", "group", g.name, "rule", r.Name(), "err", err)
+		}
+		duration := time.Since(ruleStart)
+		ruleEvaluationDuration.Observe(duration.Seconds())
+		r.setEvaluation(ts, duration, err)
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.lastEval = ts
+	g.evalDuration = time.Since(start)
+}
+
+// staleSamples records the series returned by the i-th rule at ts, returning
+// stale markers for the series it returned on its previous evaluation but not
+// anymore, e.g. those of resolved alerts.
+func (g *Group) staleSamples(i int, vector promql.Vector, ts time.Time) promql.Vector {
+	returned := make(map[string]labels.Labels, len(vector))
+	for _, s := range vector {
+		returned[s.Metric.String()] = s.Metric
+	}
+	var stale promql.Vector
+	for key, lset := range g.seriesReturned[i] {
+		if _, ok := returned[key]; !ok {
+			stale = append(stale, staleSample(lset, ts))
+		}
+	}
+	g.seriesReturned[i] = returned
+	return stale
+}
+
+// markStale stores stale markers at ts for all the series the rules of the
+// group returned on their last evaluation.
+func (g *Group) markStale(ts time.Time, opts *Options) {
+	var stale promql.Vector
+	for _, returned := range g.seriesReturned {
+		for _, lset := range returned {
+			stale = append(stale, staleSample(lset, ts))
+		}
+	}
+	g.seriesReturned = nil
+	if len(stale) == 0 {
+		return
+	}
+	if err := store(opts.Writer, g.tenant, stale); err != nil {
+		log.Warn("msg", "error marking the series of the rules stale", "group", g.name, "err", err)
+	}
+}
+
+func staleSample(lset labels.Labels, ts time.Time) promql.Sample {
+	return promql.Sample{
+		Metric: lset,
+		Point:  promql.Point{T: ts.UnixNano() / int64(time.Millisecond), V: math.Float64frombits(value.StaleNaN)},
+	}
+}
+
+// tenantQueryFunc returns a QueryFunc evaluating queries with query over the
+// series of tenant only. The results hold the label of the tenant, so that the
+// series and alerts of the rules belong to it.
+func tenantQueryFunc(tenant string, query QueryFunc) QueryFunc {
+	return func(ctx context.Context, q string, t time.Time) (promql.Vector, error) {
+		vector, err := query(tenancy.WithTenant(ctx, tenant), q, t)
+		if err != nil {
+			return nil, err
+		}
+		for i := range vector {
+			vector[i].Metric = labels.NewBuilder(vector[i].Metric).Set(pgmodel.TenantLabelName, tenant).Labels()
+		}
+		return vector, nil
+	}
+}
+
+// store writes the samples of vector through writer, for tenant if it is set.
+func store(writer pgmodel.DBInserter, tenant string, vector promql.Vector) error {
+	req := pgmodel.NewWriteRequest()
+	for _, s := range vector {
+		lset := s.Metric
+		if tenant != "" {
+			// the labels of the rules can't move the results to another
+			// tenant
+			lset = labels.NewBuilder(lset).Set(pgmodel.TenantLabelName, tenant).Labels()
+		}
+		ts := prompb.TimeSeries{
+			Labels:  make([]prompb.Label, 0, len(lset)),
+			Samples: []prompb.Sample{{Timestamp: s.T, Value: s.V}},
+		}
+		for _, l := range lset {
+			ts.Labels = append(ts.Labels, prompb.Label{Name: l.Name, Value: l.Value})
+		}
+		req.Timeseries = append(req.Timeseries, ts)
+	}
+	_, err := writer.IngestForTenant(tenant, req.Timeseries, req)
+	return err
+}
@@ -0,0 +1,43 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+var (
+	ruleEvaluations = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "rule_evaluations_total",
+			Help:      "Total number of rule evaluations",
+		},
+	)
+	ruleEvaluationFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "rule_evaluation_failures_total",
+			Help:      "Total number of rule evaluations that failed",
+		},
+	)
+	ruleEvaluationDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: util.PromNamespace,
+			Name:      "rule_evaluation_duration_seconds",
+			Help:      "Duration of rule evaluations, including storing their results",
+			Buckets:   prometheus.DefBuckets,
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		ruleEvaluations,
+		ruleEvaluationFailures,
+		ruleEvaluationDuration,
+	)
+}
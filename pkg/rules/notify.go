@@ -0,0 +1,45 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"context"
+	"time"
+
+	"github.com/timescale/promscale/pkg/notifier"
+)
+
+// DefaultResendDelay is the minimum delay before a firing alert is sent to
+// Alertmanager again.
+const DefaultResendDelay = time.Minute
+
+// NotifyFunc sends the alerts raised by the rule with expression expr.
+type NotifyFunc func(ctx context.Context, expr string, alerts ...*Alert)
+
+// Sender queues alerts to be sent to Alertmanager.
+type Sender interface {
+	Send(alerts ...*notifier.Alert)
+}
+
+// SendAlerts returns a NotifyFunc sending the alerts through s.
+func SendAlerts(s Sender) NotifyFunc {
+	return func(_ context.Context, _ string, alerts ...*Alert) {
+		res := make([]*notifier.Alert, 0, len(alerts))
+		for _, a := range alerts {
+			na := &notifier.Alert{
+				Labels:      a.Labels,
+				Annotations: a.Annotations,
+				StartsAt:    a.FiredAt,
+			}
+			if !a.ResolvedAt.IsZero() {
+				na.EndsAt = a.ResolvedAt
+			} else {
+				na.EndsAt = a.ValidUntil
+			}
+			res = append(res, na)
+		}
+		s.Send(res...)
+	}
+}
@@ -0,0 +1,156 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+// RuleHealth describes the result of the last evaluation of a rule.
+type RuleHealth string
+
+const (
+	HealthUnknown RuleHealth = "unknown"
+	HealthGood    RuleHealth = "ok"
+	HealthBad     RuleHealth = "err"
+)
+
+// QueryFunc evaluates the instant query q at t.
+type QueryFunc func(ctx context.Context, q string, t time.Time) (promql.Vector, error)
+
+// EngineQueryFunc returns a QueryFunc evaluating queries with engine over q.
+func EngineQueryFunc(engine *promql.Engine, q promql.Queryable) QueryFunc {
+	return func(ctx context.Context, qs string, t time.Time) (promql.Vector, error) {
+		query, err := engine.NewInstantQuery(q, qs, t)
+		if err != nil {
+			return nil, err
+		}
+		defer query.Close()
+		res := query.Exec(ctx)
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		switch v := res.Value.(type) {
+		case promql.Vector:
+			return v, nil
+		case promql.Scalar:
+			return promql.Vector{promql.Sample{
+				Point:  promql.Point(v),
+				Metric: labels.Labels{},
+			}}, nil
+		default:
+			return nil, errors.New("rule result is not a vector or scalar")
+		}
+	}
+}
+
+// Rule is a recording or alerting rule.
+type Rule interface {
+	Name() string
+	// Query returns the PromQL expression of the rule.
+	Query() string
+	Labels() labels.Labels
+	// Eval evaluates the rule at ts, returning the samples to store.
+	Eval(ctx context.Context, ts time.Time, query QueryFunc) (promql.Vector, error)
+
+	Health() RuleHealth
+	LastError() error
+	LastEvaluation() time.Time
+	EvaluationDuration() time.Duration
+	setEvaluation(ts time.Time, d time.Duration, err error)
+}
+
+// ruleStatus records the outcome of the last evaluation of a rule.
+type ruleStatus struct {
+	mtx          sync.Mutex
+	health       RuleHealth
+	lastError    error
+	lastEval     time.Time
+	evalDuration time.Duration
+}
+
+func (s *ruleStatus) setEvaluation(ts time.Time, d time.Duration, err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.lastEval = ts
+	s.evalDuration = d
+	s.lastError = err
+	s.health = HealthGood
+	if err != nil {
+		s.health = HealthBad
+	}
+}
+
+func (s *ruleStatus) Health() RuleHealth {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.health == "" {
+		return HealthUnknown
+	}
+	return s.health
+}
+
+func (s *ruleStatus) LastError() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.lastError
+}
+
+func (s *ruleStatus) LastEvaluation() time.Time {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.lastEval
+}
+
+func (s *ruleStatus) EvaluationDuration() time.Duration {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.evalDuration
+}
+
+// RecordingRule stores the result of its expression as a new metric.
+type RecordingRule struct {
+	ruleStatus
+	name   string
+	expr   parser.Expr
+	labels labels.Labels
+}
+
+func newRecordingRule(name string, expr parser.Expr, lset labels.Labels) *RecordingRule {
+	return &RecordingRule{name: name, expr: expr, labels: lset}
+}
+
+func (r *RecordingRule) Name() string          { return r.name }
+func (r *RecordingRule) Query() string         { return r.expr.String() }
+func (r *RecordingRule) Labels() labels.Labels { return r.labels }
+
+// Eval evaluates the expression of the rule, naming the result after the rule
+// and adding its labels.
+func (r *RecordingRule) Eval(ctx context.Context, ts time.Time, query QueryFunc) (promql.Vector, error) {
+	vector, err := query(ctx, r.Query(), ts)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vector {
+		lb := labels.NewBuilder(vector[i].Metric)
+		lb.Set(labels.MetricName, r.name)
+		for _, l := range r.labels {
+			lb.Set(l.Name, l.Value)
+		}
+		vector[i].Metric = lb.Labels()
+	}
+	if vector.ContainsSameLabelset() {
+		return nil, fmt.Errorf("vector contains metrics with the same labelset after applying rule labels")
+	}
+	return vector, nil
+}
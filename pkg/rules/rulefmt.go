@@ -0,0 +1,148 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/tenancy"
+	"gopkg.in/yaml.v2"
+)
+
+// ruleGroupsFile is the format of a Prometheus rule file.
+type ruleGroupsFile struct {
+	Groups []ruleGroupConfig `yaml:"groups"`
+}
+
+type ruleGroupConfig struct {
+	Name     string         `yaml:"name"`
+	Interval model.Duration `yaml:"interval,omitempty"`
+	// Tenant restricts the rules of the group to the series of a tenant.
+	Tenant string       `yaml:"tenant,omitempty"`
+	Rules  []ruleConfig `yaml:"rules"`
+}
+
+type ruleConfig struct {
+	Record      string            `yaml:"record,omitempty"`
+	Alert       string            `yaml:"alert,omitempty"`
+	Expr        string            `yaml:"expr"`
+	For         model.Duration    `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// loadGroups reads the rule groups of the files matching the patterns.
+// Groups without an interval are evaluated every defaultInterval.
+func loadGroups(patterns []string, defaultInterval time.Duration) ([]*Group, error) {
+	var groups []*Group
+	for _, pattern := range patterns {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule file pattern %s: %w", pattern, err)
+		}
+		for _, file := range files {
+			fileGroups, err := loadFile(file, defaultInterval)
+			if err != nil {
+				return nil, err
+			}
+			groups = append(groups, fileGroups...)
+		}
+	}
+	return groups, nil
+}
+
+func loadFile(file string, defaultInterval time.Duration) ([]*Group, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading rule file: %w", err)
+	}
+	var cfg ruleGroupsFile
+	if err = yaml.UnmarshalStrict(content, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing rule file %s: %w", file, err)
+	}
+
+	groups := make([]*Group, 0, len(cfg.Groups))
+	seen := make(map[string]bool)
+	for _, gc := range cfg.Groups {
+		if gc.Name == "" {
+			return nil, fmt.Errorf("rule file %s: group name must not be empty", file)
+		}
+		if seen[gc.Name] {
+			return nil, fmt.Errorf("rule file %s: duplicate group name %s", file, gc.Name)
+		}
+		seen[gc.Name] = true
+		if gc.Tenant != "" {
+			if err = tenancy.Validate(gc.Tenant); err != nil {
+				return nil, fmt.Errorf("rule file %s: group %s: %w", file, gc.Name, err)
+			}
+		}
+
+		interval := time.Duration(gc.Interval)
+		if interval == 0 {
+			interval = defaultInterval
+		}
+		g := &Group{name: gc.Name, file: file, interval: interval, tenant: gc.Tenant}
+		for i, rc := range gc.Rules {
+			rule, err := newRule(rc)
+			if err != nil {
+				return nil, fmt.Errorf("rule file %s: group %s: rule %d: %w", file, gc.Name, i, err)
+			}
+			g.rules = append(g.rules, rule)
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+func newRule(rc ruleConfig) (Rule, error) {
+	if (rc.Record == "") == (rc.Alert == "") {
+		return nil, fmt.Errorf("exactly one of record and alert must be set")
+	}
+	if rc.Expr == "" {
+		return nil, fmt.Errorf("expr must not be empty")
+	}
+	expr, err := parser.ParseExpr(rc.Expr)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse expression: %w", err)
+	}
+	for name := range rc.Labels {
+		if !model.LabelName(name).IsValid() {
+			return nil, fmt.Errorf("invalid label name: %s", name)
+		}
+	}
+
+	if rc.Record != "" {
+		if !model.IsValidMetricName(model.LabelValue(rc.Record)) {
+			return nil, fmt.Errorf("invalid recording rule name: %s", rc.Record)
+		}
+		if rc.For != 0 || len(rc.Annotations) > 0 {
+			return nil, fmt.Errorf("recording rules can't have for or annotations")
+		}
+		return newRecordingRule(rc.Record, expr, labels.FromMap(rc.Labels)), nil
+	}
+
+	for name := range rc.Annotations {
+		if !model.LabelName(name).IsValid() {
+			return nil, fmt.Errorf("invalid annotation name: %s", name)
+		}
+	}
+	for _, text := range rc.Labels {
+		if err = checkTemplate(text); err != nil {
+			return nil, err
+		}
+	}
+	for _, text := range rc.Annotations {
+		if err = checkTemplate(text); err != nil {
+			return nil, err
+		}
+	}
+	return newAlertingRule(rc.Alert, expr, time.Duration(rc.For), labels.FromMap(rc.Labels), labels.FromMap(rc.Annotations)), nil
+}
@@ -0,0 +1,424 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/value"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/notifier"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+const testRules = `
+groups:
+  - name: example
+    interval: 30s
+    rules:
+      - record: job:requests:rate5m
+        expr: sum by (job) (rate(requests_total[5m]))
+        labels:
+          team: infra
+      - alert: HighErrorRate
+        expr: job:errors:ratio > 0.5
+        for: 2m
+        labels:
+          severity: page
+        annotations:
+          summary: "{{ $labels.job }} has an error ratio of {{ $value }}"
+`
+
+func writeRules(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadGroups(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeRules(t, dir, "a.yml", testRules)
+	writeRules(t, dir, "b.yml", "groups:\n  - name: other\n    tenant: team-a\n    rules:\n      - record: up:sum\n        expr: sum(up)\n")
+
+	groups, err := loadGroups([]string{filepath.Join(dir, "*.yml")}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("unexpected number of groups: %d", len(groups))
+	}
+	if groups[0].Name() != "example" || groups[0].Interval() != 30*time.Second || len(groups[0].Rules()) != 2 {
+		t.Errorf("unexpected group: %+v", groups[0])
+	}
+	if groups[1].Interval() != time.Minute {
+		t.Errorf("groups without an interval should use the default one, got %s", groups[1].Interval())
+	}
+	if groups[0].Tenant() != "" || groups[1].Tenant() != "team-a" {
+		t.Errorf("unexpected tenants: %q %q", groups[0].Tenant(), groups[1].Tenant())
+	}
+	alerting, ok := groups[0].Rules()[1].(*AlertingRule)
+	if !ok {
+		t.Fatalf("expected an alerting rule, got %T", groups[0].Rules()[1])
+	}
+	if alerting.HoldDuration() != 2*time.Minute || alerting.Labels().Get("severity") != "page" {
+		t.Errorf("unexpected alerting rule: %+v", alerting)
+	}
+
+	invalid := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"unknown field", "groups:\n  - name: a\n    foo: bar\n", "field foo not found"},
+		{"no name", "groups:\n  - rules: []\n", "group name must not be empty"},
+		{"duplicate group", "groups:\n  - name: a\n  - name: a\n", "duplicate group name"},
+		{"bad tenant", "groups:\n  - name: a\n    tenant: \"a\\tb\"\n", "invalid character"},
+		{"record and alert", "groups:\n  - name: a\n    rules:\n      - record: a\n        alert: b\n        expr: up\n", "exactly one of record and alert"},
+		{"bad expr", "groups:\n  - name: a\n    rules:\n      - record: a\n        expr: sum(\n", "could not parse expression"},
+		{"bad record name", "groups:\n  - name: a\n    rules:\n      - record: a-b\n        expr: up\n", "invalid recording rule name"},
+		{"record with for", "groups:\n  - name: a\n    rules:\n      - record: a\n        expr: up\n        for: 1m\n", "can't have for or annotations"},
+		{"bad template", "groups:\n  - name: a\n    rules:\n      - alert: a\n        expr: up\n        annotations:\n          summary: \"{{ $labels\"\n", "invalid template"},
+	}
+	for _, c := range invalid {
+		t.Run(c.name, func(t *testing.T) {
+			path := writeRules(t, dir, "invalid.yml", c.content)
+			_, err := loadFile(path, time.Minute)
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("expected an error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func mustParse(t *testing.T, q string) parser.Expr {
+	expr, err := parser.ParseExpr(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return expr
+}
+
+// vectorQuery returns a QueryFunc always returning the samples of vec at the
+// time of the query.
+func vectorQuery(vec *promql.Vector) QueryFunc {
+	return func(_ context.Context, _ string, ts time.Time) (promql.Vector, error) {
+		res := make(promql.Vector, len(*vec))
+		for i, s := range *vec {
+			s.T = ts.UnixNano() / int64(time.Millisecond)
+			res[i] = s
+		}
+		return res, nil
+	}
+}
+
+func TestRecordingRuleEval(t *testing.T) {
+	vec := promql.Vector{
+		{Metric: labels.FromStrings("job", "api"), Point: promql.Point{V: 1}},
+		{Metric: labels.FromStrings("job", "db", "team", "db"), Point: promql.Point{V: 2}},
+	}
+	rule := newRecordingRule("job:requests:rate5m", mustParse(t, "requests"), labels.FromStrings("team", "infra"))
+	res, err := rule.Eval(context.Background(), time.Unix(10, 0), vectorQuery(&vec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := promql.Vector{
+		{Metric: labels.FromStrings(labels.MetricName, "job:requests:rate5m", "job", "api", "team", "infra"), Point: promql.Point{T: 10000, V: 1}},
+		{Metric: labels.FromStrings(labels.MetricName, "job:requests:rate5m", "job", "db", "team", "infra"), Point: promql.Point{T: 10000, V: 2}},
+	}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("unexpected result:\ngot\n%v\nwanted\n%v", res, expected)
+	}
+
+	// the rule labels make both series the same
+	vec = promql.Vector{
+		{Metric: labels.FromStrings("team", "a")},
+		{Metric: labels.FromStrings("team", "b")},
+	}
+	if _, err = rule.Eval(context.Background(), time.Unix(10, 0), vectorQuery(&vec)); err == nil {
+		t.Errorf("expected an error for duplicate series")
+	}
+}
+
+func TestAlertingRuleEval(t *testing.T) {
+	vec := promql.Vector{
+		{Metric: labels.FromStrings(labels.MetricName, "errors", "job", "api"), Point: promql.Point{V: 0.7}},
+	}
+	rule := newAlertingRule(
+		"HighErrorRate",
+		mustParse(t, "errors > 0.5"),
+		2*time.Minute,
+		labels.FromStrings("severity", "page"),
+		labels.FromStrings("summary", "{{ $labels.job }} at {{ $value }}"),
+	)
+	query := vectorQuery(&vec)
+	alertLabels := labels.FromStrings(alertNameLabel, "HighErrorRate", "job", "api", "severity", "page")
+
+	steps := []struct {
+		at     time.Duration
+		series promql.Vector
+		state  AlertState
+		alerts int
+	}{
+		{at: 0, series: vec, state: StatePending, alerts: 1},
+		{at: time.Minute, series: vec, state: StatePending, alerts: 1},
+		{at: 2 * time.Minute, series: vec, state: StateFiring, alerts: 1},
+		// resolved alerts are kept, but are no longer active
+		{at: 3 * time.Minute, state: StateInactive},
+		{at: 4 * time.Minute, series: vec, state: StatePending, alerts: 1},
+	}
+	start := time.Unix(1000, 0)
+	for _, step := range steps {
+		vec = step.series
+		ts := start.Add(step.at)
+		res, err := rule.Eval(context.Background(), ts, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rule.State() != step.state {
+			t.Errorf("@ %s: unexpected state: got %s wanted %s", step.at, rule.State(), step.state)
+		}
+		alerts := rule.ActiveAlerts()
+		if len(alerts) != step.alerts || len(res) != step.alerts {
+			t.Fatalf("@ %s: unexpected number of alerts: %d, samples: %d", step.at, len(alerts), len(res))
+		}
+		if step.alerts == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(alerts[0].Labels, alertLabels) {
+			t.Errorf("@ %s: unexpected alert labels: %v", step.at, alerts[0].Labels)
+		}
+		if summary := alerts[0].Annotations.Get("summary"); summary != "api at 0.7" {
+			t.Errorf("@ %s: unexpected summary: %s", step.at, summary)
+		}
+		expected := labels.NewBuilder(alertLabels).
+			Set(labels.MetricName, alertMetricName).
+			Set(alertStateLabel, step.state.String()).
+			Labels()
+		if !reflect.DeepEqual(res[0].Metric, expected) || res[0].T != ts.UnixNano()/int64(time.Millisecond) {
+			t.Errorf("@ %s: unexpected ALERTS sample: %v", step.at, res[0])
+		}
+	}
+}
+
+type mockWriter struct {
+	series []prompb.TimeSeries
+	tenant string
+	err    error
+}
+
+func (m *mockWriter) Ingest(tts []prompb.TimeSeries, _ *prompb.WriteRequest) (uint64, error) {
+	for _, ts := range tts {
+		ts.Labels = append([]prompb.Label(nil), ts.Labels...)
+		m.series = append(m.series, ts)
+	}
+	return uint64(len(tts)), m.err
+}
+
+func (m *mockWriter) IngestForTenant(tenant string, tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	m.tenant = tenant
+	return m.Ingest(tts, req)
+}
+
+type mockElection struct {
+	isLeader bool
+}
+
+func (m *mockElection) ID() string                  { return "ID" }
+func (m *mockElection) BecomeLeader() (bool, error) { return true, nil }
+func (m *mockElection) IsLeader() (bool, error)     { return m.isLeader, nil }
+func (m *mockElection) Resign() error               { return nil }
+
+func TestGroupEval(t *testing.T) {
+	vec := promql.Vector{{Metric: labels.FromStrings("job", "api"), Point: promql.Point{V: 1}}}
+	writer := &mockWriter{}
+	opts := &Options{QueryFunc: vectorQuery(&vec), Writer: writer}
+	alerting := newAlertingRule("JobUp", mustParse(t, "up"), 0, nil, nil)
+	g := &Group{
+		name:  "example",
+		rules: []Rule{newRecordingRule("job:up", mustParse(t, "up"), nil), alerting},
+	}
+
+	ts := time.Unix(10, 0)
+	g.eval(context.Background(), ts, opts)
+	if len(writer.series) != 2 {
+		t.Fatalf("unexpected number of series written: %d", len(writer.series))
+	}
+	expected := []prompb.Label{{Name: labels.MetricName, Value: "job:up"}, {Name: "job", Value: "api"}}
+	if !reflect.DeepEqual(writer.series[0].Labels, expected) || writer.series[0].Samples[0].Timestamp != 10000 {
+		t.Errorf("unexpected recorded series: %v", writer.series[0])
+	}
+	for _, r := range g.rules {
+		if r.Health() != HealthGood || !r.LastEvaluation().Equal(ts) {
+			t.Errorf("unexpected status of rule %s: %s %s", r.Name(), r.Health(), r.LastEvaluation())
+		}
+	}
+	if !g.LastEvaluation().Equal(ts) {
+		t.Errorf("unexpected group evaluation time: %s", g.LastEvaluation())
+	}
+
+	// the series the rules stop returning are marked stale
+	vec = nil
+	writer.series = nil
+	g.eval(context.Background(), ts.Add(time.Minute), opts)
+	if len(writer.series) != 2 {
+		t.Fatalf("unexpected number of stale series written: %d", len(writer.series))
+	}
+	for _, series := range writer.series {
+		if !value.IsStaleNaN(series.Samples[0].Value) || series.Samples[0].Timestamp != 70000 {
+			t.Errorf("expected a stale marker, got %v", series)
+		}
+	}
+	writer.series = nil
+	g.eval(context.Background(), ts.Add(2*time.Minute), opts)
+	if len(writer.series) != 0 {
+		t.Errorf("series should only be marked stale once: %v", writer.series)
+	}
+
+	// all the series are marked stale when the rules are stopped
+	vec = promql.Vector{{Metric: labels.FromStrings("job", "api"), Point: promql.Point{V: 1}}}
+	g.eval(context.Background(), ts, opts)
+	writer.series = nil
+	g.markStale(ts.Add(time.Minute), opts)
+	if len(writer.series) != 2 || !value.IsStaleNaN(writer.series[0].Samples[0].Value) {
+		t.Errorf("unexpected series written when stopped: %v", writer.series)
+	}
+
+	writer.err = fmt.Errorf("some error")
+	g.eval(context.Background(), ts, opts)
+	if r := g.rules[0]; r.Health() != HealthBad || r.LastError() != writer.err {
+		t.Errorf("unexpected status after a failed write: %s %v", r.Health(), r.LastError())
+	}
+
+	// only the leader evaluates the rules, followers forget their alerts
+	election := &mockElection{isLeader: true}
+	if !shouldEvaluate(util.NewElector(election)) || !shouldEvaluate(nil) {
+		t.Errorf("the leader should evaluate the rules")
+	}
+	election.isLeader = false
+	if shouldEvaluate(util.NewElector(election)) {
+		t.Errorf("followers should not evaluate the rules")
+	}
+	g.resetAlerts()
+	if len(alerting.ActiveAlerts()) != 0 {
+		t.Errorf("alerts should be forgotten on reset")
+	}
+}
+
+func TestTenantGroupEval(t *testing.T) {
+	var queryTenant string
+	query := func(ctx context.Context, _ string, ts time.Time) (promql.Vector, error) {
+		queryTenant = tenancy.FromContext(ctx)
+		return promql.Vector{{
+			Metric: labels.FromStrings("job", "api"),
+			Point:  promql.Point{T: ts.UnixNano() / int64(time.Millisecond), V: 1},
+		}}, nil
+	}
+	writer := &mockWriter{}
+	alerting := newAlertingRule("JobUp", mustParse(t, "up"), 0, nil, nil)
+	g := &Group{
+		name:   "example",
+		tenant: "team-a",
+		rules: []Rule{
+			newRecordingRule("job:up", mustParse(t, "up"), labels.FromStrings(pgmodel.TenantLabelName, "team-b")),
+			alerting,
+		},
+	}
+
+	g.eval(context.Background(), time.Unix(10, 0), &Options{QueryFunc: query, Writer: writer})
+	if queryTenant != "team-a" || writer.tenant != "team-a" {
+		t.Errorf("the rules should query and write for the tenant of the group: %q %q", queryTenant, writer.tenant)
+	}
+	for _, series := range writer.series {
+		var tenant string
+		for _, l := range series.Labels {
+			if l.Name == pgmodel.TenantLabelName {
+				tenant = l.Value
+			}
+		}
+		if tenant != "team-a" {
+			t.Errorf("unexpected tenant of series %v", series.Labels)
+		}
+	}
+	if alerts := alerting.ActiveAlerts(); len(alerts) != 1 || alerts[0].Labels.Get(pgmodel.TenantLabelName) != "team-a" {
+		t.Errorf("the alerts should belong to the tenant of the group: %v", alerts)
+	}
+}
+
+type mockSender struct {
+	alerts []*notifier.Alert
+}
+
+func (m *mockSender) Send(alerts ...*notifier.Alert) {
+	m.alerts = append(m.alerts, alerts...)
+}
+
+func TestSendAlerts(t *testing.T) {
+	vec := promql.Vector{{Metric: labels.FromStrings("job", "api"), Point: promql.Point{V: 1}}}
+	sender := &mockSender{}
+	opts := &Options{QueryFunc: vectorQuery(&vec), Writer: &mockWriter{}, NotifyFunc: SendAlerts(sender), ResendDelay: 2 * time.Minute}
+	g := &Group{
+		name:     "example",
+		interval: time.Minute,
+		rules:    []Rule{newAlertingRule("JobUp", mustParse(t, "up"), time.Minute, nil, nil)},
+	}
+
+	start := time.Unix(1000, 0)
+	steps := []struct {
+		at     time.Duration
+		series promql.Vector
+		sent   bool
+		endsAt time.Duration
+	}{
+		// pending alerts aren't sent
+		{at: 0, series: vec},
+		{at: time.Minute, series: vec, sent: true, endsAt: 9 * time.Minute},
+		{at: 2 * time.Minute, series: vec},
+		{at: 3 * time.Minute, series: vec, sent: true, endsAt: 11 * time.Minute},
+		// resolved alerts are sent once
+		{at: 4 * time.Minute, sent: true, endsAt: 4 * time.Minute},
+		{at: 5 * time.Minute},
+	}
+	for _, step := range steps {
+		vec = step.series
+		sender.alerts = nil
+		g.eval(context.Background(), start.Add(step.at), opts)
+		if !step.sent {
+			if len(sender.alerts) != 0 {
+				t.Errorf("@ %s: unexpected alerts sent: %v", step.at, sender.alerts)
+			}
+			continue
+		}
+		if len(sender.alerts) != 1 {
+			t.Fatalf("@ %s: unexpected number of alerts sent: %d", step.at, len(sender.alerts))
+		}
+		a := sender.alerts[0]
+		if !a.StartsAt.Equal(start.Add(time.Minute)) || !a.EndsAt.Equal(start.Add(step.endsAt)) {
+			t.Errorf("@ %s: unexpected alert times: %s %s", step.at, a.StartsAt, a.EndsAt)
+		}
+		if a.Labels.Get(alertNameLabel) != "JobUp" {
+			t.Errorf("@ %s: unexpected alert labels: %v", step.at, a.Labels)
+		}
+	}
+}
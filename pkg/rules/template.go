@@ -0,0 +1,46 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// templateDefs makes the labels and value of an alert available as $labels
+// and $value, like in Prometheus.
+const templateDefs = "{{$labels := .Labels}}{{$value := .Value}}"
+
+type templateData struct {
+	Labels map[string]string
+	Value  float64
+}
+
+func checkTemplate(text string) error {
+	if _, err := template.New("check").Option("missingkey=zero").Parse(templateDefs + text); err != nil {
+		return fmt.Errorf("invalid template %q: %w", text, err)
+	}
+	return nil
+}
+
+// expandTemplate expands a label or annotation template for an alert with the
+// labels lset and the value v.
+func expandTemplate(name, text string, lset labels.Labels, v float64) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(templateDefs + text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err = tmpl.Execute(&b, templateData{Labels: lset.Map(), Value: v}); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
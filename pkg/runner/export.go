@@ -0,0 +1,142 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package runner
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	pgx "github.com/jackc/pgx/v4"
+	"github.com/jamiealquiza/envy"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/timescale/promscale/pkg/exporter"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgclient"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/util"
+	"github.com/timescale/promscale/pkg/version"
+)
+
+// ExportConfig configures the export subcommand, which dumps the series
+// matching PromQL selectors.
+type ExportConfig struct {
+	PgmodelCfg pgclient.Config
+	LogCfg     log.Config
+	Exporter   exporter.Config
+	// Output is the file the series are written to, stdout if it is "-".
+	Output string
+	// Selectors are the PromQL series selectors of the series to export.
+	Selectors []string
+}
+
+// ParseExportFlags parses the flags of the export subcommand from args, the
+// command line arguments following the subcommand.
+func ParseExportFlags(cfg *ExportConfig, args []string) (*ExportConfig, error) {
+	pgclient.ParseFlags(&cfg.PgmodelCfg)
+	log.ParseFlags(&cfg.LogCfg)
+	format := flag.String("format", string(exporter.OpenMetrics), fmt.Sprintf("Format of the export: %s for the OpenMetrics text format, %s for newline-delimited JSON, or %s for length-prefixed snappy-compressed remote write requests.", exporter.OpenMetrics, exporter.JSON, exporter.RemoteWrite))
+	start := flag.String("start", "", "Start of the exported time range, as an RFC 3339 time or a Unix timestamp in seconds. The Unix epoch if empty.")
+	end := flag.String("end", "", "End of the exported time range, as an RFC 3339 time or a Unix timestamp in seconds. The current time if empty.")
+	flag.StringVar(&cfg.Output, "output", "-", "File the series are written to, stdout if -.")
+	flag.IntVar(&cfg.Exporter.BatchSamples, "batch-samples", exporter.DefaultBatchSamples, "Number of samples of a remote write request.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s export [flags] <selector>...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	envy.Parse("TS_PROM")
+
+	if err := flag.CommandLine.Parse(args); err != nil {
+		return nil, err
+	}
+	cfg.Selectors = flag.Args()
+	if len(cfg.Selectors) == 0 {
+		return nil, fmt.Errorf("no series selector to export")
+	}
+
+	var err error
+	if cfg.Exporter.Format, err = exporter.ParseFormat(*format); err != nil {
+		return nil, err
+	}
+	startTime, err := parseExportTime(*start, time.Unix(0, 0))
+	if err != nil {
+		return nil, fmt.Errorf("invalid start: %w", err)
+	}
+	endTime, err := parseExportTime(*end, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid end: %w", err)
+	}
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("the end of the time range is before its start")
+	}
+	cfg.Exporter.Start = timestamp.FromTime(startTime)
+	cfg.Exporter.End = timestamp.FromTime(endTime)
+	return cfg, nil
+}
+
+// parseExportTime parses an RFC 3339 time or a Unix timestamp in seconds,
+// like the time parameters of the Prometheus HTTP API, returning def if s is
+// empty.
+func parseExportTime(s string, def time.Time) (time.Time, error) {
+	if s == "" {
+		return def, nil
+	}
+	if t, err := strconv.ParseFloat(s, 64); err == nil {
+		sec, ns := math.Modf(t)
+		return time.Unix(int64(sec), int64(math.Round(ns*1000))*int64(time.Millisecond)), nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+// RunExport exports the series selected by cfg.
+func RunExport(cfg *ExportConfig) error {
+	log.Info("msg", "Version:"+version.Version+"; Commit Hash: "+version.CommitHash)
+	log.Info("config", util.MaskPassword(fmt.Sprintf("%+v", cfg)))
+
+	conn, err := pgx.Connect(context.Background(), cfg.PgmodelCfg.GetConnectionStr())
+	if err != nil {
+		log.Error("msg", "aborting export due to error", "err", util.MaskPassword(err.Error()))
+		return startupError
+	}
+	err = pgmodel.CheckDependencies(conn, appVersion)
+	_ = conn.Close(context.Background())
+	if err != nil {
+		log.Error("msg", "aborting export due to error", "err", fmt.Errorf("dependency error: %w", err))
+		return startupError
+	}
+
+	// the export only reads, so no ingestor is started
+	client, err := pgclient.NewReadOnlyClient(&cfg.PgmodelCfg, getSchemaLease)
+	if err != nil {
+		log.Error("msg", "aborting export due to error", "err", util.MaskPassword(err.Error()))
+		return startupError
+	}
+	defer client.Close()
+
+	out := os.Stdout
+	if cfg.Output != "-" {
+		if out, err = os.Create(cfg.Output); err != nil {
+			log.Error("msg", "aborting export due to error", "err", err)
+			return err
+		}
+	}
+
+	start := time.Now()
+	result, err := exporter.New(cfg.Exporter, client).Export(out, cfg.Selectors...)
+	if out != os.Stdout {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		log.Error("msg", "Export failed", "series", result.Series, "samples", result.Samples, "err", err)
+		return err
+	}
+	log.Info("msg", "Export finished", "series", result.Series, "samples", result.Samples, "duration", time.Since(start))
+	return nil
+}
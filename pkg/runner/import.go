@@ -0,0 +1,117 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package runner
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	pgx "github.com/jackc/pgx/v4"
+	"github.com/jamiealquiza/envy"
+	"github.com/timescale/promscale/pkg/importer"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgclient"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/util"
+	"github.com/timescale/promscale/pkg/version"
+)
+
+// ImportConfig configures the import subcommand, which backfills Prometheus
+// TSDB blocks.
+type ImportConfig struct {
+	PgmodelCfg pgclient.Config
+	LogCfg     log.Config
+	Importer   importer.Config
+	// DecompressChunks decompresses the chunks the samples of every block
+	// are imported into before importing it.
+	DecompressChunks bool
+	// Dirs are the block directories, or directories holding blocks, to
+	// import.
+	Dirs []string
+}
+
+// ParseImportFlags parses the flags of the import subcommand from args, the
+// command line arguments following the subcommand.
+func ParseImportFlags(cfg *ImportConfig, args []string) (*ImportConfig, error) {
+	pgclient.ParseFlags(&cfg.PgmodelCfg)
+	log.ParseFlags(&cfg.LogCfg)
+	flag.IntVar(&cfg.Importer.Parallelism, "parallelism", importer.DefaultParallelism, "Number of batches of samples written to the database at once.")
+	flag.IntVar(&cfg.Importer.BatchSamples, "batch-samples", importer.DefaultBatchSamples, "Number of samples of a batch.")
+	flag.StringVar(&cfg.Importer.ProgressFile, "progress-file", "promscale-import-progress.json", "File recording the progress of the import, which is resumed from it when run again. The progress isn't kept if empty.")
+	flag.BoolVar(&cfg.DecompressChunks, "decompress-chunks", true, "Decompress the compressed chunks of the metrics of a block over the time range of the block before importing it.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s import [flags] <block or data directory>...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	envy.Parse("TS_PROM")
+
+	if err := flag.CommandLine.Parse(args); err != nil {
+		return nil, err
+	}
+	cfg.Dirs = flag.Args()
+	if len(cfg.Dirs) == 0 {
+		return nil, fmt.Errorf("no block or data directory to import")
+	}
+	return cfg, nil
+}
+
+// RunImport imports the TSDB blocks of cfg. The database schema must be at
+// the version of the connector, no migration is done.
+func RunImport(cfg *ImportConfig) error {
+	log.Info("msg", "Version:"+version.Version+"; Commit Hash: "+version.CommitHash)
+	log.Info("config", util.MaskPassword(fmt.Sprintf("%+v", cfg)))
+
+	conn, err := pgx.Connect(context.Background(), cfg.PgmodelCfg.GetConnectionStr())
+	if err != nil {
+		log.Error("msg", "aborting import due to error", "err", util.MaskPassword(err.Error()))
+		return startupError
+	}
+	err = pgmodel.CheckDependencies(conn, appVersion)
+	_ = conn.Close(context.Background())
+	if err != nil {
+		log.Error("msg", "aborting import due to error", "err", fmt.Errorf("dependency error: %w", err))
+		return startupError
+	}
+
+	// the progress of the import is only recorded once its samples are
+	// written, and the import is not a client to protect from overload
+	clientCfg := cfg.PgmodelCfg
+	clientCfg.AsyncAcks = false
+	clientCfg.AsyncAcksWALDir = ""
+	clientCfg.MaxInFlightSamples = 0
+	clientCfg.MaxInsertQueueDepth = 0
+	clientCfg.Limits = pgmodel.Limits{}
+
+	// the schema version lease prevents migrations during the import
+	client, err := pgclient.NewClient(&clientCfg, getSchemaLease)
+	if err != nil {
+		log.Error("msg", "aborting import due to error", "err", util.MaskPassword(err.Error()))
+		return startupError
+	}
+	defer client.Close()
+
+	var decompressor importer.ChunkDecompressor
+	if cfg.DecompressChunks {
+		decompressor = pgmodel.NewChunkDecompressor(client.Connection)
+	}
+
+	start := time.Now()
+	result, err := importer.New(cfg.Importer, client, decompressor).Import(cfg.Dirs...)
+	if result.Samples > 0 {
+		// the imported samples may belong to any tenant, and to the time
+		// ranges of query results the connectors cached
+		if markErr := client.MarkDataModified(""); markErr != nil {
+			log.Warn("msg", "could not record the import, the query results cached by the connectors may miss imported samples", "err", markErr)
+		}
+	}
+	if err != nil {
+		log.Error("msg", "Import failed, run it again to resume it", "blocks", result.Blocks, "samples", result.Samples, "err", err)
+		return err
+	}
+	log.Info("msg", "Import finished", "blocks", result.Blocks, "samples", result.Samples, "duration", time.Since(start))
+	return nil
+}
@@ -0,0 +1,193 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package runner
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	pgx "github.com/jackc/pgx/v4"
+	"github.com/jamiealquiza/envy"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgclient"
+	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/util"
+	"github.com/timescale/promscale/pkg/version"
+)
+
+const (
+	migrateStatus = "status"
+	migratePlan   = "plan"
+	migrateDryRun = "dry-run"
+)
+
+// MigrateConfig configures the migrate subcommand, which inspects the
+// migration of the database schema to the version of the connector without
+// applying it.
+type MigrateConfig struct {
+	PgmodelCfg pgclient.Config
+	LogCfg     log.Config
+	// Command is status, plan or dry-run.
+	Command string
+}
+
+// ParseMigrateFlags parses the command and flags of the migrate subcommand
+// from args, the command line arguments following the subcommand.
+func ParseMigrateFlags(cfg *MigrateConfig, args []string) (*MigrateConfig, error) {
+	pgclient.ParseFlags(&cfg.PgmodelCfg)
+	log.ParseFlags(&cfg.LogCfg)
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintf(out, "Usage: %s migrate <command> [flags]\n\n", os.Args[0])
+		fmt.Fprintf(out, "Commands:\n")
+		fmt.Fprintf(out, "  %-9sshow the schema version, the pending migration scripts and the extension versions\n", migrateStatus)
+		fmt.Fprintf(out, "  %-9sprint the SQL the migration runs\n", migratePlan)
+		fmt.Fprintf(out, "  %-9srun the migration in a transaction that is rolled back\n\n", migrateDryRun)
+		fmt.Fprintf(out, "The migration is applied by running the connector with -migrate=only.\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	envy.Parse("TS_PROM")
+
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		cfg.Command = args[0]
+		args = args[1:]
+	}
+	if err := flag.CommandLine.Parse(args); err != nil {
+		return nil, err
+	}
+	switch cfg.Command {
+	case migrateStatus, migratePlan, migrateDryRun:
+	case "":
+		return nil, fmt.Errorf("no migrate command, must be one of %s, %s or %s", migrateStatus, migratePlan, migrateDryRun)
+	default:
+		return nil, fmt.Errorf("invalid migrate command %q, must be one of %s, %s or %s", cfg.Command, migrateStatus, migratePlan, migrateDryRun)
+	}
+	if flag.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", flag.Args())
+	}
+	return cfg, nil
+}
+
+// RunMigrate runs the migrate command of cfg, writing its output to stdout.
+func RunMigrate(cfg *MigrateConfig) error {
+	log.Info("msg", "Version:"+version.Version+"; Commit Hash: "+version.CommitHash)
+	log.Info("config", util.MaskPassword(fmt.Sprintf("%+v", cfg)))
+
+	if cfg.Command == migrateDryRun {
+		return runMigrateDryRun(cfg, os.Stdout)
+	}
+
+	conn, err := pgx.Connect(context.Background(), cfg.PgmodelCfg.GetConnectionStr())
+	if err != nil {
+		log.Error("msg", "aborting migrate "+cfg.Command+" due to error", "err", util.MaskPassword(err.Error()))
+		return startupError
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	switch cfg.Command {
+	case migrateStatus:
+		status, err := pgmodel.GetMigrationStatus(conn, appVersion)
+		if err != nil {
+			log.Error("msg", "could not get the migration status", "err", err)
+			return err
+		}
+		return writeMigrationStatus(os.Stdout, status)
+	case migratePlan:
+		plan, err := pgmodel.PlanMigration(conn, appVersion)
+		if err != nil {
+			log.Error("msg", "could not plan the migration", "err", err)
+			return err
+		}
+		return plan.WriteSQL(os.Stdout)
+	}
+	return nil
+}
+
+// runMigrateDryRun runs the migration in a transaction that is rolled back.
+// Like the migration, it needs the exclusive schema version lock, so that it
+// fails instead of blocking connectors using the schema.
+func runMigrateDryRun(cfg *MigrateConfig, w io.Writer) error {
+	lease, err := util.NewPgAdvisoryLock(schemaLockId, cfg.PgmodelCfg.GetConnectionStr())
+	if err != nil {
+		log.Error("msg", "error creating schema version lease", "err", util.MaskPassword(err.Error()))
+		return startupError
+	}
+	defer lease.Close()
+
+	locked, err := lease.GetAdvisoryLock()
+	if err != nil {
+		return fmt.Errorf("error while acquiring migration lock %w", err)
+	}
+	if !locked {
+		log.Error("msg", "could not acquire the migration lock, the schema is in use by other connectors")
+		return migrationLockError
+	}
+	defer func() {
+		if _, err := lease.Unlock(); err != nil {
+			log.Error("msg", "error while releasing migration lock", "err", err)
+		}
+	}()
+
+	conn, err := lease.Conn()
+	if err != nil {
+		return fmt.Errorf("migration error: %w", err)
+	}
+	plan, err := pgmodel.DryRunMigration(conn, appVersion)
+	if err != nil {
+		log.Error("msg", "Migration dry run failed", "err", err)
+		return err
+	}
+	fmt.Fprintf(w, "Migration from version %s to %s succeeded and was rolled back.\n", schemaVersionString(plan.From.String()), plan.To)
+	for _, script := range plan.Scripts {
+		fmt.Fprintf(w, "  %s\n", script.Name)
+	}
+	return nil
+}
+
+func writeMigrationStatus(w io.Writer, status *pgmodel.MigrationStatus) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Schema version:\t%s\n", schemaVersionString(status.InstalledVersion.String()))
+	fmt.Fprintf(tw, "Connector schema version:\t%s\n", status.AppVersion)
+	switch c := status.InstalledVersion.Compare(status.AppVersion); {
+	case c > 0:
+		fmt.Fprintf(tw, "Pending scripts:\tnone, the schema is above the connector version\n")
+	case len(status.Pending) == 0:
+		fmt.Fprintf(tw, "Pending scripts:\tnone\n")
+	default:
+		fmt.Fprintf(tw, "Pending scripts:\t%d\n", len(status.Pending))
+		for _, name := range status.Pending {
+			fmt.Fprintf(tw, "\t%s\n", name)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nExtensions:\n")
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "  NAME\tINSTALLED\tTARGET\tREQUIRED\n")
+	for _, ext := range status.Extensions {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", ext.Name, orNone(ext.Installed), orNone(ext.Target), ext.Required)
+	}
+	return tw.Flush()
+}
+
+func schemaVersionString(v string) string {
+	if v == "0.0.0" {
+		return "none"
+	}
+	return v
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -12,9 +12,12 @@ import (
 	"fmt"
 	"net/http"
 	pprof "net/http/pprof"
+	"os"
+	"os/signal"
 	"regexp"
 	"strings"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	pgx "github.com/jackc/pgx/v4"
@@ -23,8 +26,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/timescale/promscale/pkg/api"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/notifier"
 	"github.com/timescale/promscale/pkg/pgclient"
 	"github.com/timescale/promscale/pkg/pgmodel"
+	"github.com/timescale/promscale/pkg/query"
+	"github.com/timescale/promscale/pkg/rules"
+	"github.com/timescale/promscale/pkg/tenancy"
 	"github.com/timescale/promscale/pkg/util"
 	"github.com/timescale/promscale/pkg/version"
 )
@@ -43,6 +50,30 @@ type Config struct {
 	UseVersionLease    bool
 	CorsOrigin         *regexp.Regexp
 	InstallTimescaleDB bool
+	MultiTenancy       bool
+	TenantHeader       string
+	// RuleFiles are the patterns of the Prometheus rule files to evaluate.
+	RuleFiles              []string
+	RuleEvaluationInterval time.Duration
+	// AlertmanagerURLs and AlertmanagerSDFiles list the Alertmanagers the
+	// alerts are sent to.
+	AlertmanagerURLs          []string
+	AlertmanagerSDFiles       []string
+	AlertmanagerTimeout       time.Duration
+	AlertmanagerQueueCapacity int
+	AlertResendDelay          time.Duration
+	// QueryEngine bounds the resources used by PromQL queries.
+	QueryEngine query.EngineConfig
+	// QueryResultsCacheSize is the number of range query intervals cached,
+	// the cache is disabled if it is 0.
+	QueryResultsCacheSize          uint64
+	QueryResultsCacheSplitInterval time.Duration
+	QueryResultsCacheMaxFreshness  time.Duration
+	// AdminAPIEnabled serves the Prometheus admin API deleting series.
+	AdminAPIEnabled bool
+	// ExplainAnalyzeEnabled lets the explain endpoint run queries with
+	// EXPLAIN ANALYZE.
+	ExplainAnalyzeEnabled bool
 }
 
 const (
@@ -67,6 +98,8 @@ func ParseFlags(cfg *Config) (*Config, error) {
 	var corsOriginFlag string
 	var migrateOption string
 	flag.StringVar(&corsOriginFlag, "web-cors-origin", ".*", `Regex for CORS origin. It is fully anchored. Example: 'https?://(domain1|domain2)\.com'`)
+	flag.BoolVar(&cfg.MultiTenancy, "multi-tenancy", false, "Require every request to name its tenant in the multi-tenancy header, and restrict all reads and writes to the series of that tenant.")
+	flag.StringVar(&cfg.TenantHeader, "multi-tenancy-header", tenancy.DefaultHeader, "HTTP header naming the tenant of a request when multi-tenancy is enabled.")
 	flag.Int64Var(&cfg.HaGroupLockID, "leader-election-pg-advisory-lock-id", 0, "Unique advisory lock id per adapter high-availability group. Set it if you want to use leader election implementation based on PostgreSQL advisory lock.")
 	flag.DurationVar(&cfg.PrometheusTimeout, "leader-election-pg-advisory-lock-prometheus-timeout", -1, "Adapter will resign if there are no requests from Prometheus within a given timeout (0 means no timeout). "+
 		"Note: make sure that only one Prometheus instance talks to the adapter. Timeout value should be co-related with Prometheus scrape interval but add enough `slack` to prevent random flips.")
@@ -75,7 +108,28 @@ func ParseFlags(cfg *Config) (*Config, error) {
 	flag.StringVar(&migrateOption, "migrate", "true", "Update the Prometheus SQL to the latest version. Valid options are: [true, false, only]")
 	flag.BoolVar(&cfg.UseVersionLease, "use-schema-version-lease", true, "Prevent race conditions during migration")
 	flag.BoolVar(&cfg.InstallTimescaleDB, "install-timescaledb", true, "Install or update the TimescaleDB extension")
+	var ruleFilesFlag string
+	flag.StringVar(&ruleFilesFlag, "rule-files", "", "Comma separated list of Prometheus rule files to evaluate, which may contain globs. No rules are evaluated if empty.")
+	flag.DurationVar(&cfg.RuleEvaluationInterval, "rule-evaluation-interval", rules.DefaultEvaluationInterval, "Interval at which the rule groups that don't set one are evaluated.")
+	var alertmanagerURLsFlag, alertmanagerSDFilesFlag string
+	flag.StringVar(&alertmanagerURLsFlag, "alertmanager-urls", "", "Comma separated list of the URLs of the Alertmanagers the alerts are sent to.")
+	flag.StringVar(&alertmanagerSDFilesFlag, "alertmanager-sd-files", "", "Comma separated list of files listing Alertmanagers in the Prometheus file_sd format, re-read every minute.")
+	flag.DurationVar(&cfg.AlertmanagerTimeout, "alertmanager-timeout", 10*time.Second, "Timeout for sending alerts to Alertmanager.")
+	flag.IntVar(&cfg.AlertmanagerQueueCapacity, "alertmanager-queue-capacity", 10000, "Number of alerts waiting to be sent to Alertmanager after which the oldest ones are dropped.")
+	flag.DurationVar(&cfg.AlertResendDelay, "alert-resend-delay", rules.DefaultResendDelay, "Minimum delay before a firing alert is sent to Alertmanager again.")
+	flag.DurationVar(&cfg.QueryEngine.Timeout, "query-timeout", query.DefaultQueryTimeout, "Maximum time a PromQL query may take, including the time it waits to be executed.")
+	flag.IntVar(&cfg.QueryEngine.MaxSamples, "query-max-samples", query.DefaultMaxSamples, "Maximum number of samples a PromQL query may load into memory at once.")
+	flag.IntVar(&cfg.QueryEngine.MaxConcurrent, "query-max-concurrency", query.DefaultMaxConcurrent, "Maximum number of PromQL queries executed at once, 0 means no limit.")
+	flag.IntVar(&cfg.QueryEngine.MaxQueued, "query-max-queued", query.DefaultMaxQueued, "Maximum number of PromQL queries waiting to be executed, queries over the limit are rejected with HTTP 503. -1 means no limit.")
+	flag.StringVar(&cfg.QueryEngine.ActiveQueryLogDir, "query-active-log-dir", "", "Directory of the log of the PromQL queries being executed, which are logged on startup if the connector didn't stop cleanly. Requires -query-max-concurrency. Disabled if empty.")
+	flag.DurationVar(&cfg.QueryEngine.SlowQueryThreshold, "query-slow-log-threshold", 0, "PromQL queries taking longer than this to execute are logged, with their SQL statements and timings. Disabled if 0.")
+	flag.Uint64Var(&cfg.QueryResultsCacheSize, "query-results-cache-size", 0, "Maximum number of range query results cached, one per split interval. The results cache is disabled if 0.")
+	flag.DurationVar(&cfg.QueryResultsCacheSplitInterval, "query-results-cache-split-interval", query.DefaultResultsCacheSplitInterval, "Length of the intervals range queries are split in, whose results are cached separately.")
+	flag.DurationVar(&cfg.QueryResultsCacheMaxFreshness, "query-results-cache-max-freshness", query.DefaultResultsCacheMaxFreshness, "Intervals ending less than this long ago are always recomputed, as samples may still be ingested in them.")
+	flag.BoolVar(&cfg.AdminAPIEnabled, "web-enable-admin-api", false, "Serve the endpoints of the Prometheus admin API deleting series.")
+	flag.BoolVar(&cfg.ExplainAnalyzeEnabled, "web-enable-explain-analyze", false, "Let the explain endpoint run the SQL statements of queries with EXPLAIN ANALYZE.")
 	envy.Parse("TS_PROM")
+
 	flag.Parse()
 
 	corsOriginRegex, err := compileAnchoredRegexString(corsOriginFlag)
@@ -85,6 +139,23 @@ func ParseFlags(cfg *Config) (*Config, error) {
 	}
 	cfg.CorsOrigin = corsOriginRegex
 
+	if cfg.QueryEngine.ActiveQueryLogDir != "" {
+		if cfg.QueryEngine.MaxConcurrent <= 0 {
+			return nil, fmt.Errorf("the active query log requires a maximum query concurrency")
+		}
+		if err = os.MkdirAll(cfg.QueryEngine.ActiveQueryLogDir, 0777); err != nil {
+			return nil, fmt.Errorf("could not create the active query log directory: %w", err)
+		}
+	}
+
+	if cfg.QueryResultsCacheSplitInterval < time.Millisecond {
+		return nil, fmt.Errorf("invalid query results cache split interval %v, must be at least 1ms", cfg.QueryResultsCacheSplitInterval)
+	}
+
+	cfg.RuleFiles = splitList(ruleFilesFlag)
+	cfg.AlertmanagerURLs = splitList(alertmanagerURLsFlag)
+	cfg.AlertmanagerSDFiles = splitList(alertmanagerSDFilesFlag)
+
 	cfg.StopAfterMigrate = false
 	if strings.EqualFold(migrateOption, "true") {
 		cfg.Migrate = true
@@ -99,12 +170,35 @@ func ParseFlags(cfg *Config) (*Config, error) {
 	return cfg, nil
 }
 
+// splitList returns the non-empty elements of a comma separated list.
+func splitList(list string) []string {
+	var res []string
+	for _, e := range strings.Split(list, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
 func Run(cfg *Config) error {
 	log.Info("msg", "Version:"+version.Version+"; Commit Hash: "+version.CommitHash)
 	log.Info("config", util.MaskPassword(fmt.Sprintf("%+v", cfg)))
 
 	promMetrics := api.InitMetrics()
 
+	// the results cache is dropped from by the client, when it changes or
+	// removes samples, or finds out other connectors did
+	var resultsCache *query.ResultsCache
+	if cfg.QueryResultsCacheSize > 0 {
+		resultsCache = query.NewResultsCache(
+			query.NewInProcessCacheBackend(cfg.QueryResultsCacheSize),
+			cfg.QueryResultsCacheSplitInterval,
+			cfg.QueryResultsCacheMaxFreshness,
+		)
+		cfg.PgmodelCfg.CacheInvalidator = resultsCache
+	}
+
 	client, err := CreateClient(cfg, promMetrics)
 	if err != nil {
 		log.Error("msg", "aborting startup due to error", "err", util.MaskPassword(err.Error()))
@@ -117,8 +211,40 @@ func Run(cfg *Config) error {
 
 	defer client.Close()
 
-	apiConf := &api.Config{AllowedOrigin: cfg.CorsOrigin}
-	router := api.GenerateRouter(apiConf, promMetrics, client, elector)
+	if cfg.PgmodelCfg.RelabelConfigFile != "" {
+		reloadOnSIGHUP(client)
+	}
+
+	notifierManager, err := startNotifier(cfg)
+	if err != nil {
+		log.Error("msg", "aborting startup due to error", "err", err)
+		return startupError
+	}
+	if notifierManager != nil {
+		defer notifierManager.Stop()
+	}
+
+	ruleManager, err := startRuleManager(cfg, client, notifierManager)
+	if err != nil {
+		log.Error("msg", "aborting startup due to error", "err", err)
+		return startupError
+	}
+	var rulesReader api.RulesReader
+	if ruleManager != nil {
+		defer ruleManager.Stop()
+		rulesReader = ruleManager
+	}
+
+	apiConf := &api.Config{
+		AllowedOrigin:         cfg.CorsOrigin,
+		MultiTenancy:          cfg.MultiTenancy,
+		TenantHeader:          cfg.TenantHeader,
+		QueryEngine:           cfg.QueryEngine,
+		AdminAPIEnabled:       cfg.AdminAPIEnabled,
+		ExplainAnalyzeEnabled: cfg.ExplainAnalyzeEnabled,
+		ResultsCache:          resultsCache,
+	}
+	router := api.GenerateRouter(apiConf, promMetrics, client, elector, rulesReader)
 
 	log.Info("msg", "Starting up...")
 	log.Info("msg", "Listening", "addr", cfg.ListenAddr)
@@ -259,6 +385,71 @@ func CreateClient(cfg *Config, promMetrics *api.Metrics) (*pgclient.Client, erro
 	return client, nil
 }
 
+// startNotifier starts sending alerts to the configured Alertmanagers. Returns
+// nil if there are no Alertmanagers.
+func startNotifier(cfg *Config) (*notifier.Manager, error) {
+	if len(cfg.AlertmanagerURLs) == 0 && len(cfg.AlertmanagerSDFiles) == 0 {
+		return nil, nil
+	}
+	manager, err := notifier.NewManager(notifier.Options{
+		URLs:          cfg.AlertmanagerURLs,
+		SDFiles:       cfg.AlertmanagerSDFiles,
+		Timeout:       cfg.AlertmanagerTimeout,
+		QueueCapacity: cfg.AlertmanagerQueueCapacity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("configuring Alertmanagers: %w", err)
+	}
+	go manager.Run()
+	return manager, nil
+}
+
+// startRuleManager starts evaluating the configured rule files, only on the
+// leader if leader election is used. Alerts are sent through sender if it is
+// not nil. Returns nil if there are no rule files.
+func startRuleManager(cfg *Config, client *pgclient.Client, sender *notifier.Manager) (*rules.Manager, error) {
+	if len(cfg.RuleFiles) == 0 {
+		return nil, nil
+	}
+	// rule evaluations aren't queued with the queries of the API
+	engine := query.NewEngine(log.GetLogger(), query.EngineConfig{
+		Timeout:    cfg.QueryEngine.Timeout,
+		MaxSamples: cfg.QueryEngine.MaxSamples,
+	})
+	opts := rules.Options{
+		QueryFunc:          rules.EngineQueryFunc(engine, client.GetQueryable()),
+		Writer:             client,
+		Elector:            elector,
+		EvaluationInterval: cfg.RuleEvaluationInterval,
+		ResendDelay:        cfg.AlertResendDelay,
+	}
+	if sender != nil {
+		opts.NotifyFunc = rules.SendAlerts(sender)
+	}
+	manager, err := rules.NewManager(cfg.RuleFiles, opts)
+	if err != nil {
+		return nil, fmt.Errorf("loading rules: %w", err)
+	}
+	manager.Run()
+	return manager, nil
+}
+
+// reloadOnSIGHUP reloads the relabel config of the client every time the
+// process receives a SIGHUP.
+func reloadOnSIGHUP(client *pgclient.Client) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := client.ReloadRelabelConfig(); err != nil {
+				log.Error("msg", "error reloading relabel config, keeping the previous rules", "err", err)
+				continue
+			}
+			log.Info("msg", "Reloaded relabel config")
+		}
+	}()
+}
+
 func initElector(cfg *Config, metrics *api.Metrics) (*util.Elector, error) {
 	if cfg.RestElection && cfg.HaGroupLockID != 0 {
 		return nil, fmt.Errorf("Use either REST or PgAdvisoryLock for the leader election")
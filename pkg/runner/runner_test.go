@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/blang/semver/v4"
 	"github.com/timescale/promscale/pkg/api"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel"
 	"github.com/timescale/promscale/pkg/util"
 )
 
@@ -99,3 +102,33 @@ func TestInitElector(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteMigrationStatus(t *testing.T) {
+	status := &pgmodel.MigrationStatus{
+		InstalledVersion: semver.MustParse("0.1.1-dev.4"),
+		AppVersion:       semver.MustParse("0.1.1-dev.5"),
+		Pending:          []string{"versions/dev/0.1.1-dev/5-add_duplicate_policy.sql", "idempotent/base.sql"},
+		Extensions: []pgmodel.ExtensionStatus{
+			{Name: "timescaledb", Installed: "1.7.4", Target: "1.7.4", Required: ">=1.7.3"},
+			{Name: "promscale", Required: "=0.1.x"},
+		},
+	}
+	var out strings.Builder
+	if err := writeMigrationStatus(&out, status); err != nil {
+		t.Fatal(err)
+	}
+	expected := `Schema version:            0.1.1-dev.4
+Connector schema version:  0.1.1-dev.5
+Pending scripts:           2
+                           versions/dev/0.1.1-dev/5-add_duplicate_policy.sql
+                           idempotent/base.sql
+
+Extensions:
+  NAME         INSTALLED  TARGET  REQUIRED
+  timescaledb  1.7.4      1.7.4   >=1.7.3
+  promscale    none       none    =0.1.x
+`
+	if out.String() != expected {
+		t.Errorf("unexpected status:\ngot\n%s\nwanted\n%s", out.String(), expected)
+	}
+}
@@ -0,0 +1,49 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package tenancy carries the tenant a request was made on behalf of.
+package tenancy
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultHeader is the HTTP header identifying the tenant, the same one
+// Cortex and Loki use.
+const DefaultHeader = "X-Scope-OrgID"
+
+// maxTenantLength bounds the tenant identifiers we accept.
+const maxTenantLength = 150
+
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx carrying tenant.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext returns the tenant carried by ctx, or an empty string if
+// there is none.
+func FromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
+
+// Validate checks that tenant is a usable tenant identifier: a non-empty
+// string of printable ASCII characters.
+func Validate(tenant string) error {
+	if tenant == "" {
+		return fmt.Errorf("empty tenant")
+	}
+	if len(tenant) > maxTenantLength {
+		return fmt.Errorf("tenant longer than %d characters", maxTenantLength)
+	}
+	for _, c := range tenant {
+		if c < ' ' || c > '~' {
+			return fmt.Errorf("invalid character %q in tenant", c)
+		}
+	}
+	return nil
+}
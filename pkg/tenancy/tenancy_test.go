@@ -0,0 +1,45 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package tenancy
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if tenant := FromContext(ctx); tenant != "" {
+		t.Errorf("unexpected tenant %q", tenant)
+	}
+	if tenant := FromContext(WithTenant(ctx, "team-a")); tenant != "team-a" {
+		t.Errorf("unexpected tenant: got %q wanted %q", tenant, "team-a")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		tenant string
+		valid  bool
+	}{
+		{tenant: "team-a", valid: true},
+		{tenant: "Team A/1", valid: true},
+		{tenant: ""},
+		{tenant: "team\na"},
+		{tenant: "équipe"},
+		{tenant: strings.Repeat("a", maxTenantLength), valid: true},
+		{tenant: strings.Repeat("a", maxTenantLength+1)},
+	}
+	for _, c := range testCases {
+		err := Validate(c.tenant)
+		if c.valid && err != nil {
+			t.Errorf("unexpected error for %q: %v", c.tenant, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("expected an error for %q", c.tenant)
+		}
+	}
+}
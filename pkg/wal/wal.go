@@ -0,0 +1,290 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package wal implements a simple segmented write-ahead log used to durably
+// record data that was acknowledged before it reached the database.
+//
+// Records are appended to the active segment and fsync'd before Append
+// returns. Every record must be acknowledged once it is safely stored
+// elsewhere; a segment is deleted as soon as it has been rotated out and all
+// of its records were acknowledged. Segments left on disk when the log is
+// opened are ones that were never fully acknowledged and are handed back to
+// the caller through Replay.
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/timescale/promscale/pkg/log"
+)
+
+const (
+	// DefaultSegmentSize is the size after which a segment is rotated out.
+	DefaultSegmentSize = 64 * 1024 * 1024
+
+	// every record is prefixed by its length and the CRC32C of its data
+	recordHeaderSize = 8
+	segmentNameLen   = 8
+)
+
+var (
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+	// ErrClosed is returned when appending to a closed WAL.
+	ErrClosed = errors.New("wal is closed")
+)
+
+// WAL is a segmented write-ahead log, safe for concurrent use.
+type WAL struct {
+	dir         string
+	segmentSize int64
+
+	// guards all the fields below
+	mtx        sync.Mutex
+	active     *os.File
+	activeID   int
+	activeSize int64
+	// number of unacknowledged records of every segment written since the
+	// WAL was opened
+	pending map[int]int
+	// segments left over from a previous run, waiting to be replayed
+	toReplay []int
+}
+
+// Open opens the WAL in dir, creating the directory if needed. Segments found
+// in dir are kept for Replay, new records go to a fresh segment.
+func Open(dir string, segmentSize int64) (*WAL, error) {
+	if segmentSize <= 0 {
+		segmentSize = DefaultSegmentSize
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating wal directory: %w", err)
+	}
+
+	existing, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &WAL{
+		dir:         dir,
+		segmentSize: segmentSize,
+		pending:     make(map[int]int),
+		toReplay:    existing,
+	}
+
+	next := 0
+	if len(existing) > 0 {
+		next = existing[len(existing)-1] + 1
+	}
+	if err := w.openSegment(next); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func listSegments(dir string) ([]int, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("listing wal segments: %w", err)
+	}
+	segments := make([]int, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || len(f.Name()) != segmentNameLen {
+			continue
+		}
+		id, err := strconv.Atoi(f.Name())
+		if err != nil {
+			continue
+		}
+		segments = append(segments, id)
+	}
+	sort.Ints(segments)
+	return segments, nil
+}
+
+func (w *WAL) segmentPath(id int) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%0*d", segmentNameLen, id))
+}
+
+// openSegment makes a new, empty, segment the active one. Must be called with
+// the lock held.
+func (w *WAL) openSegment(id int) error {
+	f, err := os.OpenFile(w.segmentPath(id), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("creating wal segment: %w", err)
+	}
+	w.active = f
+	w.activeID = id
+	w.activeSize = 0
+	w.pending[id] = 0
+	return nil
+}
+
+// Append durably writes the record to the log and returns the segment it was
+// written to, which has to be passed to Ack once the record is no longer
+// needed.
+func (w *WAL) Append(rec []byte) (int, error) {
+	buf := make([]byte, recordHeaderSize+len(rec))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(rec)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.Checksum(rec, castagnoli))
+	copy(buf[recordHeaderSize:], rec)
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.active == nil {
+		return 0, ErrClosed
+	}
+
+	if _, err := w.active.Write(buf); err != nil {
+		return 0, fmt.Errorf("writing wal record: %w", err)
+	}
+	if err := w.active.Sync(); err != nil {
+		return 0, fmt.Errorf("syncing wal segment: %w", err)
+	}
+
+	id := w.activeID
+	w.pending[id]++
+	w.activeSize += int64(len(buf))
+
+	if w.activeSize >= w.segmentSize {
+		if err := w.rotate(); err != nil {
+			// the record itself is safely stored, we'll retry rotating on the
+			// next append
+			log.Warn("msg", "could not rotate wal segment", "err", err)
+		}
+	}
+	return id, nil
+}
+
+// rotate seals the active segment and opens a new one. Must be called with the
+// lock held.
+func (w *WAL) rotate() error {
+	sealed, f := w.activeID, w.active
+	if err := w.openSegment(sealed + 1); err != nil {
+		return err
+	}
+	// the data was already synced, nothing is lost if closing fails
+	if err := f.Close(); err != nil {
+		log.Warn("msg", "could not close wal segment", "segment", sealed, "err", err)
+	}
+	w.maybeRemove(sealed)
+	return nil
+}
+
+// maybeRemove deletes a sealed segment once all its records are acknowledged.
+// Must be called with the lock held.
+func (w *WAL) maybeRemove(id int) {
+	if id == w.activeID || w.pending[id] > 0 {
+		return
+	}
+	delete(w.pending, id)
+	if err := os.Remove(w.segmentPath(id)); err != nil && !os.IsNotExist(err) {
+		log.Warn("msg", "could not remove wal segment", "segment", id, "err", err)
+	}
+}
+
+// Ack marks one record of the segment as no longer needed.
+func (w *WAL) Ack(segment int) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if _, ok := w.pending[segment]; !ok {
+		return
+	}
+	w.pending[segment]--
+	w.maybeRemove(segment)
+}
+
+// Replay calls fn for every record in the segments left over from a previous
+// run, in the order they were written. Acknowledgements are only tracked per
+// segment, so all the records of a segment that was not fully acknowledged
+// are replayed, fn must be able to cope with records it has already seen
+// being replayed. A segment is deleted once all its
+// records were successfully processed; if fn returns an error replay stops
+// and the remaining segments are kept for the next time the WAL is opened.
+// A torn or corrupted record ends its segment, as it can only be the result
+// of a crash while it was being appended.
+func (w *WAL) Replay(fn func(rec []byte) error) error {
+	w.mtx.Lock()
+	segments := w.toReplay
+	w.toReplay = nil
+	w.mtx.Unlock()
+
+	for i, id := range segments {
+		if err := w.replaySegment(id, fn); err != nil {
+			w.mtx.Lock()
+			w.toReplay = segments[i:]
+			w.mtx.Unlock()
+			return err
+		}
+		if err := os.Remove(w.segmentPath(id)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing replayed wal segment: %w", err)
+		}
+	}
+	return nil
+}
+
+func (w *WAL) replaySegment(id int, fn func(rec []byte) error) error {
+	f, err := os.Open(w.segmentPath(id))
+	if err != nil {
+		return fmt.Errorf("opening wal segment: %w", err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	header := make([]byte, recordHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err != io.EOF {
+				log.Warn("msg", "truncated record at the end of wal segment", "segment", id)
+			}
+			return nil
+		}
+		rec := make([]byte, binary.BigEndian.Uint32(header[0:4]))
+		if _, err := io.ReadFull(r, rec); err != nil {
+			log.Warn("msg", "truncated record at the end of wal segment", "segment", id)
+			return nil
+		}
+		if crc32.Checksum(rec, castagnoli) != binary.BigEndian.Uint32(header[4:8]) {
+			log.Warn("msg", "corrupted record in wal segment, skipping the rest of the segment", "segment", id)
+			return nil
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// Close closes the active segment. Records that were not acknowledged are
+// kept on disk and will be replayed the next time the WAL is opened.
+func (w *WAL) Close() error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.active == nil {
+		return nil
+	}
+	err := w.active.Close()
+	w.active = nil
+
+	// the closed segment is now sealed, removable once all its records are
+	// acknowledged
+	closed := w.activeID
+	w.activeID = -1
+	w.maybeRemove(closed)
+	return err
+}
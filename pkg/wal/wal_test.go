@@ -0,0 +1,175 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package wal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func segmentFiles(t *testing.T, dir string) []int {
+	segments, err := listSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return segments
+}
+
+func replayAll(t *testing.T, w *WAL) []string {
+	var records []string
+	err := w.Replay(func(rec []byte) error {
+		records = append(records, string(rec))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return records
+}
+
+func TestWALAckRemovesSegments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// every record fills a segment
+	w, err := Open(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s0, err := w.Append([]byte("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1, err := w.Append([]byte("second"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s0 != 0 || s1 != 1 {
+		t.Fatalf("unexpected segments %d, %d", s0, s1)
+	}
+	if segs := segmentFiles(t, dir); !reflect.DeepEqual(segs, []int{0, 1, 2}) {
+		t.Fatalf("unexpected segment files %v", segs)
+	}
+
+	w.Ack(s1)
+	if segs := segmentFiles(t, dir); !reflect.DeepEqual(segs, []int{0, 2}) {
+		t.Fatalf("unexpected segment files %v", segs)
+	}
+	w.Ack(s0)
+	if segs := segmentFiles(t, dir); !reflect.DeepEqual(segs, []int{2}) {
+		t.Fatalf("unexpected segment files %v", segs)
+	}
+
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if segs := segmentFiles(t, dir); len(segs) != 0 {
+		t.Fatalf("unexpected segment files %v", segs)
+	}
+	if _, err = w.Append([]byte("closed")); err != ErrClosed {
+		t.Fatalf("unexpected error appending to a closed wal: %v", err)
+	}
+}
+
+func TestWALReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// one record per segment, so that acked records are removed
+	w, err := Open(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{}
+	for i := 0; i < 5; i++ {
+		rec := fmt.Sprintf("record %d", i)
+		s, err := w.Append([]byte(rec))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the acked record must not be replayed, the others must
+		if i == 2 {
+			w.Ack(s)
+			continue
+		}
+		expected = append(expected, rec)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a failed replay keeps the segments around for the next attempt
+	w, err = Open(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayErr := fmt.Errorf("replay error")
+	err = w.Replay(func(rec []byte) error { return replayErr })
+	if err != replayErr {
+		t.Fatalf("unexpected error: got %v wanted %v", err, replayErr)
+	}
+	if records := replayAll(t, w); !reflect.DeepEqual(records, expected) {
+		t.Fatalf("unexpected records:\ngot\n%v\nwanted\n%v", records, expected)
+	}
+	if records := replayAll(t, w); len(records) != 0 {
+		t.Fatalf("records replayed twice: %v", records)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if segs := segmentFiles(t, dir); len(segs) != 0 {
+		t.Fatalf("unexpected segment files %v", segs)
+	}
+}
+
+func TestWALReplayTornRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := Open(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range []string{"complete", "torn"} {
+		if _, err = w.Append([]byte(rec)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// simulate a crash in the middle of writing the last record
+	path := w.segmentPath(0)
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Truncate(path, fi.Size()-2); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err = Open(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if records := replayAll(t, w); !reflect.DeepEqual(records, []string{"complete"}) {
+		t.Fatalf("unexpected records %v", records)
+	}
+}